@@ -21,6 +21,23 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// TLSClientCert and TLSClientKey are the PEM encoded client certificate
+	// and key presented to the bridge, for external adapters requiring mTLS.
+	TLSClientCert string `json:"tlsClientCert"`
+	TLSClientKey  string `json:"tlsClientKey"`
+	// TLSRootCA are the PEM encoded certificates trusted for the bridge,
+	// instead of the system roots.
+	TLSRootCA string `json:"tlsRootCA"`
+	// SigningSecret signs request bodies sent to the bridge, see Signature.
+	SigningSecret string `json:"signingSecret"`
+	// HealthCheckPath is probed periodically with a GET request on the
+	// bridge's host, if set.
+	HealthCheckPath string `json:"healthCheckPath"`
+	// ResponseCacheTTL enables the in-memory response cache, see
+	// ResponseCache, and ResponseCacheMaxStale bounds how much longer a
+	// cached response may be served when requests fail.
+	ResponseCacheTTL      models.Interval `json:"responseCacheTTL"`
+	ResponseCacheMaxStale models.Interval `json:"responseCacheMaxStale"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -60,8 +77,20 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	TLSClientCert          string
+	TLSRootCA              string
+	HealthCheckPath        string
+	ResponseCacheTTL       models.Interval
+	ResponseCacheMaxStale  models.Interval
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
+
+	// TLSClientKey and SigningSecret are stored encrypted with the keystore
+	// password, in EncryptedTLSClientKey and EncryptedSigningSecret.
+	TLSClientKey           string `db:"-"`
+	SigningSecret          string `db:"-"`
+	EncryptedTLSClientKey  []byte
+	EncryptedSigningSecret []byte
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -92,6 +121,13 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			TLSClientCert:          btr.TLSClientCert,
+			TLSClientKey:           btr.TLSClientKey,
+			TLSRootCA:              btr.TLSRootCA,
+			SigningSecret:          btr.SigningSecret,
+			HealthCheckPath:        btr.HealthCheckPath,
+			ResponseCacheTTL:       btr.ResponseCacheTTL,
+			ResponseCacheMaxStale:  btr.ResponseCacheMaxStale,
 		}, nil
 }
 
@@ -0,0 +1,256 @@
+package bridges
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	// HealthCheckInterval is the period between health probes of each bridge.
+	HealthCheckInterval = time.Minute
+	// HealthCheckTimeout bounds a single health probe.
+	HealthCheckTimeout = 10 * time.Second
+
+	healthCheckPageSize = 1000
+	// healthCheckBodyLimit bounds how much of an unhealthy response is kept
+	// as its error.
+	healthCheckBodyLimit = 256
+)
+
+var promBridgeHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridge_healthy",
+	Help: "Whether the last health probe of the bridge succeeded (1) or not (0), scoped by name",
+},
+	[]string{"name"},
+)
+
+// HealthStatus is the outcome of the latest health probe of a bridge.
+type HealthStatus struct {
+	Healthy   bool
+	Error     string
+	CheckedAt time.Time
+}
+
+//go:generate mockery --quiet --name HealthMonitor --output ./mocks --case=underscore
+
+// HealthMonitor periodically probes the HealthCheckPath of every bridge which
+// has one, and reports each bridge in its HealthReport.
+type HealthMonitor interface {
+	services.Service
+	// Status returns the latest health status of the named bridge, or false
+	// if the bridge has not been probed.
+	Status(name BridgeName) (HealthStatus, bool)
+}
+
+type healthMonitor struct {
+	services.StateMachine
+	orm     ORM
+	clients *HTTPClients
+	lggr    logger.Logger
+
+	mu       sync.RWMutex
+	statuses map[BridgeName]HealthStatus
+
+	chStop services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ HealthMonitor = (*healthMonitor)(nil)
+
+func NewHealthMonitor(orm ORM, clients *HTTPClients, lggr logger.Logger) HealthMonitor {
+	return &healthMonitor{
+		orm:      orm,
+		clients:  clients,
+		lggr:     lggr.Named("BridgeHealthMonitor"),
+		statuses: make(map[BridgeName]HealthStatus),
+		chStop:   make(chan struct{}),
+	}
+}
+
+func (m *healthMonitor) Start(context.Context) error {
+	return m.StartOnce("BridgeHealthMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+func (m *healthMonitor) Close() error {
+	return m.StopOnce("BridgeHealthMonitor", func() error {
+		close(m.chStop)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *healthMonitor) Name() string {
+	return m.lggr.Name()
+}
+
+// HealthReport reports the monitor itself, and each probed bridge as
+// "<name>.<bridge>".
+func (m *healthMonitor) HealthReport() map[string]error {
+	report := map[string]error{m.Name(): m.Healthy()}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for name, status := range m.statuses {
+		var err error
+		if !status.Healthy {
+			err = pkgerrors.New(status.Error)
+		}
+		report[fmt.Sprintf("%s.%s", m.Name(), name)] = err
+	}
+	return report
+}
+
+func (m *healthMonitor) Status(name BridgeName) (HealthStatus, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	status, ok := m.statuses[name]
+	return status, ok
+}
+
+func (m *healthMonitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		m.checkAll(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkAll probes every bridge with a health check path, and forgets the
+// status of bridges which have been deleted or no longer have one.
+func (m *healthMonitor) checkAll(ctx context.Context) {
+	var bts []BridgeType
+	for offset := 0; ; offset += healthCheckPageSize {
+		page, count, err := m.orm.BridgeTypes(offset, healthCheckPageSize)
+		if err != nil {
+			m.lggr.Errorw("Failed to load bridges", "err", err)
+			return
+		}
+		bts = append(bts, page...)
+		if len(page) == 0 || offset+len(page) >= count {
+			break
+		}
+	}
+
+	checked := make(map[BridgeName]struct{})
+	for _, bt := range bts {
+		if bt.HealthCheckPath == "" {
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		status := m.check(ctx, bt)
+		checked[bt.Name] = struct{}{}
+
+		m.mu.Lock()
+		previous, ok := m.statuses[bt.Name]
+		m.statuses[bt.Name] = status
+		m.mu.Unlock()
+
+		if status.Healthy {
+			promBridgeHealthy.WithLabelValues(bt.Name.String()).Set(1)
+			if ok && !previous.Healthy {
+				m.lggr.Infow("Bridge is healthy again", "bridge", bt.Name)
+			}
+		} else {
+			promBridgeHealthy.WithLabelValues(bt.Name.String()).Set(0)
+			if !ok || previous.Healthy {
+				m.lggr.Warnw("Bridge health check failed", "bridge", bt.Name, "err", status.Error)
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name := range m.statuses {
+		if _, ok := checked[name]; !ok {
+			delete(m.statuses, name)
+			promBridgeHealthy.DeleteLabelValues(name.String())
+		}
+	}
+}
+
+func (m *healthMonitor) check(ctx context.Context, bt BridgeType) HealthStatus {
+	status := HealthStatus{CheckedAt: time.Now()}
+	if err := probe(ctx, m.clients, bt); err != nil {
+		status.Error = err.Error()
+	} else {
+		status.Healthy = true
+	}
+	return status
+}
+
+// probe sends a GET request to the bridge's health check URL, and returns an
+// error unless it responds with a 2xx status.
+func probe(ctx context.Context, clients *HTTPClients, bt BridgeType) error {
+	u, err := bt.HealthCheckURL()
+	if err != nil {
+		return err
+	}
+	client, err := clients.Client(bt)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, HealthCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	// Sign the empty body, so adapters verifying every request accept probes.
+	headers := bt.SignatureHeaders(nil, time.Now())
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, healthCheckBodyLimit))
+		return pkgerrors.Errorf("health check returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// HealthCheckURL returns the URL probed by health checks, which is the
+// HealthCheckPath on the bridge's host.
+func (bt BridgeType) HealthCheckURL() (*url.URL, error) {
+	ref, err := url.Parse(bt.HealthCheckPath)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "invalid health check path")
+	}
+	if ref.IsAbs() || ref.Host != "" || !strings.HasPrefix(ref.Path, "/") {
+		return nil, pkgerrors.Errorf("health check path %q must be an absolute path", bt.HealthCheckPath)
+	}
+	u := url.URL(bt.URL)
+	return u.ResolveReference(ref), nil
+}
@@ -0,0 +1,103 @@
+package bridges_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestHealthMonitor(t *testing.T) {
+	t.Parallel()
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			w.WriteHeader(http.StatusOK)
+		case "/signed/health":
+			if r.Header.Get(bridges.SignatureHeader) != bridges.Signature(secret, r.Header.Get(bridges.TimestampHeader), nil) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("adapter is down"))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	bts := []bridges.BridgeType{
+		{Name: "healthy", URL: cltest.WebURL(t, server.URL+"/adapter"), HealthCheckPath: "/health"},
+		{Name: "signed", URL: cltest.WebURL(t, server.URL), HealthCheckPath: "/signed/health", SigningSecret: secret},
+		{Name: "unhealthy", URL: cltest.WebURL(t, server.URL), HealthCheckPath: "/down"},
+		{Name: "unprobed", URL: cltest.WebURL(t, server.URL)},
+	}
+	orm := mocks.NewORM(t)
+	orm.On("BridgeTypes", 0, mock.Anything).Return(bts, len(bts), nil)
+
+	m := bridges.NewHealthMonitor(orm, bridges.NewHTTPClients(server.Client()), logger.TestLogger(t))
+	require.NoError(t, m.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, m.Close()) })
+
+	require.Eventually(t, func() bool {
+		_, ok := m.Status("unhealthy")
+		return ok
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	status, ok := m.Status("healthy")
+	require.True(t, ok)
+	assert.True(t, status.Healthy)
+	assert.Empty(t, status.Error)
+	assert.False(t, status.CheckedAt.IsZero())
+
+	status, ok = m.Status("signed")
+	require.True(t, ok)
+	assert.True(t, status.Healthy)
+
+	status, ok = m.Status("unhealthy")
+	require.True(t, ok)
+	assert.False(t, status.Healthy)
+	assert.Equal(t, "health check returned status 503: adapter is down", status.Error)
+
+	_, ok = m.Status("unprobed")
+	assert.False(t, ok)
+
+	report := m.HealthReport()
+	assert.NoError(t, report[m.Name()])
+	assert.NoError(t, report[m.Name()+".healthy"])
+	assert.NoError(t, report[m.Name()+".signed"])
+	assert.EqualError(t, report[m.Name()+".unhealthy"], "health check returned status 503: adapter is down")
+	assert.NotContains(t, report, m.Name()+".unprobed")
+}
+
+func TestBridgeType_HealthCheckURL(t *testing.T) {
+	t.Parallel()
+
+	bt := bridges.BridgeType{URL: cltest.WebURL(t, "https://adapter.example.com:8080/v1/price?x=1")}
+	for path, want := range map[string]string{
+		"/health":         "https://adapter.example.com:8080/health",
+		"/v1/health?deep": "https://adapter.example.com:8080/v1/health?deep",
+	} {
+		bt.HealthCheckPath = path
+		u, err := bt.HealthCheckURL()
+		require.NoError(t, err)
+		assert.Equal(t, want, u.String())
+	}
+
+	for _, path := range []string{"health", "https://elsewhere.example.com/health", "//elsewhere.example.com/health"} {
+		bt.HealthCheckPath = path
+		_, err := bt.HealthCheckURL()
+		assert.Error(t, err, path)
+	}
+}
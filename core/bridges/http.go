@@ -0,0 +1,147 @@
+package bridges
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request
+	// timestamp and body, keyed with the bridge's signing secret.
+	SignatureHeader = "X-Chainlink-Bridge-Signature"
+	// TimestampHeader carries the unix time in seconds at which the request
+	// was signed.
+	TimestampHeader = "X-Chainlink-Bridge-Timestamp"
+
+	// MinSigningSecretLength is the minimum length of a bridge signing secret.
+	MinSigningSecretLength = 32
+)
+
+// Signature returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed
+// with secret. External adapters verify requests by computing the same value.
+func Signature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureHeaders returns the headers signing body at now, as alternating
+// names and values, or nil if the bridge has no signing secret.
+func (bt BridgeType) SignatureHeaders(body []byte, now time.Time) []string {
+	if bt.SigningSecret == "" {
+		return nil
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	return []string{
+		TimestampHeader, timestamp,
+		SignatureHeader, Signature(bt.SigningSecret, timestamp, body),
+	}
+}
+
+// HasTLSConfig returns true if the bridge has a client certificate or a root
+// CA configured.
+func (bt BridgeType) HasTLSConfig() bool {
+	return bt.TLSClientCert != "" || bt.TLSRootCA != ""
+}
+
+// TLSConfig returns the TLS configuration for requests to the bridge.
+func (bt BridgeType) TLSConfig() (*tls.Config, error) {
+	return NewTLSConfig(bt.TLSClientCert, bt.TLSClientKey, bt.TLSRootCA)
+}
+
+// NewTLSConfig builds a TLS configuration presenting the PEM encoded client
+// certificate and key, if any, and trusting only the PEM encoded rootCA
+// certificates if given, or the system roots otherwise.
+func NewTLSConfig(clientCert, clientKey, rootCA string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			return nil, pkgerrors.New("a client certificate and key must be provided together")
+		}
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "invalid client certificate")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if rootCA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(rootCA)) {
+			return nil, pkgerrors.New("invalid root CA: no PEM encoded certificates found")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// HTTPClients hands out HTTP clients for requests to bridges. Bridges without
+// TLS configuration share the base client, the others get a client of their
+// own, which is kept until the bridge's TLS configuration changes.
+type HTTPClients struct {
+	base *http.Client
+
+	mu      sync.Mutex
+	clients map[BridgeName]tlsClient
+}
+
+type tlsClient struct {
+	clientCert, clientKey, rootCA string
+
+	client *http.Client
+}
+
+func NewHTTPClients(base *http.Client) *HTTPClients {
+	return &HTTPClients{base: base, clients: make(map[BridgeName]tlsClient)}
+}
+
+// Client returns the HTTP client to use for requests to bt.
+func (c *HTTPClients) Client(bt BridgeType) (*http.Client, error) {
+	if !bt.HasTLSConfig() {
+		return c.base, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.clients[bt.Name]; ok && cached.clientCert == bt.TLSClientCert &&
+		cached.clientKey == bt.TLSClientKey && cached.rootCA == bt.TLSRootCA {
+		return cached.client, nil
+	}
+
+	tlsConfig, err := bt.TLSConfig()
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "bridge %s", bt.Name)
+	}
+	base, ok := c.base.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	tr := base.Clone()
+	tr.TLSClientConfig = tlsConfig
+	client := &http.Client{
+		Transport:     tr,
+		CheckRedirect: c.base.CheckRedirect,
+		Jar:           c.base.Jar,
+		Timeout:       c.base.Timeout,
+	}
+	if cached, ok := c.clients[bt.Name]; ok {
+		cached.client.CloseIdleConnections()
+	}
+	c.clients[bt.Name] = tlsClient{
+		clientCert: bt.TLSClientCert,
+		clientKey:  bt.TLSClientKey,
+		rootCA:     bt.TLSRootCA,
+		client:     client,
+	}
+	return client, nil
+}
@@ -0,0 +1,159 @@
+package bridges_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestBridgeType_SignatureHeaders(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"data":{}}`)
+	now := time.Unix(1700000000, 0)
+
+	assert.Nil(t, bridges.BridgeType{}.SignatureHeaders(body, now))
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	headers := bridges.BridgeType{SigningSecret: secret}.SignatureHeaders(body, now)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	assert.Equal(t, []string{
+		bridges.TimestampHeader, timestamp,
+		bridges.SignatureHeader, bridges.Signature(secret, timestamp, body),
+	}, headers)
+
+	assert.Equal(t, "569f388079d8e94dff56f273407acb5ebf1e09e2024b07b5a7378e6bcdb7a8d5", bridges.Signature(secret, timestamp, body))
+	assert.NotEqual(t, bridges.Signature(secret, timestamp, body), bridges.Signature(secret, timestamp, []byte(`{"data":{"a":1}}`)))
+	assert.NotEqual(t, bridges.Signature(secret, timestamp, body), bridges.Signature(secret, "1700000001", body))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	cert, key := newClientCertificate(t)
+
+	_, err := bridges.NewTLSConfig("", "", "")
+	require.NoError(t, err)
+	cfg, err := bridges.NewTLSConfig(cert, key, cert)
+	require.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 1)
+	assert.NotNil(t, cfg.RootCAs)
+
+	_, err = bridges.NewTLSConfig(cert, "", "")
+	assert.EqualError(t, err, "a client certificate and key must be provided together")
+	_, err = bridges.NewTLSConfig("", key, "")
+	assert.EqualError(t, err, "a client certificate and key must be provided together")
+	_, err = bridges.NewTLSConfig("not a certificate", key, "")
+	assert.ErrorContains(t, err, "invalid client certificate")
+	_, err = bridges.NewTLSConfig("", "", "not a certificate")
+	assert.EqualError(t, err, "invalid root CA: no PEM encoded certificates found")
+}
+
+func TestHTTPClients_Client(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := newClientCertificate(t)
+	block, _ := pem.Decode([]byte(clientCert))
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(parsed)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	rootCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	base := &http.Client{}
+	clients := bridges.NewHTTPClients(base)
+	get := func(bt bridges.BridgeType) error {
+		client, err := clients.Client(bt)
+		require.NoError(t, err)
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	t.Run("without TLS config", func(t *testing.T) {
+		bt := bridges.BridgeType{Name: "plain", URL: cltest.WebURL(t, server.URL)}
+		client, err := clients.Client(bt)
+		require.NoError(t, err)
+		assert.Same(t, base, client)
+		assert.Error(t, get(bt))
+	})
+
+	t.Run("root CA without client certificate", func(t *testing.T) {
+		bt := bridges.BridgeType{Name: "ca", URL: cltest.WebURL(t, server.URL), TLSRootCA: rootCA}
+		assert.Error(t, get(bt))
+	})
+
+	t.Run("mTLS", func(t *testing.T) {
+		bt := bridges.BridgeType{Name: "mtls", URL: cltest.WebURL(t, server.URL), TLSClientCert: clientCert, TLSClientKey: clientKey, TLSRootCA: rootCA}
+		require.NoError(t, get(bt))
+
+		client, err := clients.Client(bt)
+		require.NoError(t, err)
+		again, err := clients.Client(bt)
+		require.NoError(t, err)
+		assert.Same(t, client, again, "client is reused while the TLS config is unchanged")
+
+		bt.TLSRootCA = ""
+		changed, err := clients.Client(bt)
+		require.NoError(t, err)
+		assert.NotSame(t, client, changed, "client is replaced when the TLS config changes")
+		assert.Error(t, get(bt))
+	})
+
+	t.Run("invalid TLS config", func(t *testing.T) {
+		_, err := clients.Client(bridges.BridgeType{Name: "invalid", TLSClientCert: clientCert})
+		assert.ErrorContains(t, err, "bridge invalid")
+	})
+}
+
+// newClientCertificate returns a PEM encoded self-signed client certificate
+// and its key.
+func newClientCertificate(t *testing.T) (cert string, key string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	require.NoError(t, err)
+
+	cert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	key = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return cert, key
+}
@@ -0,0 +1,150 @@
+// Code generated by mockery v2.38.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthMonitor is an autogenerated mock type for the HealthMonitor type
+type HealthMonitor struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields:
+func (_m *HealthMonitor) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *HealthMonitor) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HealthReport")
+	}
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *HealthMonitor) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *HealthMonitor) Ready() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *HealthMonitor) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Status provides a mock function with given fields: name
+func (_m *HealthMonitor) Status(name bridges.BridgeName) (bridges.HealthStatus, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 bridges.HealthStatus
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(bridges.BridgeName) (bridges.HealthStatus, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(bridges.BridgeName) bridges.HealthStatus); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bridges.HealthStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(bridges.BridgeName) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// NewHealthMonitor creates a new instance of HealthMonitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthMonitor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthMonitor {
+	mock := &HealthMonitor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
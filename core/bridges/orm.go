@@ -1,6 +1,7 @@
 package bridges
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"sync"
@@ -34,17 +35,37 @@ type ORM interface {
 	FindExternalInitiatorByName(iname string) (exi ExternalInitiator, err error)
 }
 
+// SecretsCipher encrypts the TLS client keys and signing secrets of bridges,
+// it is implemented by the keystore.
+type SecretsCipher interface {
+	EncryptSecret(plaintext []byte) ([]byte, error)
+	DecryptSecret(ciphertext []byte) ([]byte, error)
+}
+
+// ErrNoSecretsCipher is returned when storing or loading a bridge with a TLS
+// client key or a signing secret from an ORM without a SecretsCipher.
+var ErrNoSecretsCipher = pkgerrors.New("bridge TLS client keys and signing secrets require the keystore")
+
 type orm struct {
-	q pg.Q
+	q       pg.Q
+	secrets SecretsCipher
 
 	bridgeTypesCache sync.Map
 }
 
 var _ ORM = (*orm)(nil)
 
+// NewORM creates an ORM which can't store nor load the TLS client keys and
+// signing secrets of bridges, see NewORMWithSecrets.
 func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) ORM {
+	return NewORMWithSecrets(db, lggr, cfg, nil)
+}
+
+// NewORMWithSecrets creates an ORM which stores the TLS client keys and
+// signing secrets of bridges encrypted with secrets.
+func NewORMWithSecrets(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, secrets SecretsCipher) ORM {
 	namedLogger := lggr.Named("BridgeORM")
-	return &orm{q: pg.NewQ(db, namedLogger, cfg)}
+	return &orm{q: pg.NewQ(db, namedLogger, cfg), secrets: secrets}
 }
 
 // FindBridge looks up a Bridge by its Name.
@@ -55,10 +76,13 @@ func (o *orm) FindBridge(name BridgeName) (bt BridgeType, err error) {
 	}
 
 	stmt := "SELECT * FROM bridge_types WHERE name = $1"
-	err = o.q.Get(&bt, stmt, name.String())
-	if err == nil {
-		o.bridgeTypesCache.Store(bt.Name, bt)
+	if err = o.q.Get(&bt, stmt, name.String()); err != nil {
+		return
+	}
+	if err = o.decryptSecrets(&bt); err != nil {
+		return
 	}
+	o.bridgeTypesCache.Store(bt.Name, bt)
 	return
 }
 
@@ -94,8 +118,11 @@ func (o *orm) FindBridges(names []BridgeName) (bts []BridgeType, err error) {
 	if err != nil {
 		return nil, err
 	}
-	for _, bt := range bts {
-		o.bridgeTypesCache.Store(bt.Name, bt)
+	for i := range bts {
+		if err = o.decryptSecrets(&bts[i]); err != nil {
+			return nil, err
+		}
+		o.bridgeTypesCache.Store(bts[i].Name, bts[i])
 	}
 	allFoundBts = append(allFoundBts, bts...)
 	if len(allFoundBts) != len(names) {
@@ -136,16 +163,31 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 		}
 		return nil
 	}, pg.OptReadOnlyTx())
+	if err != nil {
+		return
+	}
+	for i := range bridges {
+		if err = o.decryptSecrets(&bridges[i]); err != nil {
+			return nil, 0, err
+		}
+	}
 
 	return
 }
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	var err error
+	if bt.EncryptedTLSClientKey, err = o.encryptSecret(bt.TLSClientKey); err != nil {
+		return pkgerrors.Wrap(err, "CreateBridgeType failed")
+	}
+	if bt.EncryptedSigningSecret, err = o.encryptSecret(bt.SigningSecret); err != nil {
+		return pkgerrors.Wrap(err, "CreateBridgeType failed")
+	}
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, tls_client_cert, encrypted_tls_client_key, tls_root_ca, encrypted_signing_secret, health_check_path, response_cache_ttl, response_cache_max_stale, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :tls_client_cert, :encrypted_tls_client_key, :tls_root_ca, :encrypted_signing_secret, :health_check_path, :response_cache_ttl, :response_cache_max_stale, now(), now())
 	RETURNING *;`
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
 		if err != nil {
 			return err
@@ -162,15 +204,77 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.q.Get(bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	encryptedTLSClientKey, err := o.encryptSecret(btr.TLSClientKey)
+	if err != nil {
+		return err
+	}
+	encryptedSigningSecret, err := o.encryptSecret(btr.SigningSecret)
+	if err != nil {
+		return err
+	}
+	stmt := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3,
+	tls_client_cert = $4, encrypted_tls_client_key = $5, tls_root_ca = $6, encrypted_signing_secret = $7, health_check_path = $8,
+	response_cache_ttl = $9, response_cache_max_stale = $10
+	WHERE name = $11 RETURNING *`
+	err = o.q.Get(bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment,
+		btr.TLSClientCert, encryptedTLSClientKey, btr.TLSRootCA, encryptedSigningSecret, btr.HealthCheckPath,
+		btr.ResponseCacheTTL, btr.ResponseCacheMaxStale, bt.Name)
 	if err == nil {
+		bt.TLSClientKey = btr.TLSClientKey
+		bt.SigningSecret = btr.SigningSecret
 		o.bridgeTypesCache.Store(bt.Name, *bt)
 	}
 
 	return err
 }
 
+// encryptSecret returns the encrypted secret, or nil if secret is empty.
+func (o *orm) encryptSecret(secret string) ([]byte, error) {
+	if secret == "" {
+		return nil, nil
+	}
+	if o.secrets == nil {
+		return nil, ErrNoSecretsCipher
+	}
+	return o.secrets.EncryptSecret([]byte(secret))
+}
+
+// decryptSecrets sets the TLS client key and signing secret of bt from their
+// encrypted values. The values of the cached bridge are reused while they
+// don't change, as decrypting is expensive.
+func (o *orm) decryptSecrets(bt *BridgeType) error {
+	if len(bt.EncryptedTLSClientKey) == 0 && len(bt.EncryptedSigningSecret) == 0 {
+		return nil
+	}
+	if cached, ok := o.bridgeTypesCache.Load(bt.Name); ok {
+		cached := cached.(BridgeType)
+		if bytes.Equal(cached.EncryptedTLSClientKey, bt.EncryptedTLSClientKey) &&
+			bytes.Equal(cached.EncryptedSigningSecret, bt.EncryptedSigningSecret) {
+			bt.TLSClientKey = cached.TLSClientKey
+			bt.SigningSecret = cached.SigningSecret
+			return nil
+		}
+	}
+	if o.secrets == nil {
+		return pkgerrors.Wrapf(ErrNoSecretsCipher, "bridge %s", bt.Name)
+	}
+	if len(bt.EncryptedTLSClientKey) > 0 {
+		key, err := o.secrets.DecryptSecret(bt.EncryptedTLSClientKey)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to decrypt TLS client key of bridge %s", bt.Name)
+		}
+		bt.TLSClientKey = string(key)
+	}
+	if len(bt.EncryptedSigningSecret) > 0 {
+		secret, err := o.secrets.DecryptSecret(bt.EncryptedSigningSecret)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "failed to decrypt signing secret of bridge %s", bt.Name)
+		}
+		bt.SigningSecret = string(secret)
+	}
+	return nil
+}
+
 func (o *orm) GetCachedResponse(dotId string, specId int32, maxElapsed time.Duration) (response []byte, err error) {
 	stalenessThreshold := time.Now().Add(-maxElapsed)
 	sql := `SELECT value FROM bridge_last_value WHERE
@@ -104,7 +104,10 @@ func TestORM_FindBridge(t *testing.T) {
 	}
 }
 func TestORM_UpdateBridgeType(t *testing.T) {
-	_, orm := setupORM(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg.Database())
+	orm := bridges.NewORMWithSecrets(db, logger.TestLogger(t), cfg.Database(), keyStore)
 
 	firstBridge := &bridges.BridgeType{
 		Name: "UniqueName",
@@ -114,7 +117,10 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.NoError(t, orm.CreateBridgeType(firstBridge))
 
 	updateBridge := &bridges.BridgeTypeRequest{
-		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		URL:             cltest.WebURL(t, "http:/updatedurl.com"),
+		TLSRootCA:       "root CA",
+		SigningSecret:   "0123456789abcdef0123456789abcdef",
+		HealthCheckPath: "/health",
 	}
 
 	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
@@ -122,6 +128,20 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := orm.FindBridge("UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, updateBridge.TLSRootCA, foundbridge.TLSRootCA)
+	require.Equal(t, updateBridge.SigningSecret, foundbridge.SigningSecret)
+	require.Equal(t, updateBridge.HealthCheckPath, foundbridge.HealthCheckPath)
+
+	var stored []byte
+	require.NoError(t, db.Get(&stored, `SELECT encrypted_signing_secret FROM bridge_types WHERE name = $1`, firstBridge.Name))
+	require.NotContains(t, string(stored), updateBridge.SigningSecret)
+
+	reloaded, err := bridges.NewORMWithSecrets(db, logger.TestLogger(t), cfg.Database(), keyStore).FindBridge("UniqueName")
+	require.NoError(t, err)
+	require.Equal(t, updateBridge.SigningSecret, reloaded.SigningSecret)
+
+	_, err = bridges.NewORM(db, logger.TestLogger(t), cfg.Database()).FindBridge("UniqueName")
+	require.ErrorIs(t, err, bridges.ErrNoSecretsCipher)
 
 	bs, count, err := orm.BridgeTypes(0, 10)
 	require.NoError(t, err)
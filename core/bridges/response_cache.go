@@ -0,0 +1,170 @@
+package bridges
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+// responseCachePruneInterval is the minimum period between sweeps of expired
+// entries.
+const responseCachePruneInterval = time.Minute
+
+var (
+	promBridgeResponseCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_hits_total",
+		Help: "Bridge requests answered by a fresh cached response, or by an identical request in flight, scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeResponseCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_misses_total",
+		Help: "Bridge requests sent to the bridge because no fresh cached response was available, scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeResponseCacheStale = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_stale_total",
+		Help: "Failed bridge requests answered by a stale cached response, scoped by name",
+	},
+		[]string{"name"},
+	)
+)
+
+// Response is a response of a bridge, as kept by ResponseCache.
+type Response struct {
+	Body       []byte
+	StatusCode int
+	Header     http.Header
+	Elapsed    time.Duration
+}
+
+func (r Response) ok() bool {
+	return r.StatusCode == http.StatusOK
+}
+
+// CacheStatus describes where a response returned by ResponseCache.Fetch
+// comes from.
+type CacheStatus string
+
+const (
+	// CacheHit is a fresh cached response, or the response to an identical
+	// request which was in flight.
+	CacheHit CacheStatus = "hit"
+	// CacheMiss is the response to a request sent to the bridge.
+	CacheMiss CacheStatus = "miss"
+	// CacheStale is a cached response older than the bridge's
+	// ResponseCacheTTL, returned because the request to the bridge failed.
+	CacheStale CacheStatus = "stale"
+)
+
+// ResponseCache keeps successful bridge responses in memory by bridge and
+// request key, so that identical requests from many jobs within a bridge's
+// ResponseCacheTTL are sent only once. When a request fails, a response up to
+// ResponseCacheMaxStale older than that is served instead.
+type ResponseCache struct {
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	entries   map[string]cacheEntry
+	lastPrune time.Time
+}
+
+type cacheEntry struct {
+	response  Response
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{entries: make(map[string]cacheEntry)}
+}
+
+// Fetch returns the cached response for key if it is fresh. Otherwise it
+// calls fetch, at most once at a time per bridge and key, and caches the
+// response if its status is 200. If fetch fails, with an error or another
+// status, a cached response no older than the bridge's ResponseCacheTTL plus
+// ResponseCacheMaxStale is returned instead.
+//
+// fetch runs on a context detached from ctx, bounded by timeout if it is not
+// zero, so that callers giving up do not fail it for the others waiting for
+// it. Each caller waits until its own ctx is done.
+func (c *ResponseCache) Fetch(ctx context.Context, bt BridgeType, key string, timeout time.Duration, fetch func(context.Context) (Response, error)) (Response, CacheStatus, error) {
+	ttl := bt.ResponseCacheTTL.Duration()
+	maxAge := ttl + bt.ResponseCacheMaxStale.Duration()
+	name := bt.Name.String()
+	key = name + "/" + key
+
+	if entry, ok := c.get(key); ok && time.Since(entry.fetchedAt) < ttl {
+		promBridgeResponseCacheHits.WithLabelValues(name).Inc()
+		return entry.response, CacheHit, nil
+	}
+
+	var fetched atomic.Bool
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		fetched.Store(true)
+		fetchCtx := context.WithoutCancel(ctx)
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			fetchCtx, cancel = context.WithTimeout(fetchCtx, timeout)
+			defer cancel()
+		}
+		resp, err := fetch(fetchCtx)
+		if err == nil && resp.ok() {
+			c.put(key, resp, maxAge)
+		}
+		return resp, err
+	})
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return Response{}, CacheMiss, ctx.Err()
+	case res = <-ch:
+	}
+	resp, err := res.Val.(Response), res.Err
+	if err == nil && resp.ok() {
+		if fetched.Load() {
+			promBridgeResponseCacheMisses.WithLabelValues(name).Inc()
+			return resp, CacheMiss, nil
+		}
+		promBridgeResponseCacheHits.WithLabelValues(name).Inc()
+		return resp, CacheHit, nil
+	}
+
+	promBridgeResponseCacheMisses.WithLabelValues(name).Inc()
+	if entry, ok := c.get(key); ok && time.Since(entry.fetchedAt) <= maxAge {
+		promBridgeResponseCacheStale.WithLabelValues(name).Inc()
+		return entry.response, CacheStale, nil
+	}
+	return resp, CacheMiss, err
+}
+
+func (c *ResponseCache) get(key string) (cacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *ResponseCache) put(key string, resp Response, maxAge time.Duration) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{response: resp, fetchedAt: now, expiresAt: now.Add(maxAge)}
+
+	if now.Sub(c.lastPrune) < responseCachePruneInterval {
+		return
+	}
+	c.lastPrune = now
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+}
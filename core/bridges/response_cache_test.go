@@ -0,0 +1,199 @@
+package bridges_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestResponseCache_Fetch(t *testing.T) {
+	t.Parallel()
+
+	ok := func(body string) func(context.Context) (bridges.Response, error) {
+		return func(context.Context) (bridges.Response, error) {
+			return bridges.Response{Body: []byte(body), StatusCode: http.StatusOK}, nil
+		}
+	}
+	failed := func(context.Context) (bridges.Response, error) {
+		return bridges.Response{}, errors.New("adapter is down")
+	}
+	badStatus := func(context.Context) (bridges.Response, error) {
+		return bridges.Response{Body: []byte(`{"error":"rate limited"}`), StatusCode: http.StatusTooManyRequests}, nil
+	}
+
+	t.Run("serves fresh responses within the TTL", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "fresh", ResponseCacheTTL: models.Interval(time.Hour)}
+
+		resp, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("1"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, "1", string(resp.Body))
+
+		resp, status, err = c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("2"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheHit, status)
+		assert.Equal(t, "1", string(resp.Body))
+
+		resp, status, err = c.Fetch(testutils.Context(t), bt, "other key", time.Minute, ok("3"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, "3", string(resp.Body))
+
+		resp, status, err = c.Fetch(testutils.Context(t), bridges.BridgeType{Name: "other", ResponseCacheTTL: bt.ResponseCacheTTL}, "key", time.Minute, ok("4"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status, "keys are scoped by bridge")
+		assert.Equal(t, "4", string(resp.Body))
+	})
+
+	t.Run("refetches after the TTL", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "expired", ResponseCacheTTL: models.Interval(time.Millisecond)}
+
+		_, _, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("1"))
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		resp, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("2"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, "2", string(resp.Body))
+	})
+
+	t.Run("serves stale responses on failure", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "stale", ResponseCacheTTL: models.Interval(time.Millisecond), ResponseCacheMaxStale: models.Interval(time.Hour)}
+
+		_, _, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("1"))
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		for _, fetch := range []func(context.Context) (bridges.Response, error){failed, badStatus} {
+			resp, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, fetch)
+			require.NoError(t, err)
+			assert.Equal(t, bridges.CacheStale, status)
+			assert.Equal(t, "1", string(resp.Body))
+		}
+
+		_, status, err := c.Fetch(testutils.Context(t), bt, "other key", time.Minute, failed)
+		assert.EqualError(t, err, "adapter is down")
+		assert.Equal(t, bridges.CacheMiss, status)
+
+		resp, status, err := c.Fetch(testutils.Context(t), bt, "other key", time.Minute, badStatus)
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "failed responses are returned as they are")
+	})
+
+	t.Run("does not serve responses older than the max staleness", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "too-stale", ResponseCacheTTL: models.Interval(time.Millisecond), ResponseCacheMaxStale: models.Interval(time.Millisecond)}
+
+		_, _, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("1"))
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		_, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, failed)
+		assert.EqualError(t, err, "adapter is down")
+		assert.Equal(t, bridges.CacheMiss, status)
+	})
+
+	t.Run("does not cache failures", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "failures", ResponseCacheTTL: models.Interval(time.Hour)}
+
+		_, _, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, badStatus)
+		require.NoError(t, err)
+
+		resp, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, ok("1"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, "1", string(resp.Body))
+	})
+
+	t.Run("sends concurrent identical requests once", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "concurrent", ResponseCacheTTL: models.Interval(time.Hour)}
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fetch := func(context.Context) (bridges.Response, error) {
+			calls.Add(1)
+			<-release
+			return bridges.Response{Body: []byte("1"), StatusCode: http.StatusOK}, nil
+		}
+
+		const n = 10
+		var wg sync.WaitGroup
+		var hits atomic.Int32
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, status, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, fetch)
+				assert.NoError(t, err)
+				assert.Equal(t, "1", string(resp.Body))
+				if status == bridges.CacheHit {
+					hits.Add(1)
+				}
+			}()
+		}
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, int32(n-1), hits.Load())
+	})
+
+	t.Run("sends requests after the caller which started them gives up", func(t *testing.T) {
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "cancelled", ResponseCacheTTL: models.Interval(time.Hour)}
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fetch := func(ctx context.Context) (bridges.Response, error) {
+			calls.Add(1)
+			<-release
+			if err := ctx.Err(); err != nil {
+				return bridges.Response{}, err
+			}
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline, "requests are bounded by the timeout")
+			return bridges.Response{Body: []byte("1"), StatusCode: http.StatusOK}, nil
+		}
+
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		firstErr := make(chan error)
+		go func() {
+			_, _, err := c.Fetch(ctx, bt, "key", time.Minute, fetch)
+			firstErr <- err
+		}()
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+		second := make(chan bridges.Response)
+		go func() {
+			resp, _, err := c.Fetch(testutils.Context(t), bt, "key", time.Minute, fetch)
+			assert.NoError(t, err)
+			second <- resp
+		}()
+		cancel()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(release)
+		assert.Equal(t, "1", string((<-second).Body))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -39,6 +39,10 @@ func (disabled) UnregisterFilter(name string, qopts ...pg.QOpt) error { return E
 
 func (disabled) HasFilter(name string) bool { return false }
 
+func (disabled) LogsRetainedSince(address common.Address, eventSig common.Hash, topicValues [][]common.Hash) (time.Time, bool) {
+	return time.Time{}, false
+}
+
 func (disabled) LatestBlock(qopts ...pg.QOpt) (LogPollerBlock, error) {
 	return LogPollerBlock{}, ErrDisabled
 }
@@ -67,6 +71,10 @@ func (disabled) IndexedLogs(eventSig common.Hash, address common.Address, topicI
 	return nil, ErrDisabled
 }
 
+func (disabled) LatestIndexedLog(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) IndexedLogsByBlockRange(start, end int64, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	return nil, ErrDisabled
 }
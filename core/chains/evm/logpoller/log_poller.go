@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -39,6 +40,7 @@ type LogPoller interface {
 	RegisterFilter(filter Filter, qopts ...pg.QOpt) error
 	UnregisterFilter(name string, qopts ...pg.QOpt) error
 	HasFilter(name string) bool
+	LogsRetainedSince(address common.Address, eventSig common.Hash, topicValues [][]common.Hash) (time.Time, bool)
 	LatestBlock(qopts ...pg.QOpt) (LogPollerBlock, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
@@ -52,6 +54,7 @@ type LogPoller interface {
 
 	// Content based querying
 	IndexedLogs(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) ([]Log, error)
+	LatestIndexedLog(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error)
 	IndexedLogsByBlockRange(start, end int64, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error)
 	IndexedLogsCreatedAfter(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, after time.Time, confs Confirmations, qopts ...pg.QOpt) ([]Log, error)
 	IndexedLogsByTxHash(eventSig common.Hash, address common.Address, txHash common.Hash, qopts ...pg.QOpt) ([]Log, error)
@@ -111,6 +114,7 @@ type logPoller struct {
 
 	filterMu        sync.RWMutex
 	filters         map[string]Filter
+	filterCreatedAt map[string]time.Time // when each filter was last extended, logs of earlier blocks may be missing
 	filterDirty     bool
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
@@ -162,6 +166,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, opts Opts) *logPoller
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
 		filters:                  make(map[string]Filter),
+		filterCreatedAt:          make(map[string]time.Time),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
@@ -178,6 +183,30 @@ type Filter struct {
 	LogsPerBlock uint64             // rate limit ( maximum # of logs per block, 0 = unlimited )
 }
 
+// covers returns true if the filter retains all logs of the given event and
+// address which match topicValues.
+func (filter *Filter) covers(address common.Address, eventSig common.Hash, topicValues [][]common.Hash) bool {
+	if !slices.Contains(filter.Addresses, address) || !slices.Contains(filter.EventSigs, eventSig) {
+		return false
+	}
+	for i, filterTopics := range []evmtypes.HashArray{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(filterTopics) == 0 {
+			continue
+		}
+		// The filter only retains logs with specific values for this topic, so
+		// the requested values must be a subset of them.
+		if i >= len(topicValues) || len(topicValues[i]) == 0 {
+			return false
+		}
+		for _, v := range topicValues[i] {
+			if !slices.Contains(filterTopics, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // FilterName is a suggested convenience function for clients to construct unique filter names
 // to populate Name field of struct Filter
 func FilterName(id string, args ...any) string {
@@ -272,6 +301,7 @@ func (lp *logPoller) RegisterFilter(filter Filter, qopts ...pg.QOpt) error {
 		return pkgerrors.Wrap(err, "error inserting filter")
 	}
 	lp.filters[filter.Name] = filter
+	lp.filterCreatedAt[filter.Name] = time.Now()
 	lp.filterDirty = true
 	return nil
 }
@@ -293,6 +323,7 @@ func (lp *logPoller) UnregisterFilter(name string, qopts ...pg.QOpt) error {
 		return pkgerrors.Wrap(err, "error deleting filter")
 	}
 	delete(lp.filters, name)
+	delete(lp.filterCreatedAt, name)
 	lp.filterDirty = true
 	return nil
 }
@@ -306,6 +337,39 @@ func (lp *logPoller) HasFilter(name string) bool {
 	return ok
 }
 
+// LogsRetainedSince returns the time from which a registered filter retains
+// all logs of the given event emitted by the given address which match
+// topicValues, where topicValues[i] lists the accepted values of topic i+2 (the
+// i-th indexed argument) and an empty list accepts any value. Logs of blocks
+// with a later timestamp are all in the database, unless the LogPoller is
+// behind. It returns false if no filter retains these logs.
+//
+// A filter retains logs from the time it was registered, or last extended,
+// and for its Retention, if any.
+func (lp *logPoller) LogsRetainedSince(address common.Address, eventSig common.Hash, topicValues [][]common.Hash) (time.Time, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+
+	var (
+		since time.Time
+		found bool
+		now   = time.Now()
+	)
+	for name, filter := range lp.filters {
+		if !filter.covers(address, eventSig, topicValues) {
+			continue
+		}
+		filterSince := lp.filterCreatedAt[name]
+		if filter.Retention > 0 && filterSince.Before(now.Add(-filter.Retention)) {
+			filterSince = now.Add(-filter.Retention)
+		}
+		if !found || filterSince.Before(since) {
+			since, found = filterSince, true
+		}
+	}
+	return since, found
+}
+
 func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -454,11 +518,16 @@ func (lp *logPoller) loadFilters() error {
 	defer lp.filterMu.Unlock()
 	filters, err := lp.orm.LoadFilters(pg.WithParentCtx(lp.ctx))
 
+	if err != nil {
+		return pkgerrors.Wrapf(err, "Failed to load initial filters from db, retrying")
+	}
+	createdAt, err := lp.orm.LoadFiltersCreatedAt(pg.WithParentCtx(lp.ctx))
 	if err != nil {
 		return pkgerrors.Wrapf(err, "Failed to load initial filters from db, retrying")
 	}
 
 	lp.filters = filters
+	lp.filterCreatedAt = createdAt
 	lp.filterDirty = true
 	return nil
 }
@@ -1025,6 +1094,11 @@ func (lp *logPoller) IndexedLogs(eventSig common.Hash, address common.Address, t
 	return lp.orm.SelectIndexedLogs(address, eventSig, topicIndex, topicValues, confs, qopts...)
 }
 
+// LatestIndexedLog finds the most recent log that has a topic value in topicValues at index topicIndex.
+func (lp *logPoller) LatestIndexedLog(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error) {
+	return lp.orm.SelectLatestIndexedLog(address, eventSig, topicIndex, topicValues, confs, qopts...)
+}
+
 // IndexedLogsByBlockRange finds all the logs that have a topic value in topicValues at index topicIndex within the block range
 func (lp *logPoller) IndexedLogsByBlockRange(start, end int64, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	return lp.orm.SelectIndexedLogsByBlockRange(start, end, address, eventSig, topicIndex, topicValues, qopts...)
@@ -143,6 +143,59 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	assert.Len(t, lp.Filter(nil, nil, nil).Topics[0], 0)
 }
 
+func TestLogPoller_LogsRetainedSince(t *testing.T) {
+	t.Parallel()
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	log1 := EmitterABI.Events["Log1"].ID
+	log2 := EmitterABI.Events["Log2"].ID
+	t1 := common.HexToHash("0x01")
+	t2 := common.HexToHash("0x02")
+	now := time.Now()
+	dayAgo := now.Add(-24 * time.Hour)
+	hourAgo := now.Add(-time.Hour)
+
+	lp := &logPoller{
+		filters: map[string]Filter{
+			"any topics": {Name: "any topics", Addresses: []common.Address{a1}, EventSigs: []common.Hash{log1}},
+			"topic2 t1":  {Name: "topic2 t1", Addresses: []common.Address{a2}, EventSigs: []common.Hash{log2}, Topic2: []common.Hash{t1}},
+			"retention":  {Name: "retention", Addresses: []common.Address{a2}, EventSigs: []common.Hash{log1}, Retention: time.Minute},
+			"recent":     {Name: "recent", Addresses: []common.Address{a2}, EventSigs: []common.Hash{log2}},
+		},
+		filterCreatedAt: map[string]time.Time{
+			"any topics": dayAgo,
+			"topic2 t1":  dayAgo,
+			"retention":  dayAgo,
+			"recent":     hourAgo,
+		},
+	}
+
+	since, ok := lp.LogsRetainedSince(a1, log1, nil)
+	assert.True(t, ok)
+	assert.Equal(t, dayAgo, since)
+	since, ok = lp.LogsRetainedSince(a1, log1, [][]common.Hash{{t2}})
+	assert.True(t, ok)
+	assert.Equal(t, dayAgo, since)
+	_, ok = lp.LogsRetainedSince(a1, log2, nil)
+	assert.False(t, ok, "event not in filter")
+	_, ok = lp.LogsRetainedSince(common.HexToAddress("0x03"), log1, nil)
+	assert.False(t, ok, "address not in filter")
+
+	since, ok = lp.LogsRetainedSince(a2, log2, [][]common.Hash{{t1}})
+	assert.True(t, ok)
+	assert.Equal(t, dayAgo, since, "earliest of the covering filters")
+	since, ok = lp.LogsRetainedSince(a2, log2, nil)
+	assert.True(t, ok)
+	assert.Equal(t, hourAgo, since, "only the recent filter retains all values of topic2")
+	since, ok = lp.LogsRetainedSince(a2, log2, [][]common.Hash{{t1, t2}})
+	assert.True(t, ok)
+	assert.Equal(t, hourAgo, since, "only the recent filter retains t2")
+
+	since, ok = lp.LogsRetainedSince(a2, log1, nil)
+	assert.True(t, ok)
+	assert.WithinDuration(t, now.Add(-time.Minute), since, time.Second, "logs older than the retention are pruned")
+}
+
 func TestLogPoller_ConvertLogs(t *testing.T) {
 	t.Parallel()
 	lggr := logger.Test(t)
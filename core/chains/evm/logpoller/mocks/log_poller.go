@@ -442,6 +442,43 @@ func (_m *LogPoller) LatestBlockByEventSigsAddrsWithConfs(fromBlock int64, event
 	return r0, r1
 }
 
+// LatestIndexedLog provides a mock function with given fields: eventSig, address, topicIndex, topicValues, confs, qopts
+func (_m *LogPoller) LatestIndexedLog(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs logpoller.Confirmations, qopts ...pg.QOpt) (*logpoller.Log, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, eventSig, address, topicIndex, topicValues, confs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestIndexedLog")
+	}
+
+	var r0 *logpoller.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Hash, common.Address, int, []common.Hash, logpoller.Confirmations, ...pg.QOpt) (*logpoller.Log, error)); ok {
+		return rf(eventSig, address, topicIndex, topicValues, confs, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(common.Hash, common.Address, int, []common.Hash, logpoller.Confirmations, ...pg.QOpt) *logpoller.Log); ok {
+		r0 = rf(eventSig, address, topicIndex, topicValues, confs, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Hash, common.Address, int, []common.Hash, logpoller.Confirmations, ...pg.QOpt) error); ok {
+		r1 = rf(eventSig, address, topicIndex, topicValues, confs, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LatestLogByEventSigWithConfs provides a mock function with given fields: eventSig, address, confs, qopts
 func (_m *LogPoller) LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs logpoller.Confirmations, qopts ...pg.QOpt) (*logpoller.Log, error) {
 	_va := make([]interface{}, len(qopts))
@@ -701,6 +738,34 @@ func (_m *LogPoller) LogsDataWordRange(eventSig common.Hash, address common.Addr
 	return r0, r1
 }
 
+// LogsRetainedSince provides a mock function with given fields: address, eventSig, topicValues
+func (_m *LogPoller) LogsRetainedSince(address common.Address, eventSig common.Hash, topicValues [][]common.Hash) (time.Time, bool) {
+	ret := _m.Called(address, eventSig, topicValues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogsRetainedSince")
+	}
+
+	var r0 time.Time
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash, [][]common.Hash) (time.Time, bool)); ok {
+		return rf(address, eventSig, topicValues)
+	}
+	if rf, ok := ret.Get(0).(func(common.Address, common.Hash, [][]common.Hash) time.Time); ok {
+		r0 = rf(address, eventSig, topicValues)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Address, common.Hash, [][]common.Hash) bool); ok {
+		r1 = rf(address, eventSig, topicValues)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // LogsWithSigs provides a mock function with given fields: start, end, eventSigs, address, qopts
 func (_m *LogPoller) LogsWithSigs(start int64, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]logpoller.Log, error) {
 	_va := make([]interface{}, len(qopts))
@@ -116,6 +116,12 @@ func (o *ObservedORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
 	})
 }
 
+func (o *ObservedORM) LoadFiltersCreatedAt(qopts ...pg.QOpt) (map[string]time.Time, error) {
+	return withObservedQuery(o, "LoadFiltersCreatedAt", func() (map[string]time.Time, error) {
+		return o.ORM.LoadFiltersCreatedAt(qopts...)
+	})
+}
+
 func (o *ObservedORM) DeleteFilter(name string, qopts ...pg.QOpt) error {
 	return withObservedExec(o, "DeleteFilter", del, func() error {
 		return o.ORM.DeleteFilter(name, qopts...)
@@ -176,6 +182,12 @@ func (o *ObservedORM) SelectIndexedLogs(address common.Address, eventSig common.
 	})
 }
 
+func (o *ObservedORM) SelectLatestIndexedLog(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error) {
+	return withObservedQuery(o, "SelectLatestIndexedLog", func() (*Log, error) {
+		return o.ORM.SelectLatestIndexedLog(address, eventSig, topicIndex, topicValues, confs, qopts...)
+	})
+}
+
 func (o *ObservedORM) SelectIndexedLogsByBlockRange(start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectIndexedLogsByBlockRange", func() ([]Log, error) {
 		return o.ORM.SelectIndexedLogsByBlockRange(start, end, address, eventSig, topicIndex, topicValues, qopts...)
@@ -28,6 +28,7 @@ type ORM interface {
 	InsertFilter(filter Filter, qopts ...pg.QOpt) error
 
 	LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error)
+	LoadFiltersCreatedAt(qopts ...pg.QOpt) (map[string]time.Time, error)
 	DeleteFilter(name string, qopts ...pg.QOpt) error
 
 	DeleteBlocksBefore(end int64, limit int64, qopts ...pg.QOpt) (int64, error)
@@ -46,6 +47,7 @@ type ORM interface {
 	SelectLatestBlockByEventSigsAddrsWithConfs(fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs Confirmations, qopts ...pg.QOpt) (int64, error)
 
 	SelectIndexedLogs(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) ([]Log, error)
+	SelectLatestIndexedLog(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error)
 	SelectIndexedLogsByBlockRange(start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error)
 	SelectIndexedLogsCreatedAfter(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, after time.Time, confs Confirmations, qopts ...pg.QOpt) ([]Log, error)
 	SelectIndexedLogsTopicGreaterThan(address common.Address, eventSig common.Hash, topicIndex int, topicValueMin common.Hash, confs Confirmations, qopts ...pg.QOpt) ([]Log, error)
@@ -164,6 +166,25 @@ func (o *DbORM) LoadFilters(qopts ...pg.QOpt) (map[string]Filter, error) {
 	return filters, err
 }
 
+// LoadFiltersCreatedAt returns when each filter of this chain was last
+// extended with an address or event
+func (o *DbORM) LoadFiltersCreatedAt(qopts ...pg.QOpt) (map[string]time.Time, error) {
+	q := o.q.WithOpts(qopts...)
+	var rows []struct {
+		Name      string
+		CreatedAt time.Time
+	}
+	err := q.Select(&rows, `SELECT name, MAX(created_at) AS created_at
+		FROM evm.log_poller_filters WHERE evm_chain_id = $1
+		GROUP BY name`, ubig.New(o.chainID))
+	createdAt := make(map[string]time.Time)
+	for _, row := range rows {
+		createdAt[row.Name] = row.CreatedAt
+	}
+
+	return createdAt, err
+}
+
 func (o *DbORM) SelectBlockByHash(hash common.Hash, qopts ...pg.QOpt) (*LogPollerBlock, error) {
 	q := o.q.WithOpts(qopts...)
 	var b LogPollerBlock
@@ -705,6 +726,31 @@ func (o *DbORM) SelectIndexedLogs(address common.Address, eventSig common.Hash,
 	return logs, nil
 }
 
+// SelectLatestIndexedLog finds the most recent log that has a topic value in topicValues at index topicIndex.
+func (o *DbORM) SelectLatestIndexedLog(address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs Confirmations, qopts ...pg.QOpt) (*Log, error) {
+	args, err := newQueryArgsForEvent(o.chainID, address, eventSig).
+		withTopicIndex(topicIndex).
+		withTopicValues(topicValues).
+		withConfs(confs).
+		toArgs()
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		SELECT * FROM evm.logs
+			WHERE evm_chain_id = :evm_chain_id
+			AND address = :address
+			AND event_sig = :event_sig
+			AND topics[:topic_index] = ANY(:topic_values)
+			AND block_number <= %s
+			ORDER BY (block_number, log_index) DESC LIMIT 1`, nestedBlockNumberQuery(confs))
+	var l Log
+	if err := o.q.WithOpts(qopts...).GetNamed(query, &l, args); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
 // SelectIndexedLogsByBlockRangeFilter finds the indexed logs in a given block range.
 func (o *DbORM) SelectIndexedLogsByBlockRange(start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	args, err := newQueryArgsForEvent(o.chainID, address, eventSig).
@@ -439,6 +439,11 @@ func TestORM(t *testing.T) {
 	assert.Equal(t, filter12, filters["short retention filter"])
 	assert.Equal(t, filter2, filters["long retention filter"])
 
+	createdAt, err := o1.LoadFiltersCreatedAt()
+	require.NoError(t, err)
+	require.Len(t, createdAt, 3)
+	assert.WithinDuration(t, time.Now(), createdAt["permanent retention filter"], time.Minute)
+
 	latest, err = o1.SelectLatestBlock()
 	require.NoError(t, err)
 	require.Equal(t, int64(17), latest.BlockNumber)
@@ -596,6 +601,12 @@ func TestORM_IndexedLogs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 2, len(lgs))
 
+	lg, err := o1.SelectLatestIndexedLog(addr, eventSig, 1, []common.Hash{logpoller.EvmWord(1), logpoller.EvmWord(2), logpoller.EvmWord(4)}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, logpoller.EvmWord(2).Bytes(), lg.GetTopics()[1].Bytes())
+	_, err = o1.SelectLatestIndexedLog(addr, eventSig, 1, []common.Hash{logpoller.EvmWord(5)}, 0)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
 	lgs, err = o1.SelectIndexedLogsByBlockRange(1, 1, addr, eventSig, 1, []common.Hash{logpoller.EvmWord(1)})
 	require.NoError(t, err)
 	assert.Equal(t, 1, len(lgs))
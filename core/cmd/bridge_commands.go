@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -50,14 +51,47 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyHealth converts the latest health probe to a string
+func (p *BridgePresenter) FriendlyHealth() string {
+	switch {
+	case p.Health == nil:
+		return "-"
+	case p.Health.Healthy:
+		return "healthy"
+	default:
+		return "unhealthy: " + p.Health.Error
+	}
+}
+
+// FriendlySecurity lists the enabled mTLS and request signing options
+func (p *BridgePresenter) FriendlySecurity() string {
+	var opts []string
+	if p.TLSClientCertSet {
+		opts = append(opts, "mTLS")
+	}
+	if p.TLSRootCASet {
+		opts = append(opts, "custom CA")
+	}
+	if p.SigningSecretSet {
+		opts = append(opts, "signed")
+	}
+	if len(opts) == 0 {
+		return "-"
+	}
+	return strings.Join(opts, ", ")
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Security", "Health Check Path", "Health"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlySecurity(),
+		p.HealthCheckPath,
+		p.FriendlyHealth(),
 	})
 	render("Bridge", table)
 	return nil
@@ -67,12 +101,13 @@ type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Health"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			p.FriendlyHealth(),
 		})
 	}
 
@@ -59,6 +59,19 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.NotContains(t, output, outgoingToken)
+
+	// Render TLS, signing and health check settings
+	buffer.Reset()
+	p.TLSClientCertSet = true
+	p.SigningSecretSet = true
+	p.HealthCheckPath = "/health"
+	p.Health = &presenters.BridgeHealth{Healthy: false, Error: "adapter is down", CheckedAt: createdAt}
+	require.NoError(t, p.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "mTLS, signed")
+	assert.Contains(t, output, "/health")
+	assert.Contains(t, output, "unhealthy: adapter is down")
 }
 
 func TestShell_IndexBridges(t *testing.T) {
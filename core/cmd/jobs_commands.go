@@ -5,9 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -45,11 +50,139 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:      "pause",
+			Usage:     "Pause a job, stopping its services while keeping the job",
+			ArgsUsage: "<id>",
+			Action:    s.PauseJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "reason",
+					Usage: "why the job is paused",
+				},
+			},
+		},
+		{
+			Name:      "resume",
+			Usage:     "Resume a paused job",
+			ArgsUsage: "<id>",
+			Action:    s.ResumeJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:      "export",
+			Usage:     "Export the TOML specs of all jobs to a directory, one file per job",
+			ArgsUsage: "<dir>",
+			Action:    s.ExportJobs,
+		},
+		{
+			Name:      "apply",
+			Usage:     "Reconcile jobs to the TOML specs in a directory, matching them by externalJobID",
+			ArgsUsage: "<dir>",
+			Action:    s.ApplyJobs,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only show the plan, without changing any jobs",
+				},
+				cli.BoolFlag{
+					Name:  "delete-extras",
+					Usage: "delete jobs which are not in the directory, except for jobs managed by the Feeds Manager",
+				},
+			},
+		},
+		{
+			Name:      "upkeep-state",
+			Usage:     "Show the state of an upkeep of an automation v2.1 job: its recent ineligible checks and its last perform",
+			ArgsUsage: "<id> <upkeepID>",
+			Action:    s.ShowUpkeepState,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "limit",
+					Usage: "number of recent checks to show",
+				},
+			},
+		},
+		{
+			Name:      "replay-upkeep",
+			Usage:     "Replay the check of an upkeep of an automation v2.1 job at a block",
+			ArgsUsage: "<id> <upkeepID>",
+			Action:    s.ReplayUpkeepCheck,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "block",
+					Usage: "check block to replay the check at",
+				},
+				cli.StringFlag{
+					Name:  "log-tx-hash",
+					Usage: "hash of the transaction of the triggering log, for log trigger upkeeps",
+				},
+				cli.Int64Flag{
+					Name:  "log-index",
+					Usage: "index of the triggering log, for log trigger upkeeps",
+				},
+			},
+		},
+		{
+			Name:      "backfill-upkeep",
+			Usage:     "Re-deliver the logs of a block range to a log trigger upkeep of an automation v2.1 job",
+			ArgsUsage: "<id> <upkeepID>",
+			Action:    s.BackfillUpkeepLogs,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "from-block",
+					Usage: "first block of the range to backfill",
+				},
+				cli.Int64Flag{
+					Name:  "to-block",
+					Usage: "last block of the range to backfill",
+				},
+			},
+		},
+		{
+			Name:      "migrate-keeper",
+			Usage:     "Show a legacy keeper job and its registry, and generate or create the OCR2 automation job replacing it",
+			ArgsUsage: "<id>",
+			Action:    s.MigrateKeeperJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "contract-id",
+					Usage: "address of the OCR2 automation registry, generates the spec of the OCR2 automation job when set",
+				},
+				cli.StringFlag{
+					Name:  "contract-version",
+					Usage: "version of the OCR2 automation registry, defaults to v2.1",
+				},
+				cli.StringFlag{
+					Name:  "ocr-key-bundle-id",
+					Usage: "OCR2 key bundle of the OCR2 automation job",
+				},
+				cli.StringFlag{
+					Name:  "transmitter-id",
+					Usage: "transmitter of the OCR2 automation job, defaults to the from address of the keeper job",
+				},
+				cli.StringSliceFlag{
+					Name:  "p2pv2-bootstrapper",
+					Usage: "P2P bootstrapper of the OCR2 automation job, can be repeated",
+				},
+				cli.StringFlag{
+					Name:  "mercury-credential-name",
+					Usage: "mercury credentials of the OCR2 automation job",
+				},
+				cli.BoolFlag{
+					Name:  "create",
+					Usage: "create the OCR2 automation job, both jobs perform upkeeps until the migration is confirmed",
+				},
+				cli.BoolFlag{
+					Name:  "confirm",
+					Usage: "confirm the migration once the upkeeps were migrated to the OCR2 automation registry, the keeper job then stops performing upkeeps",
+				},
+			},
+		},
 	}
 }
 
@@ -286,6 +419,51 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob pauses a job
+func (s *Shell) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be paused"))
+	}
+
+	request, err := json.Marshal(web.PauseJobRequest{
+		Reason: c.String("reason"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/pause", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job paused")
+}
+
+// ResumeJob resumes a paused job
+func (s *Shell) ResumeJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job resumed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -305,3 +483,417 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// ExportJobs writes the TOML spec of every job to <dir>/<externalJobID>.toml.
+// Jobs created before their specs were recorded are skipped.
+func (s *Shell) ExportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the directory to export jobs to"))
+	}
+	dir := c.Args().First()
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/export")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var exports []presenters.JobExportResource
+	if err = s.deserializeAPIResponse(resp, &exports, &jsonapi.Links{}); err != nil {
+		return s.errorOut(err)
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return s.errorOut(err)
+	}
+
+	for _, e := range exports {
+		path := filepath.Join(dir, e.ExternalJobID.String()+".toml")
+		if err = os.WriteFile(path, []byte(e.TOML), 0600); err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	fmt.Printf("Exported %d jobs to %s\n", len(exports), dir)
+	return nil
+}
+
+// ApplyJobs reconciles the jobs of the node to the TOML specs in a directory.
+func (s *Shell) ApplyJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the directory of job specs to apply"))
+	}
+	dir := c.Args().First()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.toml"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	sort.Strings(paths)
+
+	request := web.ApplyJobsRequest{
+		Specs:        []web.ApplyJobSpec{},
+		DeleteExtras: c.Bool("delete-extras"),
+		DryRun:       c.Bool("dry-run"),
+	}
+	for _, path := range paths {
+		b, rerr := os.ReadFile(path)
+		if rerr != nil {
+			return s.errorOut(rerr)
+		}
+		request.Specs = append(request.Specs, web.ApplyJobSpec{
+			Source: filepath.Base(path),
+			TOML:   string(b),
+		})
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/apply", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var actions JobApplyActionPresenters
+	header := "Job apply"
+	if request.DryRun {
+		header = "Job apply plan (dry run)"
+	}
+	if err = s.renderAPIResponse(resp, &actions, header); err != nil {
+		return err
+	}
+
+	for _, a := range actions {
+		if a.Status == web.JobApplyStatusFailed {
+			return s.errorOut(errors.Errorf("failed to %s job from %s: %s", a.Action, a.Source, a.Error))
+		}
+	}
+	return nil
+}
+
+// JobApplyActionPresenter wraps the JSONAPI JobApplyAction Resource and adds
+// rendering functionality
+type JobApplyActionPresenter struct {
+	JAID
+	presenters.JobApplyActionResource
+}
+
+// ToRow presents the JobApplyActionResource as a slice of strings.
+func (p *JobApplyActionPresenter) ToRow() []string {
+	return []string{
+		p.Action,
+		p.Source,
+		p.JobID,
+		p.ExternalJobID.String(),
+		p.Name,
+		p.Type.String(),
+		p.Status,
+		p.Error,
+	}
+}
+
+var jobApplyActionHeaders = []string{"Action", "Source", "Job ID", "External Job ID", "Name", "Type", "Status", "Error"}
+
+type JobApplyActionPresenters []JobApplyActionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobApplyActionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobApplyActionHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Job Actions", table)
+	return nil
+}
+
+// ShowUpkeepState shows the state of an upkeep of an automation v2.1 job.
+func (s *Shell) ShowUpkeepState(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the upkeep id"))
+	}
+	path := "/v2/jobs/" + c.Args().Get(0) + "/upkeeps/" + c.Args().Get(1)
+	if c.IsSet("limit") {
+		path += fmt.Sprintf("?limit=%d", c.Int("limit"))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UpkeepStatePresenter{})
+}
+
+// ReplayUpkeepCheck replays the check of an upkeep of an automation v2.1 job.
+func (s *Shell) ReplayUpkeepCheck(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the upkeep id"))
+	}
+	if !c.IsSet("block") {
+		return s.errorOut(errors.New("must pass the block to replay the check at"))
+	}
+
+	request, err := json.Marshal(web.ReplayUpkeepCheckRequest{
+		Block:     c.Int64("block"),
+		LogTxHash: c.String("log-tx-hash"),
+		LogIndex:  c.Int64("log-index"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/upkeeps/"+c.Args().Get(1)+"/replay", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UpkeepCheckResultPresenter{}, "Replayed check")
+}
+
+// BackfillUpkeepLogs requests the logs of a block range to be re-delivered to
+// a log trigger upkeep of an automation v2.1 job.
+func (s *Shell) BackfillUpkeepLogs(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the upkeep id"))
+	}
+	if !c.IsSet("from-block") || !c.IsSet("to-block") {
+		return s.errorOut(errors.New("must pass the block range to backfill"))
+	}
+
+	request, err := json.Marshal(web.BackfillUpkeepLogsRequest{
+		FromBlock: c.Int64("from-block"),
+		ToBlock:   c.Int64("to-block"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/upkeeps/"+c.Args().Get(1)+"/backfill", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogUpkeepBackfillPresenter{}, "Backfill requested")
+}
+
+// MigrateKeeperJob shows the state of a legacy keeper job and its migration.
+// When an OCR2 automation registry is passed, it generates the spec of the
+// OCR2 automation job replacing the keeper job, and creates it if requested.
+// Once created, the migration is confirmed with --confirm.
+func (s *Shell) MigrateKeeperJob(c *cli.Context) (err error) {
+	if c.NArg() != 1 {
+		return s.errorOut(errors.New("must pass the id of the keeper job"))
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/keeper_migration"
+
+	var resp *http.Response
+	if c.Bool("confirm") {
+		if c.IsSet("contract-id") || c.Bool("create") {
+			return s.errorOut(errors.New("cannot confirm a migration while creating it"))
+		}
+		resp, err = s.HTTP.Post(s.ctx(), path+"/confirm", nil)
+	} else if !c.IsSet("contract-id") {
+		if c.Bool("create") {
+			return s.errorOut(errors.New("must pass the OCR2 automation registry to create the OCR2 automation job"))
+		}
+		resp, err = s.HTTP.Get(s.ctx(), path)
+	} else {
+		var request []byte
+		request, err = json.Marshal(web.KeeperMigrationRequest{
+			ContractID:            c.String("contract-id"),
+			ContractVersion:       c.String("contract-version"),
+			OCRKeyBundleID:        c.String("ocr-key-bundle-id"),
+			TransmitterID:         c.String("transmitter-id"),
+			P2PV2Bootstrappers:    c.StringSlice("p2pv2-bootstrapper"),
+			MercuryCredentialName: c.String("mercury-credential-name"),
+			Create:                c.Bool("create"),
+		})
+		if err != nil {
+			return s.errorOut(err)
+		}
+		resp, err = s.HTTP.Post(s.ctx(), path, bytes.NewReader(request))
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeeperMigrationPresenter{})
+}
+
+// UpkeepStatePresenter wraps the JSONAPI UpkeepState Resource and adds
+// rendering functionality
+type UpkeepStatePresenter struct {
+	JAID
+	presenters.UpkeepStateResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepStatePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Upkeep ID", "Last Checked Block", "Eligible", "Ineligibility Reason", "Last Performed Tx", "Last Performed Block", "Last Perform Success"})
+	lastPerformedBlock := ""
+	if p.LastPerformedTxHash != "" {
+		lastPerformedBlock = fmt.Sprint(p.LastPerformedBlock)
+	}
+	table.Append([]string{
+		p.UpkeepID,
+		fmt.Sprint(p.LastCheckedBlock),
+		fmt.Sprint(p.Eligible),
+		p.IneligibilityReason,
+		p.LastPerformedTxHash,
+		lastPerformedBlock,
+		fmt.Sprint(p.LastPerformSuccess),
+	})
+	render("Upkeep State", table)
+
+	checks := rt.newTable([]string{"Work ID", "Block", "Eligible", "Ineligibility Reason", "Performed Tx", "Inserted At"})
+	for _, check := range p.Checks {
+		insertedAt := ""
+		if check.InsertedAt != nil {
+			insertedAt = check.InsertedAt.Format(time.RFC3339)
+		}
+		checks.Append([]string{
+			check.WorkID,
+			fmt.Sprint(check.BlockNumber),
+			fmt.Sprint(check.Eligible),
+			check.IneligibilityReason,
+			check.PerformedTxHash,
+			insertedAt,
+		})
+	}
+	render("Checks", checks)
+	return nil
+}
+
+// UpkeepCheckResultPresenter wraps the JSONAPI UpkeepCheckResult Resource and
+// adds rendering functionality
+type UpkeepCheckResultPresenter struct {
+	JAID
+	presenters.UpkeepCheckResultResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepCheckResultPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Upkeep ID", "Work ID", "Check Block", "Eligible", "Ineligibility Reason", "Pipeline Execution State", "Retryable", "Gas Allocated", "Perform Data"})
+	table.Append([]string{
+		p.UpkeepID,
+		p.WorkID,
+		fmt.Sprint(p.CheckBlock),
+		fmt.Sprint(p.Eligible),
+		p.IneligibilityReason,
+		p.PipelineExecutionState,
+		fmt.Sprint(p.Retryable),
+		fmt.Sprint(p.GasAllocated),
+		p.PerformData,
+	})
+	render("Upkeep Check Result", table)
+	return nil
+}
+
+// LogUpkeepBackfillPresenter wraps the JSONAPI LogUpkeepBackfill Resource and
+// adds rendering functionality
+type LogUpkeepBackfillPresenter struct {
+	JAID
+	presenters.LogUpkeepBackfillResource
+}
+
+// RenderTable implements TableRenderer
+func (p *LogUpkeepBackfillPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Upkeep ID", "From Block", "To Block", "Logs", "Created At", "Processed At"})
+	processedAt := ""
+	if p.ProcessedAt != nil {
+		processedAt = p.ProcessedAt.Format(time.RFC3339)
+	}
+	table.Append([]string{
+		p.JAID.ID,
+		p.UpkeepID,
+		fmt.Sprint(p.FromBlock),
+		fmt.Sprint(p.ToBlock),
+		fmt.Sprint(p.Logs),
+		p.CreatedAt.Format(time.RFC3339),
+		processedAt,
+	})
+	render("Upkeep Backfill", table)
+	return nil
+}
+
+// KeeperMigrationPresenter wraps the JSONAPI KeeperMigration Resource and adds
+// rendering functionality
+type KeeperMigrationPresenter struct {
+	JAID
+	presenters.KeeperMigrationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeeperMigrationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Keeper Job ID", "EVM Chain ID", "Registry", "Registry Version", "From Address", "Keeper Index", "Num Keepers", "Upkeeps"})
+	table.Append([]string{
+		fmt.Sprint(p.KeeperJobID),
+		p.EVMChainID,
+		p.RegistryAddress,
+		p.RegistryVersion,
+		p.FromAddress,
+		fmt.Sprint(p.KeeperIndex),
+		fmt.Sprint(p.NumKeepers),
+		fmt.Sprint(len(p.UpkeepIDs)),
+	})
+	render("Keeper Job", table)
+
+	migration := rt.newTable([]string{"Status", "OCR2 Job ID", "Migrated At", "Confirmed At"})
+	var ocr2JobID, migratedAt, confirmedAt string
+	if p.OCR2JobID != nil {
+		ocr2JobID = fmt.Sprint(*p.OCR2JobID)
+	}
+	if p.MigratedAt != nil {
+		migratedAt = p.MigratedAt.Format(time.RFC3339)
+	}
+	if p.ConfirmedAt != nil {
+		confirmedAt = p.ConfirmedAt.Format(time.RFC3339)
+	}
+	migration.Append([]string{p.Status, ocr2JobID, migratedAt, confirmedAt})
+	render("Migration", migration)
+
+	if p.AutomationSpec != "" {
+		fmt.Fprintln(rt.Writer, "OCR2 Automation Job Spec:")
+		fmt.Fprintln(rt.Writer, p.AutomationSpec)
+	}
+	if len(p.DroppedSettings) > 0 {
+		fmt.Fprintln(rt.Writer, "Keeper job settings not carried over:")
+		for _, setting := range p.DroppedSettings {
+			fmt.Fprintln(rt.Writer, "- "+setting)
+		}
+	}
+	return nil
+}
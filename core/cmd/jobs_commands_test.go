@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -87,6 +90,104 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestUpkeepStatePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		insertedAt = time.Now()
+		buffer     = bytes.NewBufferString("")
+		r          = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UpkeepStatePresenter{
+		UpkeepStateResource: presenters.UpkeepStateResource{
+			UpkeepID:            "1234",
+			LastCheckedBlock:    120,
+			IneligibilityReason: "upkeepNotNeeded",
+			LastPerformedTxHash: "0xabcd",
+			LastPerformedBlock:  101,
+			Checks: []presenters.UpkeepCheckRecord{
+				presenters.NewUpkeepCheckRecord("work1", 120, 4, insertedAt),
+				presenters.NewPerformedUpkeepCheckRecord("work0", 100, "0xabcd"),
+			},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, "0xabcd")
+	assert.Contains(t, output, "101")
+	assert.Contains(t, output, "work1")
+	assert.Contains(t, output, "work0")
+	assert.Contains(t, output, "upkeepNotNeeded")
+	assert.Contains(t, output, insertedAt.Format(time.RFC3339))
+}
+
+func TestLogUpkeepBackfillPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.LogUpkeepBackfillPresenter{
+		JAID: cmd.JAID{ID: "7"},
+		LogUpkeepBackfillResource: presenters.LogUpkeepBackfillResource{
+			UpkeepID:  "1234",
+			FromBlock: 100,
+			ToBlock:   200,
+			CreatedAt: createdAt,
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, "100")
+	assert.Contains(t, output, "200")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+}
+
+func TestKeeperMigrationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ocr2JobID  = int32(12)
+		migratedAt = time.Now()
+		buffer     = bytes.NewBufferString("")
+		r          = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.KeeperMigrationPresenter{
+		JAID: cmd.JAID{ID: "7"},
+		KeeperMigrationResource: presenters.KeeperMigrationResource{
+			KeeperJobID:     7,
+			EVMChainID:      "4",
+			RegistryAddress: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+			RegistryVersion: "v1.3",
+			UpkeepIDs:       []string{"1", "2"},
+			Status:          "shadow",
+			OCR2JobID:       &ocr2JobID,
+			MigratedAt:      &migratedAt,
+			AutomationSpec:  `pluginType = "ocr2automation"`,
+			DroppedSettings: []string{"gasLimit: 500000 is not used"},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba")
+	assert.Contains(t, output, "v1.3")
+	assert.Contains(t, output, "shadow")
+	assert.Contains(t, output, "12")
+	assert.Contains(t, output, migratedAt.Format(time.RFC3339))
+	assert.Contains(t, output, `pluginType = "ocr2automation"`)
+	assert.Contains(t, output, "gasLimit: 500000 is not used")
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -455,3 +556,96 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestShell_ExportApplyJobs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+	})
+	client, r := app.NewShellAndRenderer()
+
+	externalJobID := uuid.New()
+	specDir := t.TempDir()
+	spec := fmt.Sprintf(testspecs.CronSpecTemplate, externalJobID)
+	require.NoError(t, os.WriteFile(filepath.Join(specDir, "cron.toml"), []byte(spec), 0600))
+
+	// Dry run
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ApplyJobs, set, "")
+	require.NoError(t, set.Set("dry-run", "true"))
+	require.NoError(t, set.Parse([]string{specDir}))
+	require.NoError(t, client.ApplyJobs(cli.NewContext(nil, set, nil)))
+
+	requireJobsCount(t, app.JobORM(), 0)
+	actions := *r.Renders[0].(*cmd.JobApplyActionPresenters)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "create", actions[0].Action)
+	assert.Equal(t, "cron.toml", actions[0].Source)
+	assert.Equal(t, "planned", actions[0].Status)
+
+	// Apply
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ApplyJobs, set, "")
+	require.NoError(t, set.Parse([]string{specDir}))
+	require.NoError(t, client.ApplyJobs(cli.NewContext(nil, set, nil)))
+
+	requireJobsCount(t, app.JobORM(), 1)
+
+	// Export
+	exportDir := filepath.Join(t.TempDir(), "export")
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ExportJobs, set, "")
+	require.NoError(t, set.Parse([]string{exportDir}))
+	require.NoError(t, client.ExportJobs(cli.NewContext(nil, set, nil)))
+
+	exported, err := os.ReadFile(filepath.Join(exportDir, externalJobID.String()+".toml"))
+	require.NoError(t, err)
+	assert.Equal(t, spec, string(exported))
+}
+
+func TestShell_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+	})
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{getDirectRequestSpec()}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	require.Equal(t, "must pass the job id to be paused", client.PauseJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.PauseJob, set, "")
+	require.NoError(t, set.Set("reason", "maintenance"))
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.PauseJob(cli.NewContext(nil, set, nil)))
+
+	paused := *r.Renders[1].(*cmd.JobPresenter)
+	require.NotNil(t, paused.PausedAt)
+	assert.Equal(t, "maintenance", paused.PauseReason)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ResumeJob, set, "")
+	require.NoError(t, set.Parse([]string{output.ID}))
+	require.NoError(t, client.ResumeJob(cli.NewContext(nil, set, nil)))
+
+	resumed := *r.Renders[2].(*cmd.JobPresenter)
+	assert.Nil(t, resumed.PausedAt)
+}
@@ -168,6 +168,8 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		LatestReportTTL:      cfg.Mercury().Cache().LatestReportTTL(),
 		MaxStaleAge:          cfg.Mercury().Cache().MaxStaleAge(),
 		LatestReportDeadline: cfg.Mercury().Cache().LatestReportDeadline(),
+		StaleFallbackAge:     cfg.Mercury().Cache().StaleFallbackAge(),
+		SharedAcrossServers:  cfg.Mercury().Cache().SharedAcrossServers(),
 	})
 
 	// create the relayer-chain interoperators from application configuration
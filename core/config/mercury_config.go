@@ -10,14 +10,22 @@ type MercuryCache interface {
 	LatestReportTTL() time.Duration
 	MaxStaleAge() time.Duration
 	LatestReportDeadline() time.Duration
+	StaleFallbackAge() time.Duration
+	SharedAcrossServers() bool
 }
 
 type MercuryTLS interface {
 	CertFile() string
 }
 
+type MercuryStreams interface {
+	ObservationInterval() time.Duration
+	MaxStaleness() time.Duration
+}
+
 type Mercury interface {
 	Credentials(credName string) *types.MercuryCredentials
 	Cache() MercuryCache
 	TLS() MercuryTLS
+	Streams() MercuryStreams
 }
@@ -1272,6 +1272,8 @@ type MercuryCache struct {
 	LatestReportTTL      *commonconfig.Duration
 	MaxStaleAge          *commonconfig.Duration
 	LatestReportDeadline *commonconfig.Duration
+	StaleFallbackAge     *commonconfig.Duration
+	SharedAcrossServers  *bool
 }
 
 func (mc *MercuryCache) setFrom(f *MercuryCache) {
@@ -1284,6 +1286,27 @@ func (mc *MercuryCache) setFrom(f *MercuryCache) {
 	if v := f.LatestReportDeadline; v != nil {
 		mc.LatestReportDeadline = v
 	}
+	if v := f.StaleFallbackAge; v != nil {
+		mc.StaleFallbackAge = v
+	}
+	if v := f.SharedAcrossServers; v != nil {
+		mc.SharedAcrossServers = v
+	}
+}
+
+func (mc *MercuryCache) ValidateConfig() (err error) {
+	if mc.StaleFallbackAge == nil || mc.StaleFallbackAge.Duration() == 0 || mc.LatestReportTTL == nil || mc.MaxStaleAge == nil {
+		return
+	}
+	if mc.LatestReportTTL.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "StaleFallbackAge", Value: mc.StaleFallbackAge.String(),
+			Msg: "requires caching to be enabled with LatestReportTTL"})
+	} else if mc.MaxStaleAge.Duration() > 0 && mc.StaleFallbackAge.Duration() > mc.LatestReportTTL.Duration()+mc.MaxStaleAge.Duration() {
+		// reports are garbage collected after LatestReportTTL+MaxStaleAge
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "StaleFallbackAge", Value: mc.StaleFallbackAge.String(),
+			Msg: fmt.Sprintf("must not exceed LatestReportTTL+MaxStaleAge (%s)", mc.LatestReportTTL.Duration()+mc.MaxStaleAge.Duration())})
+	}
+	return
 }
 
 type MercuryTLS struct {
@@ -1305,18 +1328,47 @@ func (m *MercuryTLS) ValidateConfig() (err error) {
 	return
 }
 
+type MercuryStreams struct {
+	ObservationInterval *commonconfig.Duration
+	MaxStaleness        *commonconfig.Duration
+}
+
+func (m *MercuryStreams) setFrom(f *MercuryStreams) {
+	if v := f.ObservationInterval; v != nil {
+		m.ObservationInterval = v
+	}
+	if v := f.MaxStaleness; v != nil {
+		m.MaxStaleness = v
+	}
+}
+
+func (m *MercuryStreams) ValidateConfig() (err error) {
+	if m.ObservationInterval == nil || m.MaxStaleness == nil {
+		return
+	}
+	if m.ObservationInterval.Duration() > 0 && m.MaxStaleness.Duration() < m.ObservationInterval.Duration() {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxStaleness", Value: m.MaxStaleness.String(),
+			Msg: fmt.Sprintf("must be at least ObservationInterval (%s)", m.ObservationInterval.String())})
+	}
+	return
+}
+
 type Mercury struct {
-	Cache MercuryCache `toml:",omitempty"`
-	TLS   MercuryTLS   `toml:",omitempty"`
+	Cache   MercuryCache   `toml:",omitempty"`
+	TLS     MercuryTLS     `toml:",omitempty"`
+	Streams MercuryStreams `toml:",omitempty"`
 }
 
 func (m *Mercury) setFrom(f *Mercury) {
 	m.Cache.setFrom(&f.Cache)
 	m.TLS.setFrom(&f.TLS)
+	m.Streams.setFrom(&f.Streams)
 }
 
 func (m *Mercury) ValidateConfig() (err error) {
-	return m.TLS.ValidateConfig()
+	err = multierr.Append(err, m.TLS.ValidateConfig())
+	err = multierr.Append(err, m.Streams.ValidateConfig())
+	return
 }
 
 type MercuryCredentials struct {
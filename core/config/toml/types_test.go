@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -577,5 +578,73 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 	}
 }
 
+func TestMercuryCache_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		latestReportTTL  time.Duration
+		maxStaleAge      time.Duration
+		staleFallbackAge time.Duration
+		errMsg           string
+	}{
+		{name: "fallback disabled", latestReportTTL: time.Second, maxStaleAge: time.Hour, staleFallbackAge: 0},
+		{name: "valid", latestReportTTL: time.Second, maxStaleAge: time.Hour, staleFallbackAge: time.Minute},
+		{name: "without garbage collection", latestReportTTL: time.Second, maxStaleAge: 0, staleFallbackAge: 24 * time.Hour},
+		{name: "caching disabled", latestReportTTL: 0, maxStaleAge: time.Hour, staleFallbackAge: time.Minute,
+			errMsg: "StaleFallbackAge: invalid value (1m0s): requires caching to be enabled with LatestReportTTL"},
+		{name: "exceeds garbage collection age", latestReportTTL: time.Second, maxStaleAge: time.Minute, staleFallbackAge: time.Hour,
+			errMsg: "StaleFallbackAge: invalid value (1h0m0s): must not exceed LatestReportTTL+MaxStaleAge (1m1s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := &MercuryCache{
+				LatestReportTTL:  commonconfig.MustNewDuration(tt.latestReportTTL),
+				MaxStaleAge:      commonconfig.MustNewDuration(tt.maxStaleAge),
+				StaleFallbackAge: commonconfig.MustNewDuration(tt.staleFallbackAge),
+			}
+
+			err := cache.ValidateConfig()
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMercuryStreams_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name                string
+		observationInterval time.Duration
+		maxStaleness        time.Duration
+		errMsg              string
+	}{
+		{name: "scheduling disabled", observationInterval: 0, maxStaleness: 0},
+		{name: "valid", observationInterval: time.Second, maxStaleness: 5 * time.Second},
+		{name: "max staleness equal to interval", observationInterval: time.Second, maxStaleness: time.Second},
+		{name: "max staleness below interval", observationInterval: time.Second, maxStaleness: 500 * time.Millisecond,
+			errMsg: "MaxStaleness: invalid value (500ms): must be at least ObservationInterval (1s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streams := &MercuryStreams{
+				ObservationInterval: commonconfig.MustNewDuration(tt.observationInterval),
+				MaxStaleness:        commonconfig.MustNewDuration(tt.maxStaleness),
+			}
+
+			err := streams.ValidateConfig()
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
@@ -66,8 +66,9 @@ func NewPeerID() (id ragep2ptypes.PeerID) {
 }
 
 type BridgeOpts struct {
-	Name string
-	URL  string
+	Name          string
+	URL           string
+	SigningSecret string
 }
 
 // NewBridgeType create new bridge type given info slice
@@ -88,6 +89,7 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 	} else {
 		btr.URL = WebURL(t, fmt.Sprintf("https://bridge.example.com/api?%s", rnd))
 	}
+	btr.SigningSecret = opts.SigningSecret
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	require.NoError(t, err)
@@ -100,6 +102,9 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 func MustCreateBridge(t testing.TB, db *sqlx.DB, opts BridgeOpts, cfg pg.QConfig) (bta *bridges.BridgeTypeAuthentication, bt *bridges.BridgeType) {
 	bta, bt = NewBridgeType(t, opts)
 	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+	if opts.SigningSecret != "" {
+		orm = bridges.NewORMWithSecrets(db, logger.TestLogger(t), cfg, NewKeyStore(t, db, cfg))
+	}
 	err := orm.CreateBridgeType(bt)
 	require.NoError(t, err)
 	return bta, bt
@@ -33,12 +33,16 @@ import (
 
 	sqlx "github.com/jmoiron/sqlx"
 
+	threshold "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 
 	uuid "github.com/google/uuid"
 
+	v2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
@@ -107,6 +111,26 @@ func (_m *Application) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return r0
 }
 
+// BridgeHealthMonitor provides a mock function with given fields:
+func (_m *Application) BridgeHealthMonitor() bridges.HealthMonitor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealthMonitor")
+	}
+
+	var r0 bridges.HealthMonitor
+	if rf, ok := ret.Get(0).(func() bridges.HealthMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bridges.HealthMonitor)
+		}
+	}
+
+	return r0
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -443,6 +467,54 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID, reason
+func (_m *Application) PauseJob(ctx context.Context, jobID int32, reason string) error {
+	ret := _m.Called(ctx, jobID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) error); ok {
+		r0 = rf(ctx, jobID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PendingDecryptionRequests provides a mock function with given fields: jobID
+func (_m *Application) PendingDecryptionRequests(jobID int32) ([]threshold.PendingRequest, bool) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingDecryptionRequests")
+	}
+
+	var r0 []threshold.PendingRequest
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(int32) ([]threshold.PendingRequest, bool)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) []threshold.PendingRequest); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threshold.PendingRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) bool); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -481,6 +553,24 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -649,6 +739,36 @@ func (_m *Application) TxmStorageService() txmgr.EvmTxStore {
 	return r0
 }
 
+// VRFRequestQueues provides a mock function with given fields: jobID
+func (_m *Application) VRFRequestQueues(jobID int32) (map[string][]v2.QueuedRequest, bool) {
+	ret := _m.Called(jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFRequestQueues")
+	}
+
+	var r0 map[string][]v2.QueuedRequest
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(int32) (map[string][]v2.QueuedRequest, bool)); ok {
+		return rf(jobID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) map[string][]v2.QueuedRequest); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]v2.QueuedRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) bool); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -54,6 +54,8 @@ const (
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -72,10 +74,12 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
-	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
-	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
-	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
-	JobProposalSpecRejected EventID = "JOB_PROPOSAL_SPEC_REJECTED"
+	JobProposalSpecApproved            EventID = "JOB_PROPOSAL_SPEC_APPROVED"
+	JobProposalSpecUpdated             EventID = "JOB_PROPOSAL_SPEC_UPDATED"
+	JobProposalSpecCanceled            EventID = "JOB_PROPOSAL_SPEC_CANCELED"
+	JobProposalSpecRejected            EventID = "JOB_PROPOSAL_SPEC_REJECTED"
+	JobProposalSpecApprovalScheduled   EventID = "JOB_PROPOSAL_SPEC_APPROVAL_SCHEDULED"
+	JobProposalSpecApprovalUnscheduled EventID = "JOB_PROPOSAL_SPEC_APPROVAL_UNSCHEDULED"
 
 	ConfigUpdated            EventID = "CONFIG_UPDATED"
 	ConfigSqlLoggingEnabled  EventID = "CONFIG_SQL_LOGGING_ENABLED"
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
@@ -12,9 +13,17 @@ import (
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
 	v2plus "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2plus_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
+// Versions of the VRF coordinators a feeder job can read from.
+const (
+	CoordinatorVersionV1     = "v1"
+	CoordinatorVersionV2     = "v2"
+	CoordinatorVersionV2Plus = "v2plus"
+)
+
 var (
 	_ Coordinator = MultiCoordinator{}
 	_ Coordinator = &V1Coordinator{}
@@ -64,6 +73,66 @@ func (m MultiCoordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([
 	return fuls, nil
 }
 
+// VersionedCoordinator is a Coordinator along with the version of the VRF coordinator
+// contract it reads from.
+type VersionedCoordinator struct {
+	Coordinator
+	Version string
+}
+
+// NewCoordinators creates a Coordinator for each of the given coordinator addresses that is
+// set, in V1, V2, V2Plus order.
+func NewCoordinators(
+	backend bind.ContractBackend,
+	lp logpoller.LogPoller,
+	v1Address, v2Address, v2PlusAddress *ethkey.EIP55Address,
+) ([]VersionedCoordinator, error) {
+	var coordinators []VersionedCoordinator
+	if v1Address != nil {
+		c, err := v1.NewVRFCoordinator(v1Address.Address(), backend)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V1 coordinator")
+		}
+		coord, err := NewV1Coordinator(c, lp)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V1 coordinator")
+		}
+		coordinators = append(coordinators, VersionedCoordinator{coord, CoordinatorVersionV1})
+	}
+	if v2Address != nil {
+		c, err := v2.NewVRFCoordinatorV2(v2Address.Address(), backend)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V2 coordinator")
+		}
+		coord, err := NewV2Coordinator(c, lp)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V2 coordinator")
+		}
+		coordinators = append(coordinators, VersionedCoordinator{coord, CoordinatorVersionV2})
+	}
+	if v2PlusAddress != nil {
+		c, err := v2plus.NewIVRFCoordinatorV2PlusInternal(v2PlusAddress.Address(), backend)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V2Plus coordinator")
+		}
+		coord, err := NewV2PlusCoordinator(c, lp)
+		if err != nil {
+			return nil, errors.Wrap(err, "building V2Plus coordinator")
+		}
+		coordinators = append(coordinators, VersionedCoordinator{coord, CoordinatorVersionV2Plus})
+	}
+	return coordinators, nil
+}
+
+// Unversioned returns the given coordinators without their versions.
+func Unversioned(coordinators []VersionedCoordinator) []Coordinator {
+	var result []Coordinator
+	for _, c := range coordinators {
+		result = append(result, c.Coordinator)
+	}
+	return result
+}
+
 // V1Coordinator fetches request and fulfillment logs from a VRF V1 coordinator contract.
 type V1Coordinator struct {
 	c  v1.VRFCoordinatorInterface
@@ -3,18 +3,17 @@ package blockhashstore
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
-	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/trusted_blockhash_store"
-	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
-	v2plus "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2plus_interface"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
@@ -30,18 +29,22 @@ type Delegate struct {
 	logger       logger.Logger
 	legacyChains legacyevm.LegacyChainContainer
 	ks           keystore.Eth
+	gapSchedule  *GapSchedule
 }
 
-// NewDelegate creates a new Delegate.
+// NewDelegate creates a new Delegate. The gaps detected by its jobs are scheduled in
+// gapSchedule, to be filled by the blockheaderfeeder jobs of the same blockhash store.
 func NewDelegate(
 	logger logger.Logger,
 	legacyChains legacyevm.LegacyChainContainer,
 	ks keystore.Eth,
+	gapSchedule *GapSchedule,
 ) *Delegate {
 	return &Delegate{
 		logger:       logger,
 		legacyChains: legacyChains,
 		ks:           ks,
+		gapSchedule:  gapSchedule,
 	}
 }
 
@@ -97,51 +100,15 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	}
 
 	lp := chain.LogPoller()
-	var coordinators []Coordinator
-	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
-		var c *v1.VRFCoordinator
-		if c, err = v1.NewVRFCoordinator(
-			jb.BlockhashStoreSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-
-		var coord *V1Coordinator
-		coord, err = NewV1Coordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
-		var c *v2.VRFCoordinatorV2
-		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockhashStoreSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V2 coordinator")
-		}
-
-		var coord *V2Coordinator
-		coord, err = NewV2Coordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
-		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockhashStoreSpec.CoordinatorV2PlusAddress != nil {
-		var c v2plus.IVRFCoordinatorV2PlusInternalInterface
-		if c, err = v2plus.NewIVRFCoordinatorV2PlusInternal(
-			jb.BlockhashStoreSpec.CoordinatorV2PlusAddress.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V2Plus coordinator")
-		}
-
-		var coord *V2PlusCoordinator
-		coord, err = NewV2PlusCoordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V2Plus coordinator")
-		}
-		coordinators = append(coordinators, coord)
+	coordinators, err := NewCoordinators(
+		chain.Client(),
+		lp,
+		jb.BlockhashStoreSpec.CoordinatorV1Address,
+		jb.BlockhashStoreSpec.CoordinatorV2Address,
+		jb.BlockhashStoreSpec.CoordinatorV2PlusAddress,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	bpBHS, err := NewBulletproofBHS(
@@ -161,7 +128,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	log := d.logger.Named("BHSFeeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
 	feeder := NewFeeder(
 		log,
-		NewMultiCoordinator(coordinators...),
+		NewMultiCoordinator(Unversioned(coordinators)...),
 		bpBHS,
 		lp,
 		jb.BlockhashStoreSpec.TrustedBlockhashStoreBatchSize,
@@ -176,11 +143,31 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 			return uint64(head.BlockNumber), nil
 		})
 
+	gapDetector := NewGapDetector(
+		log,
+		coordinators,
+		bpBHS,
+		int(jb.BlockhashStoreSpec.LookbackBlocks),
+		func(ctx context.Context) (uint64, error) {
+			head, err := lp.LatestBlock(pg.WithParentCtx(ctx))
+			if err != nil {
+				return 0, errors.Wrap(err, "getting chain head")
+			}
+			return uint64(head.BlockNumber), nil
+		})
+
 	return []job.ServiceCtx{&service{
-		feeder:     feeder,
-		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
-		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
-		logger:     log,
+		feeder:        feeder,
+		gapDetector:   gapDetector,
+		gapSchedule:   d.gapSchedule,
+		chainID:       chain.ID(),
+		bhsAddress:    bhs.Address(),
+		jobID:         jb.ID,
+		jobName:       jb.Name.ValueOrZero(),
+		externalJobID: jb.ExternalJobID.String(),
+		pollPeriod:    jb.BlockhashStoreSpec.PollPeriod,
+		runTimeout:    jb.BlockhashStoreSpec.RunTimeout,
+		logger:        log,
 	}}, nil
 }
 
@@ -199,13 +186,20 @@ func (d *Delegate) OnDeleteJob(spec job.Job, q pg.Queryer) error { return nil }
 // service is a job.Service that runs the BHS feeder every pollPeriod.
 type service struct {
 	services.StateMachine
-	feeder     *Feeder
-	wg         sync.WaitGroup
-	pollPeriod time.Duration
-	runTimeout time.Duration
-	logger     logger.Logger
-	parentCtx  context.Context
-	cancel     context.CancelFunc
+	feeder        *Feeder
+	gapDetector   *GapDetector
+	gapSchedule   *GapSchedule
+	chainID       *big.Int
+	bhsAddress    common.Address
+	jobID         int32
+	jobName       string
+	externalJobID string
+	wg            sync.WaitGroup
+	pollPeriod    time.Duration
+	runTimeout    time.Duration
+	logger        logger.Logger
+	parentCtx     context.Context
+	cancel        context.CancelFunc
 }
 
 // Start the BHS feeder service, satisfying the job.Service interface.
@@ -214,11 +208,15 @@ func (s *service) Start(context.Context) error {
 		s.logger.Infow("Starting BHS feeder")
 		ticker := time.NewTicker(utils.WithJitter(s.pollPeriod))
 		s.parentCtx, s.cancel = context.WithCancel(context.Background())
-		s.wg.Add(2)
+		s.wg.Add(3)
 		go func() {
 			defer s.wg.Done()
 			s.feeder.StartHeartbeats(s.parentCtx, &realTimer{})
 		}()
+		go func() {
+			defer s.wg.Done()
+			s.gapDetector.Run(s.parentCtx, s.jobName, s.externalJobID, s.runTimeout, s.scheduleGaps)
+		}()
 		go func() {
 			defer s.wg.Done()
 			defer ticker.Stop()
@@ -241,10 +239,17 @@ func (s *service) Close() error {
 		s.logger.Infow("Stopping BHS feeder")
 		s.cancel()
 		s.wg.Wait()
+		s.gapSchedule.Remove(s.chainID, s.bhsAddress, s.jobID)
 		return nil
 	})
 }
 
+// scheduleGaps schedules the gaps detected by the job for the blockheaderfeeder jobs of its
+// BHS, as they can no longer be stored by this job.
+func (s *service) scheduleGaps(gaps []Gap) {
+	s.gapSchedule.Set(s.chainID, s.bhsAddress, s.jobID, gaps)
+}
+
 func (s *service) runFeeder() {
 	s.logger.Debugw("Running BHS feeder")
 	ctx, cancel := context.WithTimeout(s.parentCtx, s.runTimeout)
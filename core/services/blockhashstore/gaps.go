@@ -0,0 +1,310 @@
+package blockhashstore
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/trusted_blockhash_store"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// blockhashWindow is the number of most recent blocks whose hashes are available to the
+// BLOCKHASH opcode, and so can still be stored without block headers.
+const blockhashWindow = 256
+
+// maxGapRequestIDs is the maximum number of request IDs reported for a single gap.
+const maxGapRequestIDs = 50
+
+// gapDetectionPeriod is the period between the gap detections of a job. Detection scans the
+// requests of the whole lookback window again, so it runs much less often than the feeders.
+const gapDetectionPeriod = 10 * time.Minute
+
+var promMissingBlockhashes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "blockhash_store_missing_blockhashes",
+	Help: "The number of blocks with unfulfilled VRF requests whose blockhash is missing from the blockhash store and out of the BLOCKHASH opcode window.",
+}, []string{"job_name", "external_job_id", "coordinator_version"})
+
+// Gap is a block with unfulfilled VRF requests whose blockhash is not stored in the BHS, and
+// is too old to be stored with the BLOCKHASH opcode. It can only be filled by storing block
+// headers backwards from a later stored block.
+type Gap struct {
+	// Block is the number of the block missing from the BHS.
+	Block uint64
+
+	// CoordinatorVersion is the version of the coordinator the unfulfilled requests were made to.
+	CoordinatorVersion string
+
+	// NumRequests is the number of unfulfilled requests made in the block.
+	NumRequests int
+
+	// RequestIDs are the IDs of the unfulfilled requests, limited to maxGapRequestIDs.
+	RequestIDs []string
+}
+
+// StoredChecker checks whether blockhashes are stored in a BHS.
+type StoredChecker interface {
+	// IsStored checks whether the hash associated with blockNum is already stored.
+	IsStored(ctx context.Context, blockNum uint64) (bool, error)
+}
+
+// GapDetector compares the blockhashes stored in a BHS against the unfulfilled requests of
+// each of the configured coordinators, and finds the blocks that are missing.
+type GapDetector struct {
+	lggr           logger.Logger
+	coordinators   []VersionedCoordinator
+	bhs            StoredChecker
+	lookbackBlocks int
+	latestBlock    func(ctx context.Context) (uint64, error)
+}
+
+// NewGapDetector creates a new GapDetector which scans the blocks from latest - lookbackBlocks
+// up to the start of the BLOCKHASH opcode window.
+func NewGapDetector(
+	lggr logger.Logger,
+	coordinators []VersionedCoordinator,
+	bhs StoredChecker,
+	lookbackBlocks int,
+	latestBlock func(ctx context.Context) (uint64, error),
+) *GapDetector {
+	return &GapDetector{
+		lggr:           lggr.Named("GapDetector"),
+		coordinators:   coordinators,
+		bhs:            bhs,
+		lookbackBlocks: lookbackBlocks,
+		latestBlock:    latestBlock,
+	}
+}
+
+// Detect returns the gaps of the BHS, ordered by block number and coordinator version.
+// The requests of each coordinator are matched only with its own fulfillments.
+func (d *GapDetector) Detect(ctx context.Context) ([]Gap, error) {
+	latestBlock, err := d.latestBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching block number")
+	}
+
+	fromBlock, toBlock := GetSearchWindow(int(latestBlock), blockhashWindow, d.lookbackBlocks)
+	if toBlock <= fromBlock {
+		// The lookback does not reach past the BLOCKHASH opcode window.
+		return nil, nil
+	}
+
+	lggr := d.lggr.With("latestBlock", latestBlock, "fromBlock", fromBlock, "toBlock", toBlock)
+	stored := make(map[uint64]bool)
+	var gaps []Gap
+	for _, c := range d.coordinators {
+		blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, lggr, c, fromBlock, toBlock)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetching unfulfilled requests of %s coordinator", c.Version)
+		}
+		for block, unfulfilledReqs := range blockToRequests {
+			if len(unfulfilledReqs) == 0 {
+				continue
+			}
+			isStored, ok := stored[block]
+			if !ok {
+				isStored, err = d.bhs.IsStored(ctx, block)
+				if err != nil {
+					return nil, errors.Wrapf(err, "checking if block %d is stored", block)
+				}
+				stored[block] = isStored
+			}
+			if isStored {
+				continue
+			}
+			reqIDs := LimitReqIDs(unfulfilledReqs, maxGapRequestIDs)
+			sort.Strings(reqIDs)
+			gaps = append(gaps, Gap{
+				Block:              block,
+				CoordinatorVersion: c.Version,
+				NumRequests:        len(unfulfilledReqs),
+				RequestIDs:         reqIDs,
+			})
+		}
+	}
+
+	sort.Slice(gaps, func(i, j int) bool {
+		if gaps[i].Block != gaps[j].Block {
+			return gaps[i].Block < gaps[j].Block
+		}
+		return gaps[i].CoordinatorVersion < gaps[j].CoordinatorVersion
+	})
+	return gaps, nil
+}
+
+// Run detects the gaps of a job when called and then every gapDetectionPeriod, until ctx is
+// done. Each detection is bounded by timeout. The gaps are reported with the missing
+// blockhashes metric of the job, logged, and passed to schedule.
+func (d *GapDetector) Run(ctx context.Context, jobName, externalJobID string, timeout time.Duration, schedule func([]Gap)) {
+	ticker := time.NewTicker(utils.WithJitter(gapDetectionPeriod))
+	defer ticker.Stop()
+	for {
+		d.detectAndReport(ctx, jobName, externalJobID, timeout, schedule)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *GapDetector) detectAndReport(ctx context.Context, jobName, externalJobID string, timeout time.Duration, schedule func([]Gap)) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	gaps, err := d.Detect(ctx)
+	if err != nil {
+		d.lggr.Errorw("Failed to detect BHS gaps", "err", err)
+		return
+	}
+	ReportGaps(jobName, externalJobID, d.coordinators, gaps)
+	for _, g := range gaps {
+		d.lggr.Warnw("Blockhash missing from BHS, scheduling it for the blockheaderfeeder jobs of the BHS",
+			"block", g.Block, "coordinatorVersion", g.CoordinatorVersion, "unfulfilledReqIDs", g.RequestIDs)
+	}
+	schedule(gaps)
+}
+
+// ReportGaps sets the missing blockhashes metric of a job for each of its coordinators.
+func ReportGaps(jobName, externalJobID string, coordinators []VersionedCoordinator, gaps []Gap) {
+	missing := make(map[string]int)
+	for _, g := range gaps {
+		missing[g.CoordinatorVersion]++
+	}
+	for _, c := range coordinators {
+		promMissingBlockhashes.WithLabelValues(jobName, externalJobID, c.Version).Set(float64(missing[c.Version]))
+	}
+}
+
+// GapSchedule holds the gaps last detected by each blockhashstore and blockheaderfeeder job,
+// by blockhash store, so that the blockheaderfeeder jobs of a blockhash store fill the gaps
+// detected by any job of the store.
+type GapSchedule struct {
+	mu   sync.RWMutex
+	gaps map[gapScheduleKey]map[int32][]Gap
+}
+
+type gapScheduleKey struct {
+	chainID string
+	bhs     common.Address
+}
+
+// NewGapSchedule creates an empty GapSchedule.
+func NewGapSchedule() *GapSchedule {
+	return &GapSchedule{gaps: make(map[gapScheduleKey]map[int32][]Gap)}
+}
+
+// Set replaces the gaps of the blockhash store bhs of chainID detected by a job.
+func (s *GapSchedule) Set(chainID *big.Int, bhs common.Address, jobID int32, gaps []Gap) {
+	key := gapScheduleKey{chainID: chainID.String(), bhs: bhs}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(gaps) == 0 {
+		s.remove(key, jobID)
+		return
+	}
+	if s.gaps[key] == nil {
+		s.gaps[key] = make(map[int32][]Gap)
+	}
+	s.gaps[key][jobID] = gaps
+}
+
+// Remove drops the gaps of the blockhash store bhs of chainID detected by a job.
+func (s *GapSchedule) Remove(chainID *big.Int, bhs common.Address, jobID int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(gapScheduleKey{chainID: chainID.String(), bhs: bhs}, jobID)
+}
+
+func (s *GapSchedule) remove(key gapScheduleKey, jobID int32) {
+	delete(s.gaps[key], jobID)
+	if len(s.gaps[key]) == 0 {
+		delete(s.gaps, key)
+	}
+}
+
+// Gaps returns the gaps of the blockhash store bhs of chainID detected by all jobs. A block
+// is returned once for each job and coordinator version it is missing for.
+func (s *GapSchedule) Gaps(chainID *big.Int, bhs common.Address) []Gap {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var gaps []Gap
+	for _, jobGaps := range s.gaps[gapScheduleKey{chainID: chainID.String(), bhs: bhs}] {
+		gaps = append(gaps, jobGaps...)
+	}
+	return gaps
+}
+
+// NewGapDetectorForJob creates a GapDetector for the coordinators and the BHS of a
+// blockhashstore or blockheaderfeeder job. It only reads from the chain, so it can be created
+// independently of the job services.
+func NewGapDetectorForJob(lggr logger.Logger, legacyChains legacyevm.LegacyChainContainer, jb job.Job) (*GapDetector, error) {
+	var (
+		chainID                             string
+		bhsAddress                          ethkey.EIP55Address
+		trustedBHSAddress                   *ethkey.EIP55Address
+		v1Address, v2Address, v2PlusAddress *ethkey.EIP55Address
+		lookbackBlocks                      int32
+	)
+	switch {
+	case jb.BlockhashStoreSpec != nil:
+		spec := jb.BlockhashStoreSpec
+		chainID = spec.EVMChainID.String()
+		bhsAddress, trustedBHSAddress = spec.BlockhashStoreAddress, spec.TrustedBlockhashStoreAddress
+		v1Address, v2Address, v2PlusAddress = spec.CoordinatorV1Address, spec.CoordinatorV2Address, spec.CoordinatorV2PlusAddress
+		lookbackBlocks = spec.LookbackBlocks
+	case jb.BlockHeaderFeederSpec != nil:
+		spec := jb.BlockHeaderFeederSpec
+		chainID = spec.EVMChainID.String()
+		bhsAddress = spec.BlockhashStoreAddress
+		v1Address, v2Address, v2PlusAddress = spec.CoordinatorV1Address, spec.CoordinatorV2Address, spec.CoordinatorV2PlusAddress
+		lookbackBlocks = spec.LookbackBlocks
+	default:
+		return nil, errors.Errorf("job %d is not a blockhashstore or blockheaderfeeder job", jb.ID)
+	}
+
+	chain, err := legacyChains.Get(chainID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting chain ID %s", chainID)
+	}
+
+	bhs, err := blockhash_store.NewBlockhashStore(bhsAddress.Address(), chain.Client())
+	if err != nil {
+		return nil, errors.Wrap(err, "building BHS")
+	}
+	// The gap detector only checks stored blockhashes, so the BHS needs no transaction manager.
+	reader := &BulletproofBHS{bhs: bhs}
+	if trustedBHSAddress != nil && trustedBHSAddress.Hex() != EmptyAddress {
+		reader.trustedBHS, err = trusted_blockhash_store.NewTrustedBlockhashStore(trustedBHSAddress.Address(), chain.Client())
+		if err != nil {
+			return nil, errors.Wrap(err, "building trusted BHS")
+		}
+	}
+
+	lp := chain.LogPoller()
+	coordinators, err := NewCoordinators(chain.Client(), lp, v1Address, v2Address, v2PlusAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGapDetector(lggr, coordinators, reader, int(lookbackBlocks), func(ctx context.Context) (uint64, error) {
+		head, err := lp.LatestBlock(pg.WithParentCtx(ctx))
+		if err != nil {
+			return 0, errors.Wrap(err, "getting chain head")
+		}
+		return uint64(head.BlockNumber), nil
+	}), nil
+}
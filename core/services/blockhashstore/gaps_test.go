@@ -0,0 +1,94 @@
+package blockhashstore
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestGapDetector(t *testing.T) {
+	latestBlock := func(ctx context.Context) (uint64, error) {
+		return 500, nil
+	}
+	coordinators := []VersionedCoordinator{
+		{
+			Coordinator: &TestCoordinator{
+				RequestEvents: []Event{
+					{Block: 100, ID: "1"},
+					{Block: 120, ID: "2"}, // stored
+					{Block: 300, ID: "3"}, // within the BLOCKHASH opcode window
+				},
+			},
+			Version: CoordinatorVersionV2,
+		},
+		{
+			Coordinator: &TestCoordinator{
+				RequestEvents: []Event{
+					{Block: 100, ID: "5"},
+					{Block: 110, ID: "1"},
+				},
+				// Fulfills the V2Plus request 1 only, not the V2 request with the same ID.
+				FulfillmentEvents: []Event{{Block: 115, ID: "1"}},
+			},
+			Version: CoordinatorVersionV2Plus,
+		},
+	}
+
+	t.Run("finds unstored blocks of each coordinator", func(t *testing.T) {
+		detector := NewGapDetector(logger.TestLogger(t), coordinators, &TestBHS{Stored: []uint64{120}}, 400, latestBlock)
+
+		gaps, err := detector.Detect(testutils.Context(t))
+		require.NoError(t, err)
+		require.Equal(t, []Gap{
+			{Block: 100, CoordinatorVersion: CoordinatorVersionV2, NumRequests: 1, RequestIDs: []string{"1"}},
+			{Block: 100, CoordinatorVersion: CoordinatorVersionV2Plus, NumRequests: 1, RequestIDs: []string{"5"}},
+		}, gaps)
+
+		ReportGaps("job", "external-id", coordinators, gaps)
+		require.Equal(t, float64(1), testutil.ToFloat64(promMissingBlockhashes.WithLabelValues("job", "external-id", CoordinatorVersionV2)))
+		require.Equal(t, float64(1), testutil.ToFloat64(promMissingBlockhashes.WithLabelValues("job", "external-id", CoordinatorVersionV2Plus)))
+	})
+
+	t.Run("lookback within the BLOCKHASH opcode window", func(t *testing.T) {
+		detector := NewGapDetector(logger.TestLogger(t), coordinators, &TestBHS{}, 256, latestBlock)
+
+		gaps, err := detector.Detect(testutils.Context(t))
+		require.NoError(t, err)
+		require.Empty(t, gaps)
+	})
+
+	t.Run("error checking if stored", func(t *testing.T) {
+		detector := NewGapDetector(logger.TestLogger(t), coordinators, &TestBHS{ErrorsIsStored: []uint64{100}}, 400, latestBlock)
+
+		_, err := detector.Detect(testutils.Context(t))
+		require.EqualError(t, err, "checking if block 100 is stored: error checking if stored")
+	})
+}
+
+func TestGapSchedule(t *testing.T) {
+	bhs := common.HexToAddress("0x1")
+	otherBHS := common.HexToAddress("0x2")
+	chainID := big.NewInt(1)
+	schedule := NewGapSchedule()
+
+	schedule.Set(chainID, bhs, 1, []Gap{{Block: 100}})
+	schedule.Set(chainID, bhs, 2, []Gap{{Block: 90}})
+	schedule.Set(chainID, otherBHS, 3, []Gap{{Block: 80}})
+	require.ElementsMatch(t, []Gap{{Block: 100}, {Block: 90}}, schedule.Gaps(chainID, bhs))
+	require.Empty(t, schedule.Gaps(big.NewInt(2), bhs))
+
+	// The gaps of a job are replaced by its next detection.
+	schedule.Set(chainID, bhs, 1, nil)
+	require.Equal(t, []Gap{{Block: 90}}, schedule.Gaps(chainID, bhs))
+
+	schedule.Remove(chainID, bhs, 2)
+	require.Empty(t, schedule.Gaps(chainID, bhs))
+	require.Equal(t, []Gap{{Block: 80}}, schedule.Gaps(chainID, otherBHS))
+}
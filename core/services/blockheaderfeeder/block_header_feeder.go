@@ -60,7 +60,7 @@ func NewBlockHeaderFeeder(
 		lookbackBlocks:            lookbackBlocks,
 		latestBlock:               latestBlock,
 		stored:                    make(map[uint64]struct{}),
-		lastRunBlock:              0,
+		scheduledGaps:             func() []blockhashstore.Gap { return nil },
 		getBlockhashesBatchSize:   getBlockhashesBatchSize,
 		storeBlockhashesBatchSize: storeBlockhashesBatchSize,
 		blockHeaderProvider:       blockHeaderProvider,
@@ -71,7 +71,7 @@ func NewBlockHeaderFeeder(
 }
 
 // BlockHeaderFeeder checks recent VRF coordinator events and stores any blockhashes for blocks within
-// waitBlocks and lookbackBlocks that have unfulfilled requests.
+// waitBlocks and lookbackBlocks that have unfulfilled requests, and for the scheduled gaps of its BHS.
 type BlockHeaderFeeder struct {
 	lggr                      logger.Logger
 	coordinator               blockhashstore.Coordinator
@@ -81,8 +81,8 @@ type BlockHeaderFeeder struct {
 	lookbackBlocks            int
 	latestBlock               func(ctx context.Context) (uint64, error)
 	stored                    map[uint64]struct{}
+	scheduledGaps             func() []blockhashstore.Gap // gaps to fill, including the ones older than the search window
 	blockHeaderProvider       BlockHeaderProvider
-	lastRunBlock              uint64
 	getBlockhashesBatchSize   uint16
 	storeBlockhashesBatchSize uint16
 	gethks                    keystore.Eth
@@ -111,6 +111,19 @@ func (f *BlockHeaderFeeder) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	scheduled := make(map[uint64]struct{})
+	for _, g := range f.scheduledGaps() {
+		if g.Block >= toBlock {
+			continue
+		}
+		scheduled[g.Block] = struct{}{}
+		if blockToRequests[g.Block] == nil {
+			blockToRequests[g.Block] = make(map[string]struct{})
+		}
+		for _, id := range g.RequestIDs {
+			blockToRequests[g.Block][id] = struct{}{}
+		}
+	}
 
 	minBlockNumber := f.findLowestBlockNumberWithoutBlockhash(ctx, lggr, blockToRequests)
 	if minBlockNumber == nil {
@@ -174,20 +187,14 @@ func (f *BlockHeaderFeeder) Run(ctx context.Context) error {
 		}
 	}
 
-	if f.lastRunBlock != 0 {
-		// Prune stored, anything older than fromBlock can be discarded
-		for block := f.lastRunBlock - uint64(f.lookbackBlocks); block < fromBlock; block++ {
-			if _, ok := f.stored[block]; ok {
-				delete(f.stored, block)
-				lggr.Debugw("Pruned block from stored cache",
-					"block", block)
-			}
+	// Prune stored, anything older than fromBlock can be discarded unless it is a scheduled gap
+	for block := range f.stored {
+		if _, ok := scheduled[block]; !ok && block < fromBlock {
+			delete(f.stored, block)
+			lggr.Debugw("Pruned block from stored cache",
+				"block", block)
 		}
 	}
-	// lastRunBlock is only used for pruning
-	// only time we update lastRunBlock is when the run reaches completion, indicating
-	// that new block has been stored
-	f.lastRunBlock = latestBlockNumber
 	return nil
 }
 
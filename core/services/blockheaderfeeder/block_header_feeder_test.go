@@ -318,3 +318,46 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 	require.NoError(t, feeder.Run(testutils.Context(t)))
 	require.ElementsMatch(t, []uint64{74, 75}, batchBHS.Stored)
 }
+
+func TestFeeder_ScheduledGaps(t *testing.T) {
+	bhs := &blockhashstore.TestBHS{}
+	batchBHS := &blockhashstore.TestBatchBHS{Stored: []uint64{75}}
+	fromAddress := "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+	ks := keystoremocks.NewEth(t)
+	ks.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, mock.Anything).Maybe().Return(common.HexToAddress(fromAddress), nil)
+
+	feeder := NewBlockHeaderFeeder(
+		logger.TestLogger(t),
+		&blockhashstore.TestCoordinator{},
+		bhs,
+		batchBHS,
+		&blockhashstore.TestBlockHeaderProvider{},
+		20,
+		30,
+		func(ctx context.Context) (uint64, error) {
+			return 100, nil
+		},
+		ks,
+		1,
+		1,
+		[]ethkey.EIP55Address{ethkey.EIP55Address(fromAddress)},
+		testutils.FixtureChainID,
+	)
+	gaps := []blockhashstore.Gap{{Block: 73, RequestIDs: []string{"1"}}}
+	feeder.scheduledGaps = func() []blockhashstore.Gap { return gaps }
+
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.ElementsMatch(t, []uint64{73, 74, 75}, batchBHS.Stored)
+
+	// Block 68 is older than the from block (70), it is filled anyway.
+	gaps = []blockhashstore.Gap{{Block: 68, RequestIDs: []string{"2"}}, {Block: 73, RequestIDs: []string{"1"}}}
+	batchBHS.Stored = []uint64{73}
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.ElementsMatch(t, []uint64{68, 69, 70, 71, 72, 73}, batchBHS.Stored)
+	require.Contains(t, feeder.stored, uint64(68))
+
+	// The gaps were filled, nothing left to store.
+	batchBHS.Stored = []uint64{75}
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.ElementsMatch(t, []uint64{75}, batchBHS.Stored)
+}
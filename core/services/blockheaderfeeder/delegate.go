@@ -3,8 +3,11 @@ package blockheaderfeeder
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -12,9 +15,6 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_blockhash_store"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
-	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
-	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
-	v2plus "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2plus_interface"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -29,17 +29,20 @@ type Delegate struct {
 	logger       logger.Logger
 	legacyChains legacyevm.LegacyChainContainer
 	ks           keystore.Eth
+	gapSchedule  *blockhashstore.GapSchedule
 }
 
 func NewDelegate(
 	logger logger.Logger,
 	legacyChains legacyevm.LegacyChainContainer,
 	ks keystore.Eth,
+	gapSchedule *blockhashstore.GapSchedule,
 ) *Delegate {
 	return &Delegate{
 		logger:       logger,
 		legacyChains: legacyChains,
 		ks:           ks,
+		gapSchedule:  gapSchedule,
 	}
 }
 
@@ -95,48 +98,15 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 	}
 
 	lp := chain.LogPoller()
-	var coordinators []blockhashstore.Coordinator
-	if jb.BlockHeaderFeederSpec.CoordinatorV1Address != nil {
-		var c *v1.VRFCoordinator
-		if c, err = v1.NewVRFCoordinator(
-			jb.BlockHeaderFeederSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-		var coord *blockhashstore.V1Coordinator
-		coord, err = blockhashstore.NewV1Coordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V1 coordinator")
-		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockHeaderFeederSpec.CoordinatorV2Address != nil {
-		var c *v2.VRFCoordinatorV2
-		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockHeaderFeederSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V2 coordinator")
-		}
-		var coord *blockhashstore.V2Coordinator
-		coord, err = blockhashstore.NewV2Coordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V2 coordinator")
-		}
-		coordinators = append(coordinators, coord)
-	}
-	if jb.BlockHeaderFeederSpec.CoordinatorV2PlusAddress != nil {
-		var c v2plus.IVRFCoordinatorV2PlusInternalInterface
-		if c, err = v2plus.NewIVRFCoordinatorV2PlusInternal(
-			jb.BlockHeaderFeederSpec.CoordinatorV2PlusAddress.Address(), chain.Client()); err != nil {
-
-			return nil, errors.Wrap(err, "building V2 plus coordinator")
-		}
-		var coord *blockhashstore.V2PlusCoordinator
-		coord, err = blockhashstore.NewV2PlusCoordinator(c, lp)
-		if err != nil {
-			return nil, errors.Wrap(err, "building V2 plus coordinator")
-		}
-		coordinators = append(coordinators, coord)
+	coordinators, err := blockhashstore.NewCoordinators(
+		chain.Client(),
+		lp,
+		jb.BlockHeaderFeederSpec.CoordinatorV1Address,
+		jb.BlockHeaderFeederSpec.CoordinatorV2Address,
+		jb.BlockHeaderFeederSpec.CoordinatorV2PlusAddress,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	bpBHS, err := blockhashstore.NewBulletproofBHS(chain.Config().EVM().GasEstimator(), chain.Config().Database(), fromAddresses, chain.TxManager(), bhs, nil, chain.ID(), d.ks)
@@ -166,34 +136,53 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 
 	blockHeaderProvider := NewGethBlockHeaderProvider(chain.Client())
 
+	latestBlock := func(ctx context.Context) (uint64, error) {
+		head, err := chain.Client().HeadByNumber(ctx, nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "getting chain head")
+		}
+		return uint64(head.Number), nil
+	}
+
 	feeder := NewBlockHeaderFeeder(
 		log,
-		blockhashstore.NewMultiCoordinator(coordinators...),
+		blockhashstore.NewMultiCoordinator(blockhashstore.Unversioned(coordinators)...),
 		bpBHS,
 		batchBHS,
 		blockHeaderProvider,
 		int(jb.BlockHeaderFeederSpec.WaitBlocks),
 		int(jb.BlockHeaderFeederSpec.LookbackBlocks),
-		func(ctx context.Context) (uint64, error) {
-			head, err := chain.Client().HeadByNumber(ctx, nil)
-			if err != nil {
-				return 0, errors.Wrap(err, "getting chain head")
-			}
-			return uint64(head.Number), nil
-		},
+		latestBlock,
 		d.ks,
 		jb.BlockHeaderFeederSpec.GetBlockhashesBatchSize,
 		jb.BlockHeaderFeederSpec.StoreBlockhashesBatchSize,
 		fromAddresses,
 		chain.ID(),
 	)
+	feeder.scheduledGaps = func() []blockhashstore.Gap {
+		return d.gapSchedule.Gaps(chain.ID(), bhs.Address())
+	}
+
+	gapDetector := blockhashstore.NewGapDetector(
+		log,
+		coordinators,
+		bpBHS,
+		int(jb.BlockHeaderFeederSpec.LookbackBlocks),
+		latestBlock,
+	)
 
 	services := []job.ServiceCtx{&service{
-		feeder:     feeder,
-		pollPeriod: jb.BlockHeaderFeederSpec.PollPeriod,
-		runTimeout: jb.BlockHeaderFeederSpec.RunTimeout,
-		logger:     log,
-		done:       make(chan struct{}),
+		feeder:        feeder,
+		gapDetector:   gapDetector,
+		gapSchedule:   d.gapSchedule,
+		chainID:       chain.ID(),
+		bhsAddress:    bhs.Address(),
+		jobID:         jb.ID,
+		jobName:       jb.Name.ValueOrZero(),
+		externalJobID: jb.ExternalJobID.String(),
+		pollPeriod:    jb.BlockHeaderFeederSpec.PollPeriod,
+		runTimeout:    jb.BlockHeaderFeederSpec.RunTimeout,
+		logger:        log,
 	}}
 
 	return services, nil
@@ -213,13 +202,20 @@ func (d *Delegate) OnDeleteJob(spec job.Job, q pg.Queryer) error { return nil }
 // service is a job.Service that runs the BHS feeder every pollPeriod.
 type service struct {
 	services.StateMachine
-	feeder     *BlockHeaderFeeder
-	done       chan struct{}
-	pollPeriod time.Duration
-	runTimeout time.Duration
-	logger     logger.Logger
-	parentCtx  context.Context
-	cancel     context.CancelFunc
+	feeder        *BlockHeaderFeeder
+	gapDetector   *blockhashstore.GapDetector
+	gapSchedule   *blockhashstore.GapSchedule
+	chainID       *big.Int
+	bhsAddress    common.Address
+	jobID         int32
+	jobName       string
+	externalJobID string
+	wg            sync.WaitGroup
+	pollPeriod    time.Duration
+	runTimeout    time.Duration
+	logger        logger.Logger
+	parentCtx     context.Context
+	cancel        context.CancelFunc
 }
 
 // Start the BHS feeder service, satisfying the job.Service interface.
@@ -228,8 +224,13 @@ func (s *service) Start(context.Context) error {
 		s.logger.Infow("Starting BlockHeaderFeeder")
 		ticker := time.NewTicker(utils.WithJitter(s.pollPeriod))
 		s.parentCtx, s.cancel = context.WithCancel(context.Background())
+		s.wg.Add(2)
 		go func() {
-			defer close(s.done)
+			defer s.wg.Done()
+			s.gapDetector.Run(s.parentCtx, s.jobName, s.externalJobID, s.runTimeout, s.scheduleGaps)
+		}()
+		go func() {
+			defer s.wg.Done()
 			defer ticker.Stop()
 			for {
 				select {
@@ -249,11 +250,18 @@ func (s *service) Close() error {
 	return s.StopOnce("Block Header Feeder Service", func() error {
 		s.logger.Infow("Stopping BlockHeaderFeeder")
 		s.cancel()
-		<-s.done
+		s.wg.Wait()
+		s.gapSchedule.Remove(s.chainID, s.bhsAddress, s.jobID)
 		return nil
 	})
 }
 
+// scheduleGaps schedules the gaps detected by the job to be filled by the feeder, and by the
+// other blockheaderfeeder jobs of the BHS.
+func (s *service) scheduleGaps(gaps []blockhashstore.Gap) {
+	s.gapSchedule.Set(s.chainID, s.bhsAddress, s.jobID, gaps)
+}
+
 func (s *service) runFeeder() {
 	s.logger.Debugw("Running BlockHeaderFeeder")
 	ctx, cancel := context.WithTimeout(s.parentCtx, s.runTimeout)
@@ -46,6 +46,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
@@ -55,8 +56,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+	streamsevm "github.com/smartcontractkit/chainlink/v2/core/services/streams/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -91,11 +94,21 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeHealthMonitor() bridges.HealthMonitor
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32, reason string) error
+	ResumeJob(ctx context.Context, jobID int32) error
+	// VRFRequestQueues returns the request queue of each subscription of a
+	// running VRF V2 or V2Plus job, and false if the job is not running.
+	VRFRequestQueues(jobID int32) (map[string][]vrfv2.QueuedRequest, bool)
+	// PendingDecryptionRequests returns the pending requests of the threshold
+	// decryption queue of a running Functions job, and false if the job has
+	// no running decryption queue.
+	PendingDecryptionRequests(jobID int32) ([]threshold.PendingRequest, bool)
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
@@ -124,6 +137,9 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeHealth             bridges.HealthMonitor
+	vrfDelegate              *vrf.Delegate
+	decryptionQueues         *threshold.DecryptionQueues
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
@@ -289,12 +305,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(db, globalLogger, cfg.Database())
+		bridgeORM      = bridges.NewORMWithSecrets(db, globalLogger, cfg.Database(), keyStore)
+		bridgeClients  = bridges.NewHTTPClients(unrestrictedHTTPClient)
+		bridgeHealth   = bridges.NewHealthMonitor(bridgeORM, bridgeClients, globalLogger)
 		mercuryORM     = mercury.NewORM(db, globalLogger, cfg.Database())
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient, bridgeClients)
 		jobORM         = job.NewORM(db, pipelineORM, bridgeORM, keyStore, globalLogger, cfg.Database())
 		txmORM         = txmgr.NewTxStore(db, globalLogger, cfg.Database())
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
+		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner, cfg.Mercury().Streams())
 	)
 
 	for _, chain := range legacyEVMChains.Slice() {
@@ -302,7 +320,19 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
 	}
 
-	srvcs = append(srvcs, pipelineORM)
+	srvcs = append(srvcs, pipelineORM, bridgeHealth)
+
+	decryptionQueues := threshold.NewDecryptionQueues()
+	gapSchedule := blockhashstore.NewGapSchedule()
+	vrfDelegate := vrf.NewDelegate(
+		db,
+		keyStore,
+		pipelineRunner,
+		pipelineORM,
+		legacyEVMChains,
+		globalLogger,
+		cfg.Database(),
+		mailMon)
 
 	var (
 		delegates = map[job.Type]job.Delegate{
@@ -319,30 +349,25 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				legacyEVMChains,
 				mailMon),
-			job.VRF: vrf.NewDelegate(
-				db,
-				keyStore,
-				pipelineRunner,
-				pipelineORM,
-				legacyEVMChains,
-				globalLogger,
-				cfg.Database(),
-				mailMon),
+			job.VRF: vrfDelegate,
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				pipelineORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				globalLogger,
 				legacyEVMChains,
-				keyStore.Eth()),
+				keyStore.Eth(),
+				gapSchedule),
 			job.BlockHeaderFeeder: blockheaderfeeder.NewDelegate(
 				globalLogger,
 				legacyEVMChains,
-				keyStore.Eth()),
+				keyStore.Eth(),
+				gapSchedule),
 			job.Gateway: gateway.NewDelegate(
 				legacyEVMChains,
 				keyStore.Eth(),
@@ -353,7 +378,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				streamRegistry,
 				pipelineRunner,
-				cfg.JobPipeline()),
+				cfg.JobPipeline(),
+				streamsevm.NewChainReaderFactory(legacyEVMChains)),
 			job.Workflow: workflows.NewDelegate(
 				globalLogger,
 				registry,
@@ -430,6 +456,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			opts.RelayerChainInteroperators,
 			mailMon,
 			registry,
+			decryptionQueues,
 		)
 		delegates[job.Bootstrap] = ocrbootstrap.NewDelegateBootstrap(
 			db,
@@ -499,6 +526,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeHealth:             bridgeHealth,
+		vrfDelegate:              vrfDelegate,
+		decryptionQueues:         decryptionQueues,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
@@ -672,6 +702,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealthMonitor() bridges.HealthMonitor {
+	return app.bridgeHealth
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -724,6 +758,25 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(jobID, pg.WithParentCtx(ctx))
 }
 
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32, reason string) error {
+	return app.jobSpawner.PauseJob(jobID, reason, pg.WithParentCtx(ctx))
+}
+
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(jobID, pg.WithParentCtx(ctx))
+}
+
+func (app *ChainlinkApplication) VRFRequestQueues(jobID int32) (map[string][]vrfv2.QueuedRequest, bool) {
+	if _, ok := app.jobSpawner.ActiveJobs()[jobID]; !ok {
+		return nil, false
+	}
+	return app.vrfDelegate.RequestQueues(jobID)
+}
+
+func (app *ChainlinkApplication) PendingDecryptionRequests(jobID int32) ([]threshold.PendingRequest, bool) {
+	return app.decryptionQueues.PendingRequests(jobID)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -741,6 +794,9 @@ func (app *ChainlinkApplication) RunJobV2(
 	if err != nil {
 		return 0, errors.Wrapf(err, "job ID %v", jobID)
 	}
+	if jb.IsPaused() {
+		return 0, errors.Errorf("job ID %v is paused", jobID)
+	}
 	var runID int64
 
 	// Some jobs are special in that they do not have a task graph.
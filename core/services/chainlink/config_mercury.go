@@ -24,6 +24,12 @@ func (m *mercuryCacheConfig) MaxStaleAge() time.Duration {
 func (m *mercuryCacheConfig) LatestReportDeadline() time.Duration {
 	return m.c.LatestReportDeadline.Duration()
 }
+func (m *mercuryCacheConfig) StaleFallbackAge() time.Duration {
+	return m.c.StaleFallbackAge.Duration()
+}
+func (m *mercuryCacheConfig) SharedAcrossServers() bool {
+	return *m.c.SharedAcrossServers
+}
 
 type mercuryTLSConfig struct {
 	c toml.MercuryTLS
@@ -33,6 +39,20 @@ func (m *mercuryTLSConfig) CertFile() string {
 	return *m.c.CertFile
 }
 
+var _ config.MercuryStreams = (*mercuryStreamsConfig)(nil)
+
+type mercuryStreamsConfig struct {
+	c toml.MercuryStreams
+}
+
+func (m *mercuryStreamsConfig) ObservationInterval() time.Duration {
+	return m.c.ObservationInterval.Duration()
+}
+
+func (m *mercuryStreamsConfig) MaxStaleness() time.Duration {
+	return m.c.MaxStaleness.Duration()
+}
+
 type mercuryConfig struct {
 	c toml.Mercury
 	s toml.MercurySecrets
@@ -60,3 +80,7 @@ func (m *mercuryConfig) Cache() config.MercuryCache {
 func (m *mercuryConfig) TLS() config.MercuryTLS {
 	return &mercuryTLSConfig{c: m.c.TLS}
 }
+
+func (m *mercuryConfig) Streams() config.MercuryStreams {
+	return &mercuryStreamsConfig{c: m.c.Streams}
+}
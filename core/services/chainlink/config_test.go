@@ -667,10 +667,16 @@ func TestConfig_Marshal(t *testing.T) {
 			LatestReportTTL:      commonconfig.MustNewDuration(100 * time.Second),
 			MaxStaleAge:          commonconfig.MustNewDuration(101 * time.Second),
 			LatestReportDeadline: commonconfig.MustNewDuration(102 * time.Second),
+			StaleFallbackAge:     commonconfig.MustNewDuration(103 * time.Second),
+			SharedAcrossServers:  ptr(true),
 		},
 		TLS: toml.MercuryTLS{
 			CertFile: ptr("/path/to/cert.pem"),
 		},
+		Streams: toml.MercuryStreams{
+			ObservationInterval: commonconfig.MustNewDuration(500 * time.Millisecond),
+			MaxStaleness:        commonconfig.MustNewDuration(3 * time.Second),
+		},
 	}
 
 	for _, tt := range []struct {
@@ -1103,9 +1109,15 @@ URL = 'http://stark.node'
 LatestReportTTL = '1m40s'
 MaxStaleAge = '1m41s'
 LatestReportDeadline = '1m42s'
+StaleFallbackAge = '1m43s'
+SharedAcrossServers = true
 
 [Mercury.TLS]
 CertFile = '/path/to/cert.pem'
+
+[Mercury.Streams]
+ObservationInterval = '500ms'
+MaxStaleness = '3s'
 `},
 		{"full", full, fullTOML},
 		{"multi-chain", multiChain, multiChainTOML},
@@ -3,34 +3,50 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// maxCatchUpIterations bounds the number of schedules considered when catching
+// up, e.g. for a job running every second after a long downtime.
+const maxCatchUpIterations = 1_000_000
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
+	clock          clockwork.Clock
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+	running        atomic.Int32
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
+	clock clockwork.Clock,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
-		"schedule", jobSpec.CronSpec.CronSchedule,
+		"schedule", jobSpec.CronSpec.Schedule(),
 	)
 
 	return &Cron{
@@ -38,6 +54,8 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
+		clock:          clock,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -46,12 +64,29 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	entryID, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.Schedule(), cr.runScheduled)
 	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err, "schedule", cr.jobSpec.CronSpec.Schedule(), "jobID", cr.jobSpec.ID)
 		return err
 	}
-	cr.cronRunner.Start()
+
+	if cr.jobSpec.CronSpec.CatchUpLimit == 0 {
+		cr.cronRunner.Start()
+		return nil
+	}
+	// The schedule starts once caught up, so that the latest run is not one
+	// of its own and runs are in order.
+	schedule := cr.cronRunner.Entry(entryID).Schedule
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		cr.catchUp(schedule, cr.clock.Now())
+		select {
+		case <-cr.chStop:
+		default:
+			cr.cronRunner.Start()
+		}
+	}()
 	return nil
 }
 
@@ -59,11 +94,85 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
+	close(cr.chStop)
+	cr.wg.Wait()
 	cr.cronRunner.Stop()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// runScheduled is called by the cron runner at each scheduled time.
+func (cr *Cron) runScheduled() {
+	if !cr.acquire() {
+		cr.logger.Warnw("Skipping scheduled run, too many runs in progress", "maxConcurrentRuns", cr.jobSpec.CronSpec.MaxConcurrentRuns)
+		return
+	}
+	defer cr.release()
+
+	if jitter := cr.jobSpec.CronSpec.Jitter.Duration(); jitter > 0 {
+		select {
+		case <-cr.clock.After(time.Duration(rand.Int63n(int64(jitter)))):
+		case <-cr.chStop:
+			return
+		}
+	}
+	cr.runPipeline(map[string]interface{}{})
+}
+
+// catchUp runs the most recent schedules missed between the last recorded
+// run of the job and now, oldest first.
+func (cr *Cron) catchUp(schedule cron.Schedule, now time.Time) {
+	ctx, cancel := cr.chStop.NewCtx()
+	defer cancel()
+
+	latest, err := cr.pipelineORM.LatestRunCreatedAt(cr.jobSpec.PipelineSpecID, pg.WithParentCtx(ctx))
+	if err != nil {
+		cr.logger.Errorw("Failed to load latest run, not catching up", "err", err)
+		return
+	}
+	if !latest.Valid {
+		return
+	}
+
+	missed := missedSchedules(schedule, latest.Time, now, int(cr.jobSpec.CronSpec.CatchUpLimit))
+	if len(missed) > 0 {
+		cr.logger.Infow("Catching up on missed schedules", "lastRun", latest.Time, "runs", len(missed))
+	}
+	for _, scheduledAt := range missed {
+		if ctx.Err() != nil {
+			return
+		}
+		if !cr.acquire() {
+			cr.logger.Warnw("Skipping catch-up run, too many runs in progress", "scheduledAt", scheduledAt, "maxConcurrentRuns", cr.jobSpec.CronSpec.MaxConcurrentRuns)
+			continue
+		}
+		cr.runPipeline(map[string]interface{}{
+			"catchUp":     true,
+			"scheduledAt": scheduledAt,
+		})
+		cr.release()
+	}
+}
+
+// acquire reserves a slot for a run, unless MaxConcurrentRuns are in
+// progress.
+func (cr *Cron) acquire() bool {
+	limit := int32(cr.jobSpec.CronSpec.MaxConcurrentRuns)
+	for {
+		running := cr.running.Load()
+		if limit > 0 && running >= limit {
+			return false
+		}
+		if cr.running.CompareAndSwap(running, running+1) {
+			return true
+		}
+	}
+}
+
+func (cr *Cron) release() {
+	cr.running.Add(-1)
+}
+
+func (cr *Cron) runPipeline(meta map[string]interface{}) {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
@@ -74,7 +183,7 @@ func (cr *Cron) runPipeline() {
 			"name":          cr.jobSpec.Name.ValueOrZero(),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": meta,
 		},
 	})
 
@@ -86,6 +195,31 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
+// missedSchedules returns up to limit of the most recent times of schedule
+// after since and not after now, in ascending order.
+func missedSchedules(schedule cron.Schedule, since time.Time, now time.Time, limit int) []time.Time {
+	if limit <= 0 {
+		return nil
+	}
+	// ring holds the most recent times, the oldest one at n % limit once full
+	ring := make([]time.Time, 0, limit)
+	n := 0
+	t := schedule.Next(since)
+	for ; n < maxCatchUpIterations && !t.IsZero() && !t.After(now); n++ {
+		if len(ring) < limit {
+			ring = append(ring, t)
+		} else {
+			ring[n%limit] = t
+		}
+		t = schedule.Next(t)
+	}
+	if n <= limit {
+		return ring
+	}
+	oldest := n % limit
+	return append(ring[oldest:], ring[:oldest]...)
+}
+
 func cronRunner() *cron.Cron {
 	return cron.New(cron.WithSeconds())
 }
@@ -0,0 +1,42 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissedSchedules(t *testing.T) {
+	t.Parallel()
+
+	runner := cronRunner()
+	id, err := runner.AddFunc("CRON_TZ=UTC 0 0 * * * *", func() {})
+	require.NoError(t, err)
+	hourly := runner.Entry(id).Schedule
+
+	since := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	hour := func(h int) time.Time { return time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC) }
+	for _, tc := range []struct {
+		name  string
+		now   time.Time
+		limit int
+		want  []time.Time
+	}{
+		{"none missed", since.Add(time.Minute), 3, nil},
+		{"fewer than limit", hour(2), 3, []time.Time{hour(1), hour(2)}},
+		{"as many as limit", hour(3), 3, []time.Time{hour(1), hour(2), hour(3)}},
+		{"more than limit", hour(7), 3, []time.Time{hour(5), hour(6), hour(7)}},
+		{"limit of one", hour(7), 1, []time.Time{hour(7)}},
+		{"zero limit", hour(7), 0, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			missed := missedSchedules(hourly, since, tc.now, tc.limit)
+			require.Len(t, missed, len(tc.want))
+			for i := range tc.want {
+				assert.True(t, tc.want[i].Equal(missed[i]), missed[i])
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -38,7 +42,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, orm, lggr)
 
 	require.NoError(t, jobORM.CreateJob(jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -67,7 +71,7 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, nil, clockwork.NewRealClock(), logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +79,80 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:           job.Cron,
+		SchemaVersion:  1,
+		CronSpec:       &job.CronSpec{CronSchedule: "0 0 * * * *", Timezone: "UTC", CatchUpLimit: 2},
+		PipelineSpec:   &pipeline.Spec{},
+		PipelineSpecID: 42,
+	}
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	lastRun := now.Add(-5 * time.Hour)
+	orm := pipelinemocks.NewORM(t)
+	orm.On("LatestRunCreatedAt", int32(42), mock.Anything).Return(null.TimeFrom(lastRun), nil).Once()
+
+	var scheduled []time.Time
+	runner := pipelinemocks.NewRunner(t)
+	awaiter := cltest.NewAwaiter()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			vars := args.Get(1).(*pipeline.Run).Inputs.Val.(map[string]interface{})
+			meta := vars["jobRun"].(map[string]interface{})["meta"].(map[string]interface{})
+			assert.Equal(t, true, meta["catchUp"])
+			scheduled = append(scheduled, meta["scheduledAt"].(time.Time))
+			if len(scheduled) == 2 {
+				awaiter.ItHappened()
+			}
+		}).
+		Return(false, nil).
+		Twice()
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, clockwork.NewFakeClockAt(now), logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	awaiter.AwaitOrFail(t)
+	require.NoError(t, service.Close())
+
+	// Only the most recent missed schedules are run, oldest first.
+	require.Len(t, scheduled, 2)
+	assert.True(t, time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC).Equal(scheduled[0]), scheduled[0])
+	assert.True(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC).Equal(scheduled[1]), scheduled[1])
+}
+
+func TestCronV2MaxConcurrentRuns(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", MaxConcurrentRuns: 1},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	started := cltest.NewAwaiter()
+	release := make(chan struct{})
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			started.ItHappened()
+			<-release
+		}).
+		Return(false, nil).
+		Once()
+
+	lggr, observed := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+	service, err := cron.NewCronFromJobSpec(spec, runner, nil, clockwork.NewRealClock(), lggr)
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	started.AwaitOrFail(t)
+	// Further schedules are skipped while the first run is in progress.
+	require.Eventually(t, func() bool {
+		return observed.FilterMessage("Skipping scheduled run, too many runs in progress").Len() > 0
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	close(release)
+	require.NoError(t, service.Close())
+}
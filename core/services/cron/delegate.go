@@ -3,6 +3,7 @@ package cron
 import (
 	"context"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -13,14 +14,16 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
 	lggr           logger.Logger
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner, lggr logger.Logger) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, pipelineORM pipeline.ORM, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
 		lggr:           lggr,
 	}
 }
@@ -40,7 +43,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.lggr)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.pipelineORM, clockwork.NewRealClock(), d.lggr)
 	if err != nil {
 		return nil, err
 	}
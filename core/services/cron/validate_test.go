@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,60 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "with timezone, jitter, concurrency limit and catch-up",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "0 0 1 1 * *"
+timezone          = "America/New_York"
+jitter            = "30s"
+maxConcurrentRuns = 1
+catchUpLimit      = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, "CRON_TZ=America/New_York 0 0 1 1 * *", s.CronSpec.Schedule())
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter.Duration())
+				assert.Equal(t, uint32(1), s.CronSpec.MaxConcurrentRuns)
+				assert.Equal(t, uint32(3), s.CronSpec.CatchUpLimit)
+			},
+		},
+		{
+			name: "timezone and CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+timezone        = "UTC"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "cron schedule must not specify CRON_TZ when timezone is set")
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid timezone 'Mars/Olympus_Mons'")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1,7 +1,15 @@
 package feeds
 
+import "context"
+
 // SetConnectionsManager allows us to manually set the connections manager.
 // Only used for testing.
 func (s *service) SetConnectionsManager(cm ConnectionsManager) {
 	s.connMgr = cm
 }
+
+// ProcessApprovalSchedules runs a single iteration of the approval scheduler.
+// Only used for testing.
+func (s *service) ProcessApprovalSchedules(ctx context.Context) {
+	s.processApprovalSchedules(ctx)
+}
@@ -773,6 +773,67 @@ func (_c *ORM_DeleteProposal_Call) RunAndReturn(run func(int64, ...pg.QOpt) erro
 	return _c
 }
 
+// DeleteSpecApprovalSchedule provides a mock function with given fields: specID, qopts
+func (_m *ORM) DeleteSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, specID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSpecApprovalSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) error); ok {
+		r0 = rf(specID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_DeleteSpecApprovalSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSpecApprovalSchedule'
+type ORM_DeleteSpecApprovalSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteSpecApprovalSchedule is a helper method to define mock.On call
+//   - specID int64
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) DeleteSpecApprovalSchedule(specID interface{}, qopts ...interface{}) *ORM_DeleteSpecApprovalSchedule_Call {
+	return &ORM_DeleteSpecApprovalSchedule_Call{Call: _e.mock.On("DeleteSpecApprovalSchedule",
+		append([]interface{}{specID}, qopts...)...)}
+}
+
+func (_c *ORM_DeleteSpecApprovalSchedule_Call) Run(run func(specID int64, qopts ...pg.QOpt)) *ORM_DeleteSpecApprovalSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(args[0].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteSpecApprovalSchedule_Call) Return(_a0 error) *ORM_DeleteSpecApprovalSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_DeleteSpecApprovalSchedule_Call) RunAndReturn(run func(int64, ...pg.QOpt) error) *ORM_DeleteSpecApprovalSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsSpecByJobProposalIDAndVersion provides a mock function with given fields: jpID, version, qopts
 func (_m *ORM) ExistsSpecByJobProposalIDAndVersion(jpID int64, version int32, qopts ...pg.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
@@ -1296,6 +1357,79 @@ func (_c *ORM_GetSpec_Call) RunAndReturn(run func(int64, ...pg.QOpt) (*feeds.Job
 	return _c
 }
 
+// GetSpecApprovalSchedule provides a mock function with given fields: specID, qopts
+func (_m *ORM) GetSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) (*feeds.SpecApprovalSchedule, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, specID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpecApprovalSchedule")
+	}
+
+	var r0 *feeds.SpecApprovalSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) (*feeds.SpecApprovalSchedule, error)); ok {
+		return rf(specID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) *feeds.SpecApprovalSchedule); ok {
+		r0 = rf(specID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.SpecApprovalSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(specID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetSpecApprovalSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpecApprovalSchedule'
+type ORM_GetSpecApprovalSchedule_Call struct {
+	*mock.Call
+}
+
+// GetSpecApprovalSchedule is a helper method to define mock.On call
+//   - specID int64
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) GetSpecApprovalSchedule(specID interface{}, qopts ...interface{}) *ORM_GetSpecApprovalSchedule_Call {
+	return &ORM_GetSpecApprovalSchedule_Call{Call: _e.mock.On("GetSpecApprovalSchedule",
+		append([]interface{}{specID}, qopts...)...)}
+}
+
+func (_c *ORM_GetSpecApprovalSchedule_Call) Run(run func(specID int64, qopts ...pg.QOpt)) *ORM_GetSpecApprovalSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(args[0].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_GetSpecApprovalSchedule_Call) Return(_a0 *feeds.SpecApprovalSchedule, _a1 error) *ORM_GetSpecApprovalSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetSpecApprovalSchedule_Call) RunAndReturn(run func(int64, ...pg.QOpt) (*feeds.SpecApprovalSchedule, error)) *ORM_GetSpecApprovalSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsJobManaged provides a mock function with given fields: jobID, qopts
 func (_m *ORM) IsJobManaged(jobID int64, qopts ...pg.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
@@ -1670,6 +1804,77 @@ func (_c *ORM_ListManagersByIDs_Call) RunAndReturn(run func([]int64) ([]feeds.Fe
 	return _c
 }
 
+// ListSpecApprovalSchedules provides a mock function with given fields: qopts
+func (_m *ORM) ListSpecApprovalSchedules(qopts ...pg.QOpt) ([]feeds.SpecApprovalSchedule, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSpecApprovalSchedules")
+	}
+
+	var r0 []feeds.SpecApprovalSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) ([]feeds.SpecApprovalSchedule, error)); ok {
+		return rf(qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []feeds.SpecApprovalSchedule); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.SpecApprovalSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_ListSpecApprovalSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSpecApprovalSchedules'
+type ORM_ListSpecApprovalSchedules_Call struct {
+	*mock.Call
+}
+
+// ListSpecApprovalSchedules is a helper method to define mock.On call
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) ListSpecApprovalSchedules(qopts ...interface{}) *ORM_ListSpecApprovalSchedules_Call {
+	return &ORM_ListSpecApprovalSchedules_Call{Call: _e.mock.On("ListSpecApprovalSchedules",
+		append([]interface{}{}, qopts...)...)}
+}
+
+func (_c *ORM_ListSpecApprovalSchedules_Call) Run(run func(qopts ...pg.QOpt)) *ORM_ListSpecApprovalSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_ListSpecApprovalSchedules_Call) Return(_a0 []feeds.SpecApprovalSchedule, _a1 error) *ORM_ListSpecApprovalSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_ListSpecApprovalSchedules_Call) RunAndReturn(run func(...pg.QOpt) ([]feeds.SpecApprovalSchedule, error)) *ORM_ListSpecApprovalSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSpecsByJobProposalIDs provides a mock function with given fields: ids, qopts
 func (_m *ORM) ListSpecsByJobProposalIDs(ids []int64, qopts ...pg.QOpt) ([]feeds.JobProposalSpec, error) {
 	_va := make([]interface{}, len(qopts))
@@ -2177,6 +2382,67 @@ func (_c *ORM_UpsertJobProposal_Call) RunAndReturn(run func(*feeds.JobProposal,
 	return _c
 }
 
+// UpsertSpecApprovalSchedule provides a mock function with given fields: schedule, qopts
+func (_m *ORM) UpsertSpecApprovalSchedule(schedule feeds.SpecApprovalSchedule, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, schedule)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertSpecApprovalSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(feeds.SpecApprovalSchedule, ...pg.QOpt) error); ok {
+		r0 = rf(schedule, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpsertSpecApprovalSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertSpecApprovalSchedule'
+type ORM_UpsertSpecApprovalSchedule_Call struct {
+	*mock.Call
+}
+
+// UpsertSpecApprovalSchedule is a helper method to define mock.On call
+//   - schedule feeds.SpecApprovalSchedule
+//   - qopts ...pg.QOpt
+func (_e *ORM_Expecter) UpsertSpecApprovalSchedule(schedule interface{}, qopts ...interface{}) *ORM_UpsertSpecApprovalSchedule_Call {
+	return &ORM_UpsertSpecApprovalSchedule_Call{Call: _e.mock.On("UpsertSpecApprovalSchedule",
+		append([]interface{}{schedule}, qopts...)...)}
+}
+
+func (_c *ORM_UpsertSpecApprovalSchedule_Call) Run(run func(schedule feeds.SpecApprovalSchedule, qopts ...pg.QOpt)) *ORM_UpsertSpecApprovalSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]pg.QOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(pg.QOpt)
+			}
+		}
+		run(args[0].(feeds.SpecApprovalSchedule), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertSpecApprovalSchedule_Call) Return(_a0 error) *ORM_UpsertSpecApprovalSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpsertSpecApprovalSchedule_Call) RunAndReturn(run func(feeds.SpecApprovalSchedule, ...pg.QOpt) error) *ORM_UpsertSpecApprovalSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewORM(t interface {
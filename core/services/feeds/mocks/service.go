@@ -330,6 +330,66 @@ func (_m *Service) GetSpec(id int64) (*feeds.JobProposalSpec, error) {
 	return r0, r1
 }
 
+// GetSpecApprovalSchedule provides a mock function with given fields: ctx, id
+func (_m *Service) GetSpecApprovalSchedule(ctx context.Context, id int64) (*feeds.SpecApprovalSchedule, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpecApprovalSchedule")
+	}
+
+	var r0 *feeds.SpecApprovalSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*feeds.SpecApprovalSchedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.SpecApprovalSchedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.SpecApprovalSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSpecDiff provides a mock function with given fields: ctx, id
+func (_m *Service) GetSpecDiff(ctx context.Context, id int64) (*feeds.SpecDiff, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpecDiff")
+	}
+
+	var r0 *feeds.SpecDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*feeds.SpecDiff, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.SpecDiff); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.SpecDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsJobManaged provides a mock function with given fields: ctx, jobID
 func (_m *Service) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 	ret := _m.Called(ctx, jobID)
@@ -640,6 +700,24 @@ func (_m *Service) RevokeJob(ctx context.Context, args *feeds.RevokeJobArgs) (in
 	return r0, r1
 }
 
+// ScheduleSpecApproval provides a mock function with given fields: ctx, id, force, schedule
+func (_m *Service) ScheduleSpecApproval(ctx context.Context, id int64, force bool, schedule feeds.ApprovalSchedule) error {
+	ret := _m.Called(ctx, id, force, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleSpecApproval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool, feeds.ApprovalSchedule) error); ok {
+		r0 = rf(ctx, id, force, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Service) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -681,6 +759,24 @@ func (_m *Service) Unsafe_SetConnectionsManager(_a0 feeds.ConnectionsManager) {
 	_m.Called(_a0)
 }
 
+// UnscheduleSpecApproval provides a mock function with given fields: ctx, id
+func (_m *Service) UnscheduleSpecApproval(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnscheduleSpecApproval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateChainConfig provides a mock function with given fields: ctx, cfg
 func (_m *Service) UpdateChainConfig(ctx context.Context, cfg feeds.ChainConfig) (int64, error) {
 	ret := _m.Called(ctx, cfg)
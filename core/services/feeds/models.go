@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
 )
 
@@ -245,6 +246,23 @@ func (s *JobProposalSpec) CanEditDefinition() bool {
 		s.Status == SpecStatusCancelled
 }
 
+// SpecApprovalSchedule defers the approval of a job proposal spec until
+// either a timestamp or a block height of an EVM chain is reached, so that
+// the nodes of a DON can switch to a new spec version together.
+type SpecApprovalSchedule struct {
+	JobProposalSpecID int64
+	Force             bool
+	ApproveAt         null.Time
+	ApproveAtBlock    null.Int
+	EVMChainID        *big.Big `db:"evm_chain_id"`
+	CreatedAt         time.Time
+}
+
+// IsBlockHeight returns true if the approval is scheduled at a block height.
+func (s *SpecApprovalSchedule) IsBlockHeight() bool {
+	return s.ApproveAtBlock.Valid
+}
+
 // JobProposalCounts defines the counts for job proposals of each status.
 type JobProposalCounts struct {
 	Pending   int64
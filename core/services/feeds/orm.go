@@ -55,6 +55,11 @@ type ORM interface {
 	RevokeSpec(id int64, qopts ...pg.QOpt) error
 	UpdateSpecDefinition(id int64, spec string, qopts ...pg.QOpt) error
 
+	DeleteSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) error
+	GetSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) (*SpecApprovalSchedule, error)
+	ListSpecApprovalSchedules(qopts ...pg.QOpt) ([]SpecApprovalSchedule, error)
+	UpsertSpecApprovalSchedule(schedule SpecApprovalSchedule, qopts ...pg.QOpt) error
+
 	IsJobManaged(jobID int64, qopts ...pg.QOpt) (bool, error)
 }
 
@@ -481,7 +486,8 @@ WHERE id = $3;
 		return sql.ErrNoRows
 	}
 
-	return nil
+	// An approved spec no longer needs to be approved on schedule
+	return o.DeleteSpecApprovalSchedule(id, qopts...)
 }
 
 // CancelSpec cancels the spec and removes the external job id from the associated job proposal. It
@@ -802,6 +808,72 @@ WHERE id = $2;
 	return nil
 }
 
+// DeleteSpecApprovalSchedule removes the approval schedule of a spec. It
+// does not return an error if the spec was not scheduled for approval.
+func (o *orm) DeleteSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) error {
+	stmt := `
+DELETE FROM job_proposal_spec_approval_schedules
+WHERE job_proposal_spec_id = $1;
+`
+
+	_, err := o.q.WithOpts(qopts...).Exec(stmt, specID)
+
+	return errors.Wrap(err, "DeleteSpecApprovalSchedule failed")
+}
+
+// GetSpecApprovalSchedule gets the approval schedule of a spec.
+func (o *orm) GetSpecApprovalSchedule(specID int64, qopts ...pg.QOpt) (*SpecApprovalSchedule, error) {
+	stmt := `
+SELECT job_proposal_spec_id, force, approve_at, approve_at_block, evm_chain_id, created_at
+FROM job_proposal_spec_approval_schedules
+WHERE job_proposal_spec_id = $1;
+`
+
+	var schedule SpecApprovalSchedule
+	err := o.q.WithOpts(qopts...).Get(&schedule, stmt, specID)
+
+	return &schedule, errors.Wrap(err, "GetSpecApprovalSchedule failed")
+}
+
+// ListSpecApprovalSchedules lists all spec approval schedules, oldest first.
+func (o *orm) ListSpecApprovalSchedules(qopts ...pg.QOpt) ([]SpecApprovalSchedule, error) {
+	stmt := `
+SELECT job_proposal_spec_id, force, approve_at, approve_at_block, evm_chain_id, created_at
+FROM job_proposal_spec_approval_schedules
+ORDER BY created_at, job_proposal_spec_id;
+`
+
+	var schedules []SpecApprovalSchedule
+	err := o.q.WithOpts(qopts...).Select(&schedules, stmt)
+
+	return schedules, errors.Wrap(err, "ListSpecApprovalSchedules failed")
+}
+
+// UpsertSpecApprovalSchedule schedules the approval of a spec, replacing any
+// existing schedule of the same spec.
+func (o *orm) UpsertSpecApprovalSchedule(schedule SpecApprovalSchedule, qopts ...pg.QOpt) error {
+	stmt := `
+INSERT INTO job_proposal_spec_approval_schedules (job_proposal_spec_id, force, approve_at, approve_at_block, evm_chain_id, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (job_proposal_spec_id) DO UPDATE SET
+	force = EXCLUDED.force,
+	approve_at = EXCLUDED.approve_at,
+	approve_at_block = EXCLUDED.approve_at_block,
+	evm_chain_id = EXCLUDED.evm_chain_id,
+	created_at = EXCLUDED.created_at;
+`
+
+	_, err := o.q.WithOpts(qopts...).Exec(stmt,
+		schedule.JobProposalSpecID,
+		schedule.Force,
+		schedule.ApproveAt,
+		schedule.ApproveAtBlock,
+		schedule.EVMChainID,
+	)
+
+	return errors.Wrap(err, "UpsertSpecApprovalSchedule failed")
+}
+
 // IsJobManaged determines if a job is managed by the feeds manager.
 func (o *orm) IsJobManaged(jobID int64, qopts ...pg.QOpt) (exists bool, err error) {
 	stmt := `
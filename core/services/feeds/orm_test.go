@@ -3,6 +3,7 @@ package feeds_test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
@@ -1589,6 +1591,67 @@ func Test_ORM_UpdateSpecDefinition(t *testing.T) {
 
 // Other
 
+func Test_ORM_SpecApprovalSchedules(t *testing.T) {
+	t.Parallel()
+
+	var (
+		orm           = setupORM(t)
+		fmID          = createFeedsManager(t, orm)
+		jpID          = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		specID        = createJobSpec(t, orm, jpID)
+		externalJobID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+		approveAt     = time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	)
+
+	_, err := orm.GetSpecApprovalSchedule(specID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = orm.UpsertSpecApprovalSchedule(feeds.SpecApprovalSchedule{
+		JobProposalSpecID: specID,
+		ApproveAt:         null.TimeFrom(approveAt),
+	})
+	require.NoError(t, err)
+
+	actual, err := orm.GetSpecApprovalSchedule(specID)
+	require.NoError(t, err)
+	assert.Equal(t, specID, actual.JobProposalSpecID)
+	assert.False(t, actual.Force)
+	assert.False(t, actual.IsBlockHeight())
+	assert.True(t, approveAt.Equal(actual.ApproveAt.Time))
+	assert.Nil(t, actual.EVMChainID)
+
+	// Replace the timestamp with a block height
+	err = orm.UpsertSpecApprovalSchedule(feeds.SpecApprovalSchedule{
+		JobProposalSpecID: specID,
+		Force:             true,
+		ApproveAtBlock:    null.IntFrom(100),
+		EVMChainID:        big.NewI(1337),
+	})
+	require.NoError(t, err)
+
+	schedules, err := orm.ListSpecApprovalSchedules()
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.True(t, schedules[0].Force)
+	assert.True(t, schedules[0].IsBlockHeight())
+	assert.False(t, schedules[0].ApproveAt.Valid)
+	assert.Equal(t, int64(100), schedules[0].ApproveAtBlock.Int64)
+	assert.Equal(t, big.NewI(1337), schedules[0].EVMChainID)
+
+	// Approving the spec removes its schedule
+	require.NoError(t, utils.JustError(orm.db.Exec(
+		`SET CONSTRAINTS job_proposals_job_id_fkey DEFERRED`,
+	)))
+	err = orm.ApproveSpec(specID, externalJobID.UUID)
+	require.NoError(t, err)
+
+	_, err = orm.GetSpecApprovalSchedule(specID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Deleting a missing schedule is a no-op
+	require.NoError(t, orm.DeleteSpecApprovalSchedule(specID))
+}
+
 func Test_ORM_IsJobManaged(t *testing.T) {
 	t.Parallel()
 
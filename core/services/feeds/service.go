@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
@@ -46,6 +48,9 @@ var (
 	ErrJobAlreadyExists     = errors.New("a job for this contract address already exists - please use the 'force' option to replace it")
 	ErrFeedsManagerDisabled = errors.New("feeds manager is disabled")
 
+	// approvalSchedulerInterval is how often approval schedules are checked
+	approvalSchedulerInterval = 2 * time.Second
+
 	promJobProposalRequest = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "feeds_job_proposal_requests",
 		Help: "Metric to track job proposal requests",
@@ -92,8 +97,12 @@ type Service interface {
 	ApproveSpec(ctx context.Context, id int64, force bool) error
 	CancelSpec(ctx context.Context, id int64) error
 	GetSpec(id int64) (*JobProposalSpec, error)
+	GetSpecApprovalSchedule(ctx context.Context, id int64) (*SpecApprovalSchedule, error)
+	GetSpecDiff(ctx context.Context, id int64) (*SpecDiff, error)
 	ListSpecsByJobProposalIDs(ids []int64) ([]JobProposalSpec, error)
 	RejectSpec(ctx context.Context, id int64) error
+	ScheduleSpecApproval(ctx context.Context, id int64, force bool, schedule ApprovalSchedule) error
+	UnscheduleSpecApproval(ctx context.Context, id int64) error
 	UpdateSpecDefinition(ctx context.Context, id int64, spec string) error
 
 	Unsafe_SetConnectionsManager(ConnectionsManager)
@@ -118,6 +127,9 @@ type service struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	version      string
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
 }
 
 // NewService constructs a new feeds service
@@ -154,6 +166,7 @@ func NewService(
 		legacyChains: legacyChains,
 		lggr:         lggr,
 		version:      version,
+		chStop:       make(services.StopChan),
 	}
 
 	return svc
@@ -934,6 +947,126 @@ func (s *service) CancelSpec(ctx context.Context, id int64) error {
 	return nil
 }
 
+// GetSpecDiff compares a spec with the spec currently approved for its job
+// proposal. If no spec of the proposal has been approved yet, every field and
+// task of the spec is reported as added.
+func (s *service) GetSpecDiff(ctx context.Context, id int64) (*SpecDiff, error) {
+	pctx := pg.WithParentCtx(ctx)
+
+	spec, err := s.orm.GetSpec(id, pctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal spec")
+	}
+
+	var current string
+	approvedSpec, err := s.orm.GetApprovedSpec(spec.JobProposalID, pctx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "orm: approved job proposal spec")
+		}
+	} else {
+		current = approvedSpec.Definition
+	}
+
+	return DiffSpecs(current, spec.Definition)
+}
+
+// ApprovalSchedule defines when a scheduled spec approval takes place. Exactly
+// one of ApproveAt and ApproveAtBlock must be set, and ApproveAtBlock refers
+// to the chain identified by EVMChainID.
+type ApprovalSchedule struct {
+	ApproveAt      null.Time
+	ApproveAtBlock null.Int
+	EVMChainID     *big.Big
+}
+
+func (a ApprovalSchedule) validate() error {
+	if a.ApproveAt.Valid == a.ApproveAtBlock.Valid {
+		return errors.New("exactly one of an approval time or block height must be provided")
+	}
+
+	if a.ApproveAtBlock.Valid {
+		if a.ApproveAtBlock.Int64 < 0 {
+			return errors.New("approval block height must not be negative")
+		}
+		if a.EVMChainID == nil {
+			return errors.New("an evm chain id must be provided with an approval block height")
+		}
+	} else if a.EVMChainID != nil {
+		return errors.New("an evm chain id can only be provided with an approval block height")
+	}
+
+	return nil
+}
+
+// ScheduleSpecApproval schedules the approval of a spec at a timestamp or a
+// block height, replacing any previous schedule of the spec. The spec is
+// checked to be approvable when scheduling, and is approved with ApproveSpec
+// once the schedule is due.
+func (s *service) ScheduleSpecApproval(ctx context.Context, id int64, force bool, schedule ApprovalSchedule) error {
+	if err := schedule.validate(); err != nil {
+		return err
+	}
+
+	if schedule.ApproveAtBlock.Valid {
+		if _, err := s.legacyChains.Get(schedule.EVMChainID.String()); err != nil {
+			return errors.Wrap(err, "failed to get evm chain")
+		}
+	}
+
+	pctx := pg.WithParentCtx(ctx)
+
+	spec, err := s.orm.GetSpec(id, pctx)
+	if err != nil {
+		return errors.Wrap(err, "orm: job proposal spec")
+	}
+
+	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+	if err != nil {
+		return errors.Wrap(err, "orm: job proposal")
+	}
+
+	if err = s.isApprovable(proposal.Status, proposal.ID, spec.Status, spec.ID); err != nil {
+		return err
+	}
+
+	if _, err = s.generateJob(spec.Definition); err != nil {
+		return errors.Wrap(err, "could not generate job from spec")
+	}
+
+	if err = s.orm.UpsertSpecApprovalSchedule(SpecApprovalSchedule{
+		JobProposalSpecID: id,
+		Force:             force,
+		ApproveAt:         schedule.ApproveAt,
+		ApproveAtBlock:    schedule.ApproveAtBlock,
+		EVMChainID:        schedule.EVMChainID,
+	}, pctx); err != nil {
+		return errors.Wrap(err, "could not schedule job proposal spec approval")
+	}
+
+	return nil
+}
+
+// UnscheduleSpecApproval removes the approval schedule of a spec.
+func (s *service) UnscheduleSpecApproval(ctx context.Context, id int64) error {
+	pctx := pg.WithParentCtx(ctx)
+
+	if _, err := s.orm.GetSpecApprovalSchedule(id, pctx); err != nil {
+		return errors.Wrap(err, "orm: job proposal spec approval schedule")
+	}
+
+	if err := s.orm.DeleteSpecApprovalSchedule(id, pctx); err != nil {
+		return errors.Wrap(err, "could not unschedule job proposal spec approval")
+	}
+
+	return nil
+}
+
+// GetSpecApprovalSchedule gets the approval schedule of a spec.
+func (s *service) GetSpecApprovalSchedule(ctx context.Context, id int64) (*SpecApprovalSchedule, error) {
+	return s.orm.GetSpecApprovalSchedule(id, pg.WithParentCtx(ctx))
+}
+
 // ListSpecsByJobProposalIDs gets the specs which belong to the job proposal ids.
 func (s *service) ListSpecsByJobProposalIDs(ids []int64) ([]JobProposalSpec, error) {
 	return s.orm.ListSpecsByJobProposalIDs(ids)
@@ -982,6 +1115,11 @@ func (s *service) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		// Scheduled approvals also apply to a feeds manager registered later on
+		s.wgDone.Add(1)
+		go s.runApprovalScheduler()
+
 		if len(mgrs) < 1 {
 			s.lggr.Info("no feeds managers registered")
 
@@ -1002,6 +1140,9 @@ func (s *service) Start(ctx context.Context) error {
 // Close shuts down the service
 func (s *service) Close() error {
 	return s.StopOnce("FeedsService", func() error {
+		close(s.chStop)
+		s.wgDone.Wait()
+
 		// This blocks until it finishes
 		s.connMgr.Close()
 
@@ -1009,6 +1150,126 @@ func (s *service) Close() error {
 	})
 }
 
+// runApprovalScheduler periodically approves the specs whose approval
+// schedule is due.
+func (s *service) runApprovalScheduler() {
+	defer s.wgDone.Done()
+
+	ctx, cancel := s.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(approvalSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-ticker.C:
+			s.processApprovalSchedules(ctx)
+		}
+	}
+}
+
+// processApprovalSchedules approves all specs whose approval schedule is due.
+// Schedules of specs which can no longer be approved are removed, while other
+// approval failures are retried on the next run.
+func (s *service) processApprovalSchedules(ctx context.Context) {
+	pctx := pg.WithParentCtx(ctx)
+
+	schedules, err := s.orm.ListSpecApprovalSchedules(pctx)
+	if err != nil {
+		s.lggr.Errorw("Failed to list job proposal spec approval schedules", "err", err)
+
+		return
+	}
+
+	now := time.Now()
+	heads := make(map[string]int64)
+	for _, schedule := range schedules {
+		logger := s.lggr.With("job_proposal_spec_id", schedule.JobProposalSpecID)
+
+		spec, err := s.orm.GetSpec(schedule.JobProposalSpecID, pctx)
+		if err != nil {
+			logger.Errorw("Failed to get scheduled job proposal spec", "err", err)
+
+			continue
+		}
+
+		proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+		if err != nil {
+			logger.Errorw("Failed to get job proposal of scheduled spec", "err", err)
+
+			continue
+		}
+
+		if err = s.isApprovable(proposal.Status, proposal.ID, spec.Status, spec.ID); err != nil {
+			logger.Warnw("Removing approval schedule of a spec which can no longer be approved", "reason", err)
+			s.removeApprovalSchedule(ctx, schedule.JobProposalSpecID)
+
+			continue
+		}
+
+		due, err := s.isApprovalDue(ctx, schedule, now, heads)
+		if err != nil {
+			logger.Errorw("Failed to check if job proposal spec approval is due", "err", err)
+
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		if err = s.ApproveSpec(ctx, schedule.JobProposalSpecID, schedule.Force); err != nil {
+			logger.Errorw("Failed to approve scheduled job proposal spec", "err", err)
+
+			if errors.Is(err, ErrJobAlreadyExists) {
+				s.removeApprovalSchedule(ctx, schedule.JobProposalSpecID)
+			}
+
+			continue
+		}
+
+		logger.Infow("Approved scheduled job proposal spec", "version", spec.Version)
+	}
+}
+
+// isApprovalDue checks if the schedule timestamp or block height has been
+// reached. Latest heads are fetched at most once per chain and cached in heads.
+func (s *service) isApprovalDue(ctx context.Context, schedule SpecApprovalSchedule, now time.Time, heads map[string]int64) (bool, error) {
+	if !schedule.IsBlockHeight() {
+		return !schedule.ApproveAt.Time.After(now), nil
+	}
+
+	chainID := schedule.EVMChainID.String()
+	latest, ok := heads[chainID]
+	if !ok {
+		chain, err := s.legacyChains.Get(chainID)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get evm chain")
+		}
+
+		head, err := chain.Client().HeadByNumber(ctx, nil)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get latest head")
+		}
+		if head == nil {
+			return false, errors.New("latest head is unavailable")
+		}
+
+		latest = head.Number
+		heads[chainID] = latest
+	}
+
+	return latest >= schedule.ApproveAtBlock.Int64, nil
+}
+
+func (s *service) removeApprovalSchedule(ctx context.Context, specID int64) {
+	if err := s.orm.DeleteSpecApprovalSchedule(specID, pg.WithParentCtx(ctx)); err != nil {
+		s.lggr.Errorw("Failed to remove job proposal spec approval schedule", "job_proposal_spec_id", specID, "err", err)
+	}
+}
+
 // connectFeedManager connects to a feeds manager
 func (s *service) connectFeedManager(ctx context.Context, mgr FeedsManager, privkey []byte) {
 	s.connMgr.Connect(ConnectOpts{
@@ -1153,6 +1414,7 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	js.SourceTOML = spec
 
 	return &js, nil
 }
@@ -1419,6 +1681,12 @@ func (ns NullService) ListManagersByIDs(ids []int64) ([]FeedsManager, error) {
 func (ns NullService) GetSpec(id int64) (*JobProposalSpec, error) {
 	return nil, ErrFeedsManagerDisabled
 }
+func (ns NullService) GetSpecApprovalSchedule(ctx context.Context, id int64) (*SpecApprovalSchedule, error) {
+	return nil, ErrFeedsManagerDisabled
+}
+func (ns NullService) GetSpecDiff(ctx context.Context, id int64) (*SpecDiff, error) {
+	return nil, ErrFeedsManagerDisabled
+}
 func (ns NullService) ListManagers() ([]FeedsManager, error) { return nil, nil }
 func (ns NullService) CreateChainConfig(ctx context.Context, cfg ChainConfig) (int64, error) {
 	return 0, ErrFeedsManagerDisabled
@@ -1454,6 +1722,12 @@ func (ns NullService) RegisterManager(ctx context.Context, params RegisterManage
 func (ns NullService) RejectSpec(ctx context.Context, id int64) error {
 	return ErrFeedsManagerDisabled
 }
+func (ns NullService) ScheduleSpecApproval(ctx context.Context, id int64, force bool, schedule ApprovalSchedule) error {
+	return ErrFeedsManagerDisabled
+}
+func (ns NullService) UnscheduleSpecApproval(ctx context.Context, id int64) error {
+	return ErrFeedsManagerDisabled
+}
 func (ns NullService) SyncNodeInfo(ctx context.Context, id int64) error { return nil }
 func (ns NullService) UpdateManager(ctx context.Context, mgr FeedsManager) error {
 	return ErrFeedsManagerDisabled
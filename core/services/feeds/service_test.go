@@ -3472,3 +3472,222 @@ func Test_Service_StartStop(t *testing.T) {
 		})
 	}
 }
+
+func Test_Service_GetSpecDiff(t *testing.T) {
+	var (
+		ctx          = testutils.Context(t)
+		jpID         = int64(1)
+		approvedSpec = &feeds.JobProposalSpec{
+			ID:            1,
+			Status:        feeds.SpecStatusApproved,
+			JobProposalID: jpID,
+			Definition:    fmt.Sprintf(FluxMonitorTestSpecTemplate, "old", uuid.New()),
+		}
+		spec = &feeds.JobProposalSpec{
+			ID:            2,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jpID,
+			Definition:    fmt.Sprintf(FluxMonitorTestSpecTemplate, "new", uuid.New()),
+		}
+	)
+
+	testCases := []struct {
+		name       string
+		before     func(svc *TestService)
+		wantFields []string
+		wantErr    string
+	}{
+		{
+			name: "diff against the approved spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", jpID, mock.Anything).Return(approvedSpec, nil)
+			},
+			wantFields: []string{"externalJobID", "name"},
+		},
+		{
+			name: "no approved spec",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", jpID, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			wantFields: []string{
+				"absoluteThreshold", "contractAddress", "externalJobID", "idleTimerDisabled", "idleTimerPeriod",
+				"name", "pollTimerDisabled", "pollTimerPeriod", "schemaVersion", "threshold", "type",
+			},
+		},
+		{
+			name: "spec does not exist",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "orm: job proposal spec: sql: no rows in result set",
+		},
+		{
+			name: "approved spec fetch fails",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", jpID, mock.Anything).Return(nil, errors.New("orm error"))
+			},
+			wantErr: "orm: approved job proposal spec: orm error",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+
+			tc.before(svc)
+
+			diff, err := svc.GetSpecDiff(ctx, spec.ID)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var fields []string
+			for _, f := range diff.Fields {
+				fields = append(fields, f.Path)
+			}
+			assert.Equal(t, tc.wantFields, fields)
+		})
+	}
+}
+
+func Test_Service_ScheduleSpecApproval(t *testing.T) {
+	var (
+		ctx  = testutils.Context(t)
+		jp   = &feeds.JobProposal{ID: 1, FeedsManagerID: 100}
+		spec = &feeds.JobProposalSpec{
+			ID:            20,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jp.ID,
+			Definition:    fmt.Sprintf(FluxMonitorTestSpecTemplate, "scheduled", uuid.New()),
+		}
+		approveAt = null.TimeFrom(time.Now().Add(time.Hour))
+	)
+
+	testCases := []struct {
+		name     string
+		before   func(svc *TestService)
+		schedule feeds.ApprovalSchedule
+		wantErr  string
+	}{
+		{
+			name: "success at a timestamp",
+			before: func(svc *TestService) {
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(spec, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+				svc.orm.On("UpsertSpecApprovalSchedule", feeds.SpecApprovalSchedule{
+					JobProposalSpecID: spec.ID,
+					Force:             true,
+					ApproveAt:         approveAt,
+				}, mock.Anything).Return(nil)
+			},
+			schedule: feeds.ApprovalSchedule{ApproveAt: approveAt},
+		},
+		{
+			name:     "both timestamp and block height",
+			schedule: feeds.ApprovalSchedule{ApproveAt: approveAt, ApproveAtBlock: null.IntFrom(1), EVMChainID: big.NewI(0)},
+			wantErr:  "exactly one of an approval time or block height must be provided",
+		},
+		{
+			name:     "neither timestamp nor block height",
+			schedule: feeds.ApprovalSchedule{},
+			wantErr:  "exactly one of an approval time or block height must be provided",
+		},
+		{
+			name:     "block height without a chain",
+			schedule: feeds.ApprovalSchedule{ApproveAtBlock: null.IntFrom(1)},
+			wantErr:  "an evm chain id must be provided with an approval block height",
+		},
+		{
+			name:     "unknown chain",
+			schedule: feeds.ApprovalSchedule{ApproveAtBlock: null.IntFrom(1), EVMChainID: big.NewI(123456)},
+			wantErr:  "failed to get evm chain: chain id does not exist: 123456",
+		},
+		{
+			name: "spec is not approvable",
+			before: func(svc *TestService) {
+				rejected := *spec
+				rejected.Status = feeds.SpecStatusRejected
+				svc.orm.On("GetSpec", spec.ID, mock.Anything).Return(&rejected, nil)
+				svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+			},
+			schedule: feeds.ApprovalSchedule{ApproveAt: approveAt},
+			wantErr:  "cannot approve a rejected spec",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			err := svc.ScheduleSpecApproval(ctx, spec.ID, true, tc.schedule)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_Service_UnscheduleSpecApproval(t *testing.T) {
+	var (
+		ctx    = testutils.Context(t)
+		specID = int64(20)
+	)
+
+	t.Run("success", func(t *testing.T) {
+		svc := setupTestService(t)
+
+		svc.orm.On("GetSpecApprovalSchedule", specID, mock.Anything).Return(&feeds.SpecApprovalSchedule{JobProposalSpecID: specID}, nil)
+		svc.orm.On("DeleteSpecApprovalSchedule", specID, mock.Anything).Return(nil)
+
+		require.NoError(t, svc.UnscheduleSpecApproval(ctx, specID))
+	})
+
+	t.Run("not scheduled", func(t *testing.T) {
+		svc := setupTestService(t)
+
+		svc.orm.On("GetSpecApprovalSchedule", specID, mock.Anything).Return(nil, sql.ErrNoRows)
+
+		err := svc.UnscheduleSpecApproval(ctx, specID)
+		assert.EqualError(t, err, "orm: job proposal spec approval schedule: sql: no rows in result set")
+	})
+}
+
+func Test_Service_ProcessApprovalSchedules(t *testing.T) {
+	var (
+		ctx     = testutils.Context(t)
+		jp      = &feeds.JobProposal{ID: 1, FeedsManagerID: 100}
+		pending = &feeds.JobProposalSpec{ID: 20, Status: feeds.SpecStatusPending, JobProposalID: jp.ID}
+		revoked = &feeds.JobProposalSpec{ID: 21, Status: feeds.SpecStatusRevoked, JobProposalID: jp.ID}
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("ListSpecApprovalSchedules", mock.Anything).Return([]feeds.SpecApprovalSchedule{
+		{JobProposalSpecID: pending.ID, ApproveAt: null.TimeFrom(time.Now().Add(time.Hour))},
+		{JobProposalSpecID: revoked.ID, ApproveAt: null.TimeFrom(time.Now().Add(-time.Hour))},
+	}, nil)
+	svc.orm.On("GetSpec", pending.ID, mock.Anything).Return(pending, nil)
+	svc.orm.On("GetSpec", revoked.ID, mock.Anything).Return(revoked, nil)
+	svc.orm.On("GetJobProposal", jp.ID, mock.Anything).Return(jp, nil)
+	// The revoked spec can't be approved anymore, while the pending spec is not due yet
+	svc.orm.On("DeleteSpecApprovalSchedule", revoked.ID, mock.Anything).Return(nil).Once()
+
+	svc.Service.(interface {
+		ProcessApprovalSchedules(ctx context.Context)
+	}).ProcessApprovalSchedules(ctx)
+}
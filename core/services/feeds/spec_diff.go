@@ -0,0 +1,246 @@
+package feeds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// DiffChange describes how an element of a spec differs between two versions.
+type DiffChange string
+
+const (
+	DiffChangeAdded   DiffChange = "added"
+	DiffChangeRemoved DiffChange = "removed"
+	DiffChangeChanged DiffChange = "changed"
+)
+
+// observationSourceField holds the pipeline DAG, which is diffed separately
+// task by task instead of as a single string field.
+const observationSourceField = "observationSource"
+
+// FieldDiff is a difference in a single TOML field, identified by its dotted
+// path (e.g. "relayConfig.chainID"). Values are JSON encoded, and empty when
+// the field does not exist in the corresponding spec.
+type FieldDiff struct {
+	Path   string
+	Change DiffChange
+	Old    string
+	New    string
+}
+
+// TaskDiff is a difference in a single pipeline task, identified by its DOT ID.
+type TaskDiff struct {
+	DotID         string
+	Change        DiffChange
+	OldAttributes map[string]string
+	NewAttributes map[string]string
+}
+
+// EdgeDiff is a dependency between two pipeline tasks which exists in only one
+// of the specs.
+type EdgeDiff struct {
+	From   string
+	To     string
+	Change DiffChange
+}
+
+// SpecDiff is a structured difference between two job spec TOML definitions.
+type SpecDiff struct {
+	Fields []FieldDiff
+	Tasks  []TaskDiff
+	Edges  []EdgeDiff
+}
+
+// IsEmpty returns true if both specs are equivalent.
+func (d *SpecDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && len(d.Tasks) == 0 && len(d.Edges) == 0
+}
+
+// DiffSpecs compares the TOML fields and the pipeline DAG of two job specs.
+// An empty current spec is treated as a spec without any fields, so that every
+// element of the proposed spec is reported as added.
+func DiffSpecs(current, proposed string) (*SpecDiff, error) {
+	currentFields, currentSource, err := flattenSpec(current)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse current spec")
+	}
+	proposedFields, proposedSource, err := flattenSpec(proposed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed spec")
+	}
+
+	currentGraph := pipeline.NewGraph()
+	if err = currentGraph.UnmarshalText([]byte(currentSource)); err != nil {
+		return nil, errors.Wrap(err, "failed to parse current observation source")
+	}
+	proposedGraph := pipeline.NewGraph()
+	if err = proposedGraph.UnmarshalText([]byte(proposedSource)); err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed observation source")
+	}
+
+	return &SpecDiff{
+		Fields: diffFields(currentFields, proposedFields),
+		Tasks:  diffTasks(graphTasks(currentGraph), graphTasks(proposedGraph)),
+		Edges:  diffEdges(graphEdges(currentGraph), graphEdges(proposedGraph)),
+	}, nil
+}
+
+// flattenSpec parses a TOML spec into a map of dotted field paths to JSON
+// encoded values, and returns the observation source separately.
+func flattenSpec(spec string) (map[string]string, string, error) {
+	var tree map[string]interface{}
+	if err := toml.Unmarshal([]byte(spec), &tree); err != nil {
+		return nil, "", err
+	}
+
+	var source string
+	if raw, ok := tree[observationSourceField]; ok {
+		s, ok := raw.(string)
+		if !ok {
+			return nil, "", errors.Errorf("%s must be a string", observationSourceField)
+		}
+		source = s
+		delete(tree, observationSourceField)
+	}
+
+	fields := make(map[string]string)
+	if err := flattenValue("", tree, fields); err != nil {
+		return nil, "", err
+	}
+
+	return fields, source, nil
+}
+
+func flattenValue(path string, value interface{}, fields map[string]string) error {
+	if m, ok := value.(map[string]interface{}); ok && (len(m) > 0 || path == "") {
+		for k, v := range m {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			if err := flattenValue(childPath, v, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode field %s", path)
+	}
+	fields[path] = string(encoded)
+
+	return nil
+}
+
+func diffFields(current, proposed map[string]string) []FieldDiff {
+	var diffs []FieldDiff
+	for path, old := range current {
+		updated, ok := proposed[path]
+		switch {
+		case !ok:
+			diffs = append(diffs, FieldDiff{Path: path, Change: DiffChangeRemoved, Old: old})
+		case old != updated:
+			diffs = append(diffs, FieldDiff{Path: path, Change: DiffChangeChanged, Old: old, New: updated})
+		}
+	}
+	for path, updated := range proposed {
+		if _, ok := current[path]; !ok {
+			diffs = append(diffs, FieldDiff{Path: path, Change: DiffChangeAdded, New: updated})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+
+	return diffs
+}
+
+func graphTasks(g *pipeline.Graph) map[string]map[string]string {
+	tasks := make(map[string]map[string]string)
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*pipeline.GraphNode)
+		attrs := make(map[string]string)
+		for _, attr := range node.Attributes() {
+			attrs[attr.Key] = attr.Value
+		}
+		tasks[node.DOTID()] = attrs
+	}
+	return tasks
+}
+
+func diffTasks(current, proposed map[string]map[string]string) []TaskDiff {
+	var diffs []TaskDiff
+	for id, old := range current {
+		updated, ok := proposed[id]
+		switch {
+		case !ok:
+			diffs = append(diffs, TaskDiff{DotID: id, Change: DiffChangeRemoved, OldAttributes: old})
+		case !equalAttributes(old, updated):
+			diffs = append(diffs, TaskDiff{DotID: id, Change: DiffChangeChanged, OldAttributes: old, NewAttributes: updated})
+		}
+	}
+	for id, updated := range proposed {
+		if _, ok := current[id]; !ok {
+			diffs = append(diffs, TaskDiff{DotID: id, Change: DiffChangeAdded, NewAttributes: updated})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].DotID < diffs[j].DotID })
+
+	return diffs
+}
+
+func equalAttributes(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+type edgeKey struct {
+	from, to string
+}
+
+func graphEdges(g *pipeline.Graph) map[edgeKey]struct{} {
+	edges := make(map[edgeKey]struct{})
+	for it := g.Edges(); it.Next(); {
+		edge := it.Edge()
+		from := edge.From().(*pipeline.GraphNode)
+		to := edge.To().(*pipeline.GraphNode)
+		edges[edgeKey{from: from.DOTID(), to: to.DOTID()}] = struct{}{}
+	}
+	return edges
+}
+
+func diffEdges(current, proposed map[edgeKey]struct{}) []EdgeDiff {
+	var diffs []EdgeDiff
+	for e := range current {
+		if _, ok := proposed[e]; !ok {
+			diffs = append(diffs, EdgeDiff{From: e.from, To: e.to, Change: DiffChangeRemoved})
+		}
+	}
+	for e := range proposed {
+		if _, ok := current[e]; !ok {
+			diffs = append(diffs, EdgeDiff{From: e.from, To: e.to, Change: DiffChangeAdded})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return edgeString(diffs[i]) < edgeString(diffs[j])
+	})
+
+	return diffs
+}
+
+func edgeString(e EdgeDiff) string {
+	return fmt.Sprintf("%s -> %s", e.From, e.To)
+}
@@ -0,0 +1,104 @@
+package feeds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+const diffCurrentSpec = `
+type          = "offchainreporting2"
+schemaVersion = 1
+name          = "eth/usd"
+contractID    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+
+observationSource = """
+ds1 [type=http method=GET url="https://example.com/eth"];
+jp1 [type=jsonparse path="data,price"];
+ds1 -> jp1;
+"""
+
+[relayConfig]
+chainID = 1337
+`
+
+const diffProposedSpec = `
+type          = "offchainreporting2"
+schemaVersion = 1
+name          = "eth/usd v2"
+maxTaskDuration = "10s"
+
+observationSource = """
+ds1 [type=http method=GET url="https://example.com/eth/v2"];
+jp1 [type=jsonparse path="data,price"];
+mul [type=multiply times=100];
+ds1 -> jp1 -> mul;
+"""
+
+[relayConfig]
+chainID = 1337
+`
+
+func Test_DiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identical specs", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := feeds.DiffSpecs(diffCurrentSpec, diffCurrentSpec)
+		require.NoError(t, err)
+		assert.True(t, diff.IsEmpty())
+	})
+
+	t.Run("fields and pipeline changes", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := feeds.DiffSpecs(diffCurrentSpec, diffProposedSpec)
+		require.NoError(t, err)
+		require.False(t, diff.IsEmpty())
+
+		assert.Equal(t, []feeds.FieldDiff{
+			{Path: "contractID", Change: feeds.DiffChangeRemoved, Old: `"0x613a38AC1659769640aaE063C651F48E0250454C"`},
+			{Path: "maxTaskDuration", Change: feeds.DiffChangeAdded, New: `"10s"`},
+			{Path: "name", Change: feeds.DiffChangeChanged, Old: `"eth/usd"`, New: `"eth/usd v2"`},
+		}, diff.Fields)
+
+		require.Len(t, diff.Tasks, 2)
+		assert.Equal(t, "ds1", diff.Tasks[0].DotID)
+		assert.Equal(t, feeds.DiffChangeChanged, diff.Tasks[0].Change)
+		assert.Equal(t, "https://example.com/eth", diff.Tasks[0].OldAttributes["url"])
+		assert.Equal(t, "https://example.com/eth/v2", diff.Tasks[0].NewAttributes["url"])
+		assert.Equal(t, "mul", diff.Tasks[1].DotID)
+		assert.Equal(t, feeds.DiffChangeAdded, diff.Tasks[1].Change)
+		assert.Nil(t, diff.Tasks[1].OldAttributes)
+		assert.Equal(t, "100", diff.Tasks[1].NewAttributes["times"])
+
+		assert.Equal(t, []feeds.EdgeDiff{
+			{From: "jp1", To: "mul", Change: feeds.DiffChangeAdded},
+		}, diff.Edges)
+	})
+
+	t.Run("empty current spec", func(t *testing.T) {
+		t.Parallel()
+
+		diff, err := feeds.DiffSpecs("", diffCurrentSpec)
+		require.NoError(t, err)
+
+		for _, f := range diff.Fields {
+			assert.Equal(t, feeds.DiffChangeAdded, f.Change)
+		}
+		assert.Len(t, diff.Fields, 5)
+		assert.Len(t, diff.Tasks, 2)
+		assert.Len(t, diff.Edges, 1)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := feeds.DiffSpecs(diffCurrentSpec, "not toml = ")
+		require.Error(t, err)
+	})
+}
@@ -51,6 +51,7 @@ const (
 	PollRequestTypeRetry
 	PollRequestTypeAwaken
 	PollRequestTypeDrumbeat
+	PollRequestTypeHeartbeat
 )
 
 // DefaultHibernationPollPeriod defines the hibernation polling period
@@ -79,6 +80,11 @@ type FluxMonitor struct {
 	logBroadcaster    log.Broadcaster
 	chainID           *big.Int
 
+	// lastHeartbeatAt and lastSubmittedAt are only accessed by the consume
+	// goroutine.
+	lastHeartbeatAt time.Time
+	lastSubmittedAt time.Time
+
 	logger logger.SugaredLogger
 
 	backlog       *utils.BoundedPriorityQueue[log.Broadcast]
@@ -239,6 +245,8 @@ func NewFromJobSpec(
 			DrumbeatSchedule:        fmSpec.DrumbeatSchedule,
 			DrumbeatEnabled:         fmSpec.DrumbeatEnabled,
 			DrumbeatRandomDelay:     fmSpec.DrumbeatRandomDelay,
+			HeartbeatSchedule:       fmSpec.HeartbeatSchedule,
+			MinSubmissionInterval:   fmSpec.MinSubmissionInterval,
 			HibernationPollPeriod:   DefaultHibernationPollPeriod, // Not currently configurable
 			MinRetryBackoffDuration: 1 * time.Minute,
 			MaxRetryBackoffDuration: 1 * time.Hour,
@@ -445,6 +453,12 @@ func (fm *FluxMonitor) consume() {
 				fm.pollIfEligible(PollRequestTypeDrumbeat, NewZeroDeviationChecker(fm.logger), nil)
 			})
 
+		case at := <-fm.pollManager.HeartbeatTicks():
+			tickLogger.Debugf("Heartbeat ticker fired on %v", formatTime(at))
+			recovery.WrapRecover(fm.logger, func() {
+				fm.pollIfEligible(PollRequestTypeHeartbeat, fm.heartbeatDeviationChecker(at), nil)
+			})
+
 		case request := <-fm.pollManager.Poll():
 			switch request.Type {
 			case PollRequestTypeUnknown:
@@ -458,6 +472,28 @@ func (fm *FluxMonitor) consume() {
 	}
 }
 
+// heartbeatDeviationChecker returns the deviation checker for a heartbeat at
+// the given time: a zero deviation checker, which forces a submission, unless
+// the feed was updated since the previous heartbeat.
+func (fm *FluxMonitor) heartbeatDeviationChecker(at time.Time) *DeviationChecker {
+	previous := fm.lastHeartbeatAt
+	fm.lastHeartbeatAt = at
+	if previous.IsZero() {
+		return NewZeroDeviationChecker(fm.logger)
+	}
+
+	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
+	if err != nil {
+		fm.logger.Warnw("Couldn't read latest round data for heartbeat, forcing a submission", "err", err)
+		return NewZeroDeviationChecker(fm.logger)
+	}
+	if lrd.UpdatedAt != nil && lrd.UpdatedAt.Int64() >= previous.Unix() {
+		fm.logger.Debugw("Feed was updated since the previous heartbeat", "updatedAt", lrd.UpdatedAt, "previousHeartbeat", previous)
+		return fm.deviationChecker
+	}
+	return NewZeroDeviationChecker(fm.logger)
+}
+
 func formatTime(at time.Time) string {
 	ago := time.Since(at)
 	return fmt.Sprintf("%v (%v ago)", at.UTC().Format(time.RFC3339), ago)
@@ -792,6 +828,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 		newRoundLogger.Errorf("unable to create job run: %v", err)
 		return
 	}
+	fm.lastSubmittedAt = time.Now()
 }
 
 var (
@@ -930,6 +967,12 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		return
 	}
 
+	if minInterval := fm.pollManager.cfg.MinSubmissionInterval; minInterval > 0 && time.Since(fm.lastSubmittedAt) < minInterval {
+		l.Infow("skipping poll: minimum submission interval has not elapsed", "lastSubmittedAt", fm.lastSubmittedAt, "minSubmissionInterval", minInterval)
+
+		return
+	}
+
 	var metaDataForBridge map[string]interface{}
 	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
 	if err != nil {
@@ -1022,6 +1065,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		l.Errorw("can't create job run", "err", err)
 		return
 	}
+	fm.lastSubmittedAt = time.Now()
 
 	promfm.SetDecimal(promfm.ReportedValue.WithLabelValues(jobID), answer)
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
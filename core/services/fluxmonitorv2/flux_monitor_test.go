@@ -1929,3 +1929,30 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 	cltest.EventuallyExpectationsMet(t, tm.pipelineORM, waitTime, interval)
 	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
 }
+
+func TestFluxMonitor_HeartbeatDeviationChecker(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupStoreWithKey(t)
+	fm, tm := setup(t, db, disableIdleTimer(true))
+
+	first := time.Now().Truncate(time.Second)
+	checker := fm.ExportedHeartbeatDeviationChecker(first)
+	assert.Zero(t, checker.Thresholds.Rel, "the first heartbeat always submits")
+	assert.Zero(t, checker.Thresholds.Abs)
+
+	second := first.Add(time.Hour)
+	tm.fluxAggregator.On("LatestRoundData", nilOpts).
+		Return(flux_aggregator_wrapper.LatestRoundData{UpdatedAt: big.NewInt(first.Add(time.Minute).Unix())}, nil).
+		Once()
+	checker = fm.ExportedHeartbeatDeviationChecker(second)
+	assert.Equal(t, threshold, checker.Thresholds.Rel, "the feed was updated since the previous heartbeat")
+	assert.Equal(t, absoluteThreshold, checker.Thresholds.Abs)
+
+	tm.fluxAggregator.On("LatestRoundData", nilOpts).
+		Return(flux_aggregator_wrapper.LatestRoundData{UpdatedAt: big.NewInt(first.Add(time.Minute).Unix())}, nil).
+		Once()
+	checker = fm.ExportedHeartbeatDeviationChecker(second.Add(time.Hour))
+	assert.Zero(t, checker.Thresholds.Rel, "the feed was not updated since the previous heartbeat")
+	assert.Zero(t, checker.Thresholds.Abs)
+}
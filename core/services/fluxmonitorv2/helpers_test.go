@@ -3,6 +3,7 @@ package fluxmonitorv2
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -48,3 +49,7 @@ func (fm *FluxMonitor) rotateSelectLoop() {
 	// the PollRequest is sent to 'rotate' the main select loop, so that new timers will be evaluated
 	fm.pollManager.chPoll <- PollRequest{Type: PollRequestTypeUnknown}
 }
+
+func (fm *FluxMonitor) ExportedHeartbeatDeviationChecker(at time.Time) *DeviationChecker {
+	return fm.heartbeatDeviationChecker(at)
+}
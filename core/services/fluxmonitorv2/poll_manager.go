@@ -19,6 +19,8 @@ type PollManagerConfig struct {
 	DrumbeatSchedule        string
 	DrumbeatEnabled         bool
 	DrumbeatRandomDelay     time.Duration
+	HeartbeatSchedule       string
+	MinSubmissionInterval   time.Duration
 	HibernationPollPeriod   time.Duration
 	MinRetryBackoffDuration time.Duration
 	MaxRetryBackoffDuration time.Duration
@@ -48,6 +50,13 @@ type PollManagerConfig struct {
 // RetryTicker - The retry ticker requests a poll with a backoff duration. This
 // is started when the idle timer fails, and will poll with a maximum backoff
 // of either 1 hour or the idle timer period if it is lower
+//
+// Drumbeat - The drumbeat ticker requests a poll on a cron schedule, which
+// always submits.
+//
+// Heartbeat - The heartbeat ticker requests a poll on a cron schedule, which
+// submits unless the feed was updated since the previous heartbeat. This is an
+// alternative to the idle timer which is aligned to absolute times.
 type PollManager struct {
 	cfg PollManagerConfig
 
@@ -58,6 +67,7 @@ type PollManager struct {
 	roundTimer       utils.ResettableTimer
 	retryTicker      utils.BackoffTicker
 	drumbeat         utils.CronTicker
+	heartbeat        utils.CronTicker
 	chPoll           chan PollRequest
 
 	logger logger.Logger
@@ -98,6 +108,12 @@ func NewPollManager(cfg PollManagerConfig, logger logger.Logger) (*PollManager,
 			return nil, err
 		}
 	}
+	if cfg.HeartbeatSchedule != "" {
+		p.heartbeat, err = utils.NewCronTicker(cfg.HeartbeatSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
 	p.isHibernating.Store(cfg.IsHibernating)
 	return p, nil
 }
@@ -132,6 +148,11 @@ func (pm *PollManager) DrumbeatTicks() <-chan time.Time {
 	return pm.drumbeat.Ticks()
 }
 
+// HeartbeatTicks ticks on a cron schedule when a heartbeat schedule is set
+func (pm *PollManager) HeartbeatTicks() <-chan time.Time {
+	return pm.heartbeat.Ticks()
+}
+
 // Poll returns a channel which the manager will use to send polling requests
 //
 // Note: In the future, we should change the tickers above to send their request
@@ -183,6 +204,7 @@ func (pm *PollManager) Reset(roundState flux_aggregator_wrapper.OracleRoundState
 		pm.startIdleTimer(roundState.StartedAt)
 		pm.startRoundTimer(roundStateTimesOutAt(roundState))
 		pm.startDrumbeat()
+		pm.startHeartbeat()
 	}
 }
 
@@ -212,6 +234,7 @@ func (pm *PollManager) Stop() {
 	pm.idleTimer.Stop()
 	pm.roundTimer.Stop()
 	pm.drumbeat.Stop()
+	pm.heartbeat.Stop()
 }
 
 // Hibernate sets hibernation to true, starts the hibernation timer and stops
@@ -228,6 +251,7 @@ func (pm *PollManager) Hibernate() {
 	pm.idleTimer.Stop()
 	pm.roundTimer.Stop()
 	pm.drumbeat.Stop()
+	pm.heartbeat.Stop()
 	pm.StopRetryTicker()
 }
 
@@ -245,6 +269,7 @@ func (pm *PollManager) Awaken(roundState flux_aggregator_wrapper.OracleRoundStat
 	pm.startIdleTimer(roundState.StartedAt)
 	pm.startRoundTimer(roundStateTimesOutAt(roundState))
 	pm.startDrumbeat()
+	pm.startHeartbeat()
 }
 
 // startPollTicker starts the poll ticker if it is enabled
@@ -342,6 +367,17 @@ func (pm *PollManager) startDrumbeat() {
 	}
 }
 
+// startHeartbeat starts the heartbeat ticker if a heartbeat schedule is set
+func (pm *PollManager) startHeartbeat() {
+	if pm.cfg.HeartbeatSchedule == "" {
+		return
+	}
+
+	if pm.heartbeat.Start() {
+		pm.logger.Debugw("started heartbeat ticker", "schedule", pm.cfg.HeartbeatSchedule)
+	}
+}
+
 func roundStateTimesOutAt(rs flux_aggregator_wrapper.OracleRoundState) uint64 {
 	return rs.StartedAt + rs.Timeout
 }
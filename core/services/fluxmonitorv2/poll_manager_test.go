@@ -184,6 +184,44 @@ func TestPollManager_RetryTimer(t *testing.T) {
 	assert.False(t, ticks.retryTicked)
 }
 
+func TestPollManager_HeartbeatTicker(t *testing.T) {
+	pm, err := fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
+		PollTickerInterval:    pollTickerDefaultDuration,
+		PollTickerDisabled:    true,
+		IdleTimerPeriod:       idleTickerDefaultDuration,
+		IdleTimerDisabled:     true,
+		HeartbeatSchedule:     "@every 1s",
+		HibernationPollPeriod: 24 * time.Hour,
+	}, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	pm.Start(false, flux_aggregator_wrapper.OracleRoundState{})
+	t.Cleanup(pm.Stop)
+
+	select {
+	case <-pm.HeartbeatTicks():
+	case <-time.After(3 * time.Second):
+		t.Fatal("heartbeat ticker did not fire")
+	}
+
+	pm.Hibernate()
+	// Drain a tick sent while hibernating
+	select {
+	case <-pm.HeartbeatTicks():
+	default:
+	}
+	select {
+	case <-pm.HeartbeatTicks():
+		t.Fatal("heartbeat ticker fired while hibernating")
+	case <-time.After(2 * time.Second):
+	}
+
+	_, err = fluxmonitorv2.NewPollManager(fluxmonitorv2.PollManagerConfig{
+		HeartbeatSchedule: "not a schedule",
+	}, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
 func TestPollManager_InitialPoll(t *testing.T) {
 	pm := newPollManager(t)
 	pm.Start(false, flux_aggregator_wrapper.OracleRoundState{})
@@ -71,6 +71,24 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if jb.FluxMonitorSpec.HeartbeatSchedule != "" {
+		err := utils.ValidateCronSchedule(jb.FluxMonitorSpec.HeartbeatSchedule)
+		if err != nil {
+			return jb, errors.Wrap(err, "while validating heartbeat schedule")
+		}
+
+		if !spec.IdleTimerDisabled {
+			return jb, errors.Errorf("When a heartbeat schedule is set, the idle timer must be disabled. Please set IdleTimerDisabled to true")
+		}
+		if spec.DrumbeatEnabled {
+			return jb, errors.Errorf("A heartbeat schedule cannot be combined with the drumbeat ticker")
+		}
+	}
+
+	if jb.FluxMonitorSpec.MinSubmissionInterval < 0 {
+		return jb, errors.Errorf("MinSubmissionInterval (%v) must not be negative", jb.FluxMonitorSpec.MinSubmissionInterval)
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, JobPipeline.HTTPRequest.DefaultTimeout config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
@@ -171,6 +171,152 @@ ds1 -> ds1_parse;
 				assert.EqualError(t, err, "When the drumbeat ticker is enabled, the idle timer must be disabled. Please set IdleTimerDisabled to true")
 			},
 		},
+		{
+			name: "heartbeat schedule",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = true
+
+heartbeatSchedule = "CRON_TZ=UTC 0 0 * * * *"
+minSubmissionInterval = "30s"
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "CRON_TZ=UTC 0 0 * * * *", s.FluxMonitorSpec.HeartbeatSchedule)
+				assert.Equal(t, 30*time.Second, s.FluxMonitorSpec.MinSubmissionInterval)
+			},
+		},
+		{
+			name: "invalid heartbeat schedule",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = true
+
+heartbeatSchedule = "0 0 * * * *"
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.ErrorContains(t, err, "while validating heartbeat schedule")
+			},
+		},
+		{
+			name: "heartbeat and idle both active",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = false
+idleTimerPeriod = "1s"
+
+heartbeatSchedule = "@every 1h"
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "When a heartbeat schedule is set, the idle timer must be disabled. Please set IdleTimerDisabled to true")
+			},
+		},
+		{
+			name: "heartbeat and drumbeat both active",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = true
+
+drumbeatEnabled = true
+drumbeatSchedule = "@every 1m"
+heartbeatSchedule = "@every 1h"
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "A heartbeat schedule cannot be combined with the drumbeat ticker")
+			},
+		},
+		{
+			name: "negative min submission interval",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion       = 1
+name                = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+maxTaskDuration = "1s"
+threshold = 0.5
+absoluteThreshold = 0.0
+
+idleTimerDisabled = true
+
+minSubmissionInterval = "-1s"
+
+pollTimerPeriod = "1m"
+pollTimerDisabled = false
+
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": \\"ETH\\", \\"market\\": \\"USD\\"}" timeout="500ms"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				assert.EqualError(t, err, "MinSubmissionInterval (-1s) must not be negative")
+			},
+		},
 		{
 			name: "integer thresholds",
 			toml: `
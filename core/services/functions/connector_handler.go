@@ -115,8 +115,8 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 		h.lggr.Errorw("allowlist prevented the request from this address", "id", gatewayId, "address", fromAddr)
 		return
 	}
-	if !h.rateLimiter.Allow(body.Sender) {
-		h.lggr.Errorw("request rate-limited", "id", gatewayId, "address", fromAddr)
+	if err := h.rateLimiter.AllowRequest(ctx, "", body.Method, body.Sender); err != nil {
+		h.lggr.Errorw("request rate-limited", "id", gatewayId, "address", fromAddr, "err", err)
 		return
 	}
 	h.lggr.Debugw("handling gateway request", "id", gatewayId, "method", body.Method)
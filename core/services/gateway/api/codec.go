@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // Codec implements (de)serialization of Message objects.
 type Codec interface {
 	DecodeRequest(msgBytes []byte) (*Message, error)
@@ -11,4 +13,7 @@ type Codec interface {
 	EncodeResponse(msg *Message) ([]byte, error)
 
 	EncodeNewErrorResponse(id string, code int, message string, data []byte) ([]byte, error)
+
+	// Error response telling the user when the request can be retried, similar to HTTP's Retry-After header.
+	EncodeNewRetryAfterErrorResponse(id string, code int, message string, retryAfter time.Duration) ([]byte, error)
 }
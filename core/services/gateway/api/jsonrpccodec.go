@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 )
 
 // Wrapping/unwrapping Message objects into JSON RPC ones folllowing https://www.jsonrpc.org/specification
@@ -28,6 +30,12 @@ type JsonRPCError struct {
 	Data    json.RawMessage `json:"data,omitempty"`
 }
 
+// Data attached to errors of requests that can be retried later.
+// RetryAfter is expressed in whole seconds, rounded up, like HTTP's Retry-After header.
+type JsonRPCRetryAfterData struct {
+	RetryAfter int64 `json:"retryAfter"`
+}
+
 type JsonRPCCodec struct {
 }
 
@@ -99,3 +107,11 @@ func (*JsonRPCCodec) EncodeNewErrorResponse(id string, code int, message string,
 	}
 	return json.Marshal(response)
 }
+
+func (c *JsonRPCCodec) EncodeNewRetryAfterErrorResponse(id string, code int, message string, retryAfter time.Duration) ([]byte, error) {
+	data, err := json.Marshal(JsonRPCRetryAfterData{RetryAfter: int64(math.Ceil(retryAfter.Seconds()))})
+	if err != nil {
+		return nil, err
+	}
+	return c.EncodeNewErrorResponse(id, code, message, data)
+}
@@ -1,7 +1,9 @@
 package api_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -89,3 +91,22 @@ func TestJsonRPCResponse_Encode(t *testing.T) {
 	require.Equal(t, "0x1234", decoded.Body.Receiver)
 	require.Equal(t, "upload", decoded.Body.Method)
 }
+
+func TestJsonRPCCodec_EncodeNewRetryAfterErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	codec := api.JsonRPCCodec{}
+	bytes, err := codec.EncodeNewRetryAfterErrorResponse("aA-bB", -32005, "rate-limited", 1500*time.Millisecond)
+	require.NoError(t, err)
+
+	var response api.JsonRPCResponse
+	require.NoError(t, json.Unmarshal(bytes, &response))
+	require.Equal(t, "aA-bB", response.Id)
+	require.NotNil(t, response.Error)
+	require.Equal(t, -32005, response.Error.Code)
+	require.Equal(t, "rate-limited", response.Error.Message)
+
+	var data api.JsonRPCRetryAfterData
+	require.NoError(t, json.Unmarshal(response.Error.Data, &data))
+	require.Equal(t, int64(2), data.RetryAfter)
+}
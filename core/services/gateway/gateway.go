@@ -3,8 +3,10 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
@@ -147,6 +150,10 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
+	var rateLimitErr *hc.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return newRetryAfterError(g.codec, msg.Body.MessageId, api.RateLimitedError, err.Error(), rateLimitErr.RetryAfter)
+	}
 	if err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
 	}
@@ -181,6 +188,16 @@ func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string)
 	return rawResponse, api.ToHttpErrorCode(errCode)
 }
 
+func newRetryAfterError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string, retryAfter time.Duration) ([]byte, int) {
+	rawResponse, err := codec.EncodeNewRetryAfterErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, retryAfter)
+	if err != nil {
+		promRequest.WithLabelValues(api.FatalError.String()).Inc()
+		return []byte("fatal error"), api.ToHttpErrorCode(api.FatalError)
+	}
+	promRequest.WithLabelValues(errCode.String()).Inc()
+	return rawResponse, api.ToHttpErrorCode(errCode)
+}
+
 func (g *gateway) GetUserPort() int {
 	return g.httpServer.GetPort()
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
 )
@@ -245,3 +246,15 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32600, "failure")
 	require.Equal(t, 400, statusCode)
 }
+
+func TestGateway_ProcessRequest_HandlerRateLimited(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockHandler(t)
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(&hc.RateLimitError{RetryAfter: 2500 * time.Millisecond})
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), req)
+	require.Equal(t, `{"jsonrpc":"2.0","id":"abcd","error":{"code":-32005,"message":"rate-limited","data":{"retryAfter":3}}}`, string(response))
+	require.Equal(t, 429, statusCode)
+}
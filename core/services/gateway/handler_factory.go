@@ -11,11 +11,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/generic"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 const (
 	FunctionsHandlerType HandlerType = "functions"
+	GenericHandlerType   HandlerType = "generic"
 	DummyHandlerType     HandlerType = "dummy"
 )
 
@@ -36,6 +38,8 @@ func (hf *handlerFactory) NewHandler(handlerType HandlerType, handlerConfig json
 	switch handlerType {
 	case FunctionsHandlerType:
 		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.db, hf.cfg, hf.lggr)
+	case GenericHandlerType:
+		return generic.NewGenericHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.db, hf.cfg, hf.lggr)
 	case DummyHandlerType:
 		return handlers.NewDummyHandler(donConfig, don, hf.lggr)
 	default:
@@ -1,18 +1,40 @@
 package common
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/jmoiron/sqlx"
 	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
-// Wrapper around Go's rate.Limiter that supports both global and a per-sender rate limiting.
+// RateLimitError is returned when a request is rejected by a RateLimiter.
+// RetryAfter is the earliest time after which the same request could succeed.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return "rate-limited"
+}
+
+// Token bucket-based rate limiter supporting global, per-DON, per-method and per-sender limits.
+// Bucket state is kept in a TokenBucketStore, which can be shared between multiple gateway instances.
 type RateLimiter struct {
-	global    *rate.Limiter
-	perSender map[string]*rate.Limiter
 	config    RateLimiterConfig
-	mu        sync.Mutex
+	store     TokenBucketStore
+	namespace string
+}
+
+type TokenBucketConfig struct {
+	RPS   float64 `json:"rps"`
+	Burst int     `json:"burst"`
 }
 
 type RateLimiterConfig struct {
@@ -20,30 +42,184 @@ type RateLimiterConfig struct {
 	GlobalBurst    int     `json:"globalBurst"`
 	PerSenderRPS   float64 `json:"perSenderRPS"`
 	PerSenderBurst int     `json:"perSenderBurst"`
+	// Optional limit shared by all senders of a single DON.
+	PerDON *TokenBucketConfig `json:"perDON,omitempty"`
+	// Optional limits shared by all senders calling a given method of a single DON (e.g. "secrets_set").
+	PerMethod map[string]TokenBucketConfig `json:"perMethod,omitempty"`
 }
 
+func (c TokenBucketConfig) validate() error {
+	if c.RPS <= 0.0 {
+		return errors.New("RPS values must be positive")
+	}
+	if c.Burst <= 0 {
+		return errors.New("burst values must be positive")
+	}
+	return nil
+}
+
+func (c RateLimiterConfig) Validate() error {
+	if err := (TokenBucketConfig{RPS: c.GlobalRPS, Burst: c.GlobalBurst}).validate(); err != nil {
+		return err
+	}
+	if err := (TokenBucketConfig{RPS: c.PerSenderRPS, Burst: c.PerSenderBurst}).validate(); err != nil {
+		return err
+	}
+	if c.PerDON != nil {
+		if err := c.PerDON.validate(); err != nil {
+			return fmt.Errorf("perDON: %w", err)
+		}
+	}
+	for method, methodConfig := range c.PerMethod {
+		if err := methodConfig.validate(); err != nil {
+			return fmt.Errorf("perMethod %s: %w", method, err)
+		}
+	}
+	return nil
+}
+
+// NewRateLimiter creates a RateLimiter with state held in memory of the current process.
 func NewRateLimiter(config RateLimiterConfig) (*RateLimiter, error) {
-	if config.GlobalRPS <= 0.0 || config.PerSenderRPS <= 0.0 {
-		return nil, errors.New("RPS values must be positive")
+	return NewRateLimiterWithStore(config, NewInMemoryTokenBucketStore(), "")
+}
+
+// NewRateLimiterWithStore creates a RateLimiter backed by the given store.
+// Namespace is used to separate buckets of different limiters sharing the same store, such as the
+// limiters of different handlers. It must be unique to each of them, or they share their global limit.
+func NewRateLimiterWithStore(config RateLimiterConfig, store TokenBucketStore, namespace string) (*RateLimiter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
-	if config.GlobalBurst <= 0 || config.PerSenderBurst <= 0 {
-		return nil, errors.New("burst values must be positive")
+	if store == nil {
+		return nil, errors.New("nil token bucket store")
 	}
 	return &RateLimiter{
-		global:    rate.NewLimiter(rate.Limit(config.GlobalRPS), config.GlobalBurst),
-		perSender: make(map[string]*rate.Limiter),
 		config:    config,
+		store:     store,
+		namespace: namespace,
 	}, nil
 }
 
+// NewRateLimiterFromConfig creates an in-memory RateLimiter, or a database-backed one if shared is set.
+func NewRateLimiterFromConfig(config RateLimiterConfig, shared bool, namespace string, db *sqlx.DB, qcfg pg.QConfig, lggr logger.Logger) (*RateLimiter, error) {
+	if !shared {
+		return NewRateLimiter(config)
+	}
+	store, err := NewDBTokenBucketStore(db, lggr, qcfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewRateLimiterWithStore(config, store, namespace)
+}
+
+// Allow checks global and per-sender limits only.
 func (rl *RateLimiter) Allow(sender string) bool {
-	rl.mu.Lock()
-	senderLimiter, ok := rl.perSender[sender]
+	return rl.AllowRequest(context.Background(), "", "", sender) == nil
+}
+
+// AllowRequest takes a token from every bucket applicable to the request: per-sender, per-method,
+// per-DON and global. Empty donId or method skip the corresponding checks. Tokens are taken only if
+// all of the buckets hold one, so a rejected request does not use up any of the limits.
+// Returns a *RateLimitError if any of the limits is exceeded.
+func (rl *RateLimiter) AllowRequest(ctx context.Context, donId string, method string, sender string) error {
+	buckets := []TokenBucket{rl.bucket("sender/"+donId+"/"+sender, TokenBucketConfig{RPS: rl.config.PerSenderRPS, Burst: rl.config.PerSenderBurst})}
+	if methodConfig, ok := rl.config.PerMethod[method]; ok && method != "" {
+		buckets = append(buckets, rl.bucket("method/"+donId+"/"+method, methodConfig))
+	}
+	if rl.config.PerDON != nil && donId != "" {
+		buckets = append(buckets, rl.bucket("don/"+donId, *rl.config.PerDON))
+	}
+	buckets = append(buckets, rl.bucket("global", TokenBucketConfig{RPS: rl.config.GlobalRPS, Burst: rl.config.GlobalBurst}))
+
+	ok, retryAfter, err := rl.store.Take(ctx, buckets)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+func (rl *RateLimiter) bucket(key string, limit TokenBucketConfig) TokenBucket {
+	return TokenBucket{Key: rl.namespace + "/" + key, Limit: limit}
+}
+
+// tokenBucketPruneInterval is the minimum period between sweeps of full buckets.
+// A full bucket holds the same state as a missing one, so it can be dropped.
+const tokenBucketPruneInterval = time.Minute
+
+// TokenBucket identifies a bucket held by a TokenBucketStore and its limit.
+type TokenBucket struct {
+	Key   string
+	Limit TokenBucketConfig
+}
+
+// TokenBucketStore holds the state of token buckets identified by string keys.
+// All methods are thread-safe.
+type TokenBucketStore interface {
+	// Take removes a single token from each of the buckets, refilling them first according to their limits.
+	// Tokens are taken only if every bucket holds one. Otherwise, it returns false and the time until they all do.
+	Take(ctx context.Context, buckets []TokenBucket) (ok bool, retryAfter time.Duration, err error)
+}
+
+type inMemoryTokenBucketStore struct {
+	buckets   map[string]*rate.Limiter
+	lastPrune time.Time
+	mu        sync.Mutex
+}
+
+var _ TokenBucketStore = (*inMemoryTokenBucketStore)(nil)
+
+func NewInMemoryTokenBucketStore() TokenBucketStore {
+	return &inMemoryTokenBucketStore{
+		buckets: make(map[string]*rate.Limiter),
+	}
+}
+
+func (s *inMemoryTokenBucketStore) Take(_ context.Context, buckets []TokenBucket) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.prune(now)
+	ok := true
+	var retryAfter time.Duration
+	reservations := make([]*rate.Reservation, 0, len(buckets))
+	for _, b := range buckets {
+		bucket, exists := s.buckets[b.Key]
+		if !exists {
+			bucket = rate.NewLimiter(rate.Limit(b.Limit.RPS), b.Limit.Burst)
+			s.buckets[b.Key] = bucket
+		}
+		reservation := bucket.ReserveN(now, 1)
+		if !reservation.OK() {
+			ok = false
+			continue
+		}
+		reservations = append(reservations, reservation)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			ok = false
+			retryAfter = max(retryAfter, delay)
+		}
+	}
 	if !ok {
-		senderLimiter = rate.NewLimiter(rate.Limit(rl.config.PerSenderRPS), rl.config.PerSenderBurst)
-		rl.perSender[sender] = senderLimiter
+		// give the tokens back, latest first, so that every bucket is restored
+		for i := len(reservations) - 1; i >= 0; i-- {
+			reservations[i].CancelAt(now)
+		}
 	}
-	rl.mu.Unlock()
+	return ok, retryAfter, nil
+}
 
-	return senderLimiter.Allow() && rl.global.Allow()
+func (s *inMemoryTokenBucketStore) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < tokenBucketPruneInterval {
+		return
+	}
+	s.lastPrune = now
+	for key, bucket := range s.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(s.buckets, key)
+		}
+	}
 }
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// dbTokenBucketStore keeps token buckets in Postgres so that multiple gateway replicas
+// connected to the same database enforce a single budget.
+// Buckets are refilled lazily on each Take(), based on the time elapsed since the last update,
+// and deleted once they are full again.
+type dbTokenBucketStore struct {
+	q    pg.Q
+	lggr logger.Logger
+
+	mu        sync.Mutex
+	lastPrune time.Time
+}
+
+var _ TokenBucketStore = (*dbTokenBucketStore)(nil)
+
+var ErrInvalidTokenBucketStoreParameters = errors.New("invalid parameters provided to create a token bucket store")
+
+// errTokenBucketExhausted rolls back the tokens taken from other buckets.
+var errTokenBucketExhausted = errors.New("token bucket exhausted")
+
+func NewDBTokenBucketStore(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) (TokenBucketStore, error) {
+	if db == nil || cfg == nil || lggr == nil {
+		return nil, ErrInvalidTokenBucketStoreParameters
+	}
+	return &dbTokenBucketStore{
+		q:    pg.NewQ(db, lggr, cfg),
+		lggr: lggr,
+	}, nil
+}
+
+func (s *dbTokenBucketStore) Take(ctx context.Context, buckets []TokenBucket) (bool, time.Duration, error) {
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	s.prune(q)
+
+	// A new bucket starts full. An existing one is only updated if it holds at least one token after refill.
+	// full_at is the time at which the bucket is refilled to its burst.
+	const takeStmt = `
+		INSERT INTO gateway_rate_limiter_buckets AS b (key, tokens, updated_at, full_at)
+		VALUES ($1, $2::float8 - 1, NOW(), NOW() + make_interval(secs => 1 / $3::float8))
+		ON CONFLICT (key) DO UPDATE SET
+			tokens = LEAST($2, b.tokens + EXTRACT(EPOCH FROM (NOW() - b.updated_at)) * $3) - 1,
+			updated_at = NOW(),
+			full_at = NOW() + make_interval(secs => ($2 - LEAST($2, b.tokens + EXTRACT(EPOCH FROM (NOW() - b.updated_at)) * $3) + 1) / $3)
+		WHERE LEAST($2, b.tokens + EXTRACT(EPOCH FROM (NOW() - b.updated_at)) * $3) >= 1
+		RETURNING tokens;`
+	const availableStmt = `
+		SELECT LEAST($2, tokens + EXTRACT(EPOCH FROM (NOW() - updated_at)) * $3)
+		FROM gateway_rate_limiter_buckets
+		WHERE key = $1;`
+	var retryAfter time.Duration
+	err := q.Transaction(func(tx pg.Queryer) error {
+		exhausted := false
+		for _, b := range buckets {
+			var remaining float64
+			err := tx.Get(&remaining, takeStmt, b.Key, float64(b.Limit.Burst), b.Limit.RPS)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			var available float64
+			if err = tx.Get(&available, availableStmt, b.Key, float64(b.Limit.Burst), b.Limit.RPS); err != nil {
+				return err
+			}
+			exhausted = true
+			retryAfter = max(retryAfter, time.Duration(math.Ceil((1-available)/b.Limit.RPS*float64(time.Second))))
+			s.lggr.Debugw("token bucket exhausted", "key", b.Key, "available", available)
+		}
+		if exhausted {
+			return errTokenBucketExhausted
+		}
+		return nil
+	})
+	if errors.Is(err, errTokenBucketExhausted) {
+		return false, retryAfter, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	return true, 0, nil
+}
+
+// prune deletes the buckets which are full, at most once per tokenBucketPruneInterval.
+func (s *dbTokenBucketStore) prune(q pg.Q) {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Sub(s.lastPrune) < tokenBucketPruneInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastPrune = now
+	s.mu.Unlock()
+
+	if err := q.ExecQ(`DELETE FROM gateway_rate_limiter_buckets WHERE full_at <= NOW();`); err != nil {
+		s.lggr.Warnw("failed to prune token buckets", "err", err)
+	}
+}
@@ -0,0 +1,64 @@
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+func TestDBTokenBucketStore_Take(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	store, err := common.NewDBTokenBucketStore(db, lggr, pgtest.NewQConfig(true))
+	require.NoError(t, err)
+
+	limit := common.TokenBucketConfig{RPS: 0.1, Burst: 2}
+	key1 := []common.TokenBucket{{Key: "key1", Limit: limit}}
+	for i := 0; i < 2; i++ {
+		ok, _, err2 := store.Take(ctx, key1)
+		require.NoError(t, err2)
+		require.True(t, ok)
+	}
+	ok, retryAfter, err := store.Take(ctx, key1)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Greater(t, retryAfter, time.Duration(0))
+	require.LessOrEqual(t, retryAfter, 10*time.Second)
+
+	// separate buckets don't share tokens
+	key2 := []common.TokenBucket{{Key: "key2", Limit: limit}}
+	ok, _, err = store.Take(ctx, key2)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// no token is taken from key2 when key1 is exhausted
+	ok, _, err = store.Take(ctx, append(key2, key1...))
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, _, err = store.Take(ctx, key2)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// a second store instance sees the same state
+	store2, err := common.NewDBTokenBucketStore(db, lggr, pgtest.NewQConfig(true))
+	require.NoError(t, err)
+	ok, _, err = store2.Take(ctx, key1)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestDBTokenBucketStore_InvalidParameters(t *testing.T) {
+	t.Parallel()
+
+	_, err := common.NewDBTokenBucketStore(nil, logger.TestLogger(t), pgtest.NewQConfig(true))
+	require.ErrorIs(t, err, common.ErrInvalidTokenBucketStoreParameters)
+}
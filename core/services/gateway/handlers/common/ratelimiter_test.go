@@ -2,9 +2,11 @@ package common_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
 )
 
@@ -25,3 +27,71 @@ func TestRateLimiter_Simple(t *testing.T) {
 	require.False(t, rl.Allow("user1"))
 	require.False(t, rl.Allow("user3"))
 }
+
+func TestRateLimiter_PerMethodAndPerDON(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      100.0,
+		GlobalBurst:    100,
+		PerSenderRPS:   100.0,
+		PerSenderBurst: 100,
+		PerDON:         &common.TokenBucketConfig{RPS: 1.0, Burst: 3},
+		PerMethod: map[string]common.TokenBucketConfig{
+			"secrets_set": {RPS: 1.0, Burst: 1},
+		},
+	}
+	rl, err := common.NewRateLimiter(config)
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+	require.NoError(t, rl.AllowRequest(ctx, "don1", "secrets_set", "user1"))
+	var rateLimitErr *common.RateLimitError
+	require.ErrorAs(t, rl.AllowRequest(ctx, "don1", "secrets_set", "user2"), &rateLimitErr)
+	require.Greater(t, rateLimitErr.RetryAfter, time.Duration(0))
+	require.LessOrEqual(t, rateLimitErr.RetryAfter, time.Second)
+	require.NoError(t, rl.AllowRequest(ctx, "don1", "secrets_list", "user2"))
+	require.NoError(t, rl.AllowRequest(ctx, "don2", "secrets_set", "user1"))
+	require.NoError(t, rl.AllowRequest(ctx, "don1", "secrets_list", "user3"))
+	require.ErrorAs(t, rl.AllowRequest(ctx, "don1", "secrets_list", "user4"), &rateLimitErr)
+}
+
+func TestRateLimiter_RejectedRequestsTakeNoTokens(t *testing.T) {
+	t.Parallel()
+
+	config := common.RateLimiterConfig{
+		GlobalRPS:      100.0,
+		GlobalBurst:    100,
+		PerSenderRPS:   0.001,
+		PerSenderBurst: 2,
+		PerMethod: map[string]common.TokenBucketConfig{
+			"secrets_set": {RPS: 0.001, Burst: 1},
+		},
+	}
+	rl, err := common.NewRateLimiter(config)
+	require.NoError(t, err)
+	ctx := testutils.Context(t)
+	require.NoError(t, rl.AllowRequest(ctx, "don1", "secrets_set", "user1"))
+	var rateLimitErr *common.RateLimitError
+	require.ErrorAs(t, rl.AllowRequest(ctx, "don1", "secrets_set", "user1"), &rateLimitErr)
+	// the rejected request left the second token of user1
+	require.NoError(t, rl.AllowRequest(ctx, "don1", "secrets_list", "user1"))
+	require.ErrorAs(t, rl.AllowRequest(ctx, "don1", "secrets_list", "user1"), &rateLimitErr)
+}
+
+func TestRateLimiter_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	valid := common.RateLimiterConfig{GlobalRPS: 1.0, GlobalBurst: 1, PerSenderRPS: 1.0, PerSenderBurst: 1}
+	_, err := common.NewRateLimiter(valid)
+	require.NoError(t, err)
+
+	invalidDON := valid
+	invalidDON.PerDON = &common.TokenBucketConfig{RPS: 0.0, Burst: 1}
+	_, err = common.NewRateLimiter(invalidDON)
+	require.Error(t, err)
+
+	invalidMethod := valid
+	invalidMethod.PerMethod = map[string]common.TokenBucketConfig{"heartbeat": {RPS: 1.0, Burst: 0}}
+	_, err = common.NewRateLimiter(invalidMethod)
+	require.Error(t, err)
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"math/big"
 	"strings"
 	"time"
 
@@ -72,6 +70,8 @@ type FunctionsHandlerConfig struct {
 	MaxPendingRequests         uint32                `json:"maxPendingRequests"`
 	RequestTimeoutMillis       int64                 `json:"requestTimeoutMillis"`
 	AllowedHeartbeatInitiators []string              `json:"allowedHeartbeatInitiators"`
+	// Keep rate limiter state in the database so that all gateway replicas enforce a single budget
+	SharedRateLimiterState bool `json:"sharedRateLimiterState"`
 }
 
 type functionsHandler struct {
@@ -107,51 +107,26 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 		return nil, err
 	}
 	lggr = lggr.Named("FunctionsHandler:" + donConfig.DonId)
-	var allowlist fallow.OnchainAllowlist
-	if cfg.OnchainAllowlist != nil {
-		chain, err2 := legacyChains.Get(cfg.ChainID)
-		if err2 != nil {
-			return nil, err2
-		}
-
-		orm, err2 := fallow.NewORM(db, lggr, qcfg, cfg.OnchainAllowlist.ContractAddress)
-		if err2 != nil {
-			return nil, err2
-		}
-		allowlist, err2 = fallow.NewOnchainAllowlist(chain.Client(), *cfg.OnchainAllowlist, orm, lggr)
-		if err2 != nil {
-			return nil, err2
-		}
+	allowlist, err := NewOnchainAllowlistFromConfig(cfg.ChainID, cfg.OnchainAllowlist, legacyChains, db, qcfg, lggr)
+	if err != nil {
+		return nil, err
 	}
 	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
 	if cfg.UserRateLimiter != nil {
-		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		userRateLimiter, err = hc.NewRateLimiterFromConfig(*cfg.UserRateLimiter, cfg.SharedRateLimiterState, "functions/"+donConfig.DonId+"/user", db, qcfg, lggr)
 		if err != nil {
 			return nil, err
 		}
 	}
 	if cfg.NodeRateLimiter != nil {
-		nodeRateLimiter, err = hc.NewRateLimiter(*cfg.NodeRateLimiter)
+		nodeRateLimiter, err = hc.NewRateLimiterFromConfig(*cfg.NodeRateLimiter, cfg.SharedRateLimiterState, "functions/"+donConfig.DonId+"/node", db, qcfg, lggr)
 		if err != nil {
 			return nil, err
 		}
 	}
-	var subscriptions fsub.OnchainSubscriptions
-	if cfg.OnchainSubscriptions != nil {
-		chain, err2 := legacyChains.Get(cfg.ChainID)
-		if err2 != nil {
-			return nil, err2
-		}
-
-		orm, err2 := fsub.NewORM(db, lggr, qcfg, cfg.OnchainSubscriptions.ContractAddress)
-		if err2 != nil {
-			return nil, err2
-		}
-
-		subscriptions, err2 = fsub.NewOnchainSubscriptions(chain.Client(), *cfg.OnchainSubscriptions, orm, lggr)
-		if err2 != nil {
-			return nil, err2
-		}
+	subscriptions, err := NewOnchainSubscriptionsFromConfig(cfg.ChainID, cfg.OnchainSubscriptions, legacyChains, db, qcfg, lggr)
+	if err != nil {
+		return nil, err
 	}
 	allowedHeartbeatInitiators := make(map[string]struct{})
 	for _, initiator := range cfg.AllowedHeartbeatInitiators {
@@ -196,22 +171,17 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
 		return ErrNotAllowlisted
 	}
-	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
-		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
-		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
-		return ErrRateLimited
+	if h.userRateLimiter != nil {
+		if err := h.userRateLimiter.AllowRequest(ctx, h.donConfig.DonId, msg.Body.Method, msg.Body.Sender); err != nil {
+			h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender, "method", msg.Body.Method, "err", err)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+			return err
+		}
 	}
 	if msg.Body.Method == MethodSecretsSet && h.subscriptions != nil && h.minimumBalance != nil {
-		balance, err := h.subscriptions.GetMaxUserBalance(sender)
-		if err != nil {
-			h.lggr.Debugw("error getting max user balance", "sender", msg.Body.Sender, "err", err)
-		}
-		if balance == nil {
-			balance = big.NewInt(0)
-		}
-		if err != nil || balance.Cmp(h.minimumBalance.ToInt()) < 0 {
-			h.lggr.Debugw("received a message from a user having insufficient balance", "sender", msg.Body.Sender, "balance", balance.String())
-			return fmt.Errorf("sender has insufficient balance: %v juels", balance.String())
+		if err := CheckMinimumBalance(h.subscriptions, h.minimumBalance, sender); err != nil {
+			h.lggr.Debugw("received a message from a user having insufficient balance", "sender", msg.Body.Sender, "err", err)
+			return err
 		}
 	}
 	switch msg.Body.Method {
@@ -251,9 +221,11 @@ func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message,
 
 func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
 	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
-	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
-		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
-		return errors.New("rate-limited")
+	if h.nodeRateLimiter != nil {
+		if err := h.nodeRateLimiter.AllowRequest(ctx, h.donConfig.DonId, msg.Body.Method, nodeAddr); err != nil {
+			h.lggr.Debugw("rate-limited", "sender", nodeAddr, "err", err)
+			return err
+		}
 	}
 	switch msg.Body.Method {
 	case MethodSecretsSet, MethodSecretsList:
@@ -0,0 +1,63 @@
+package functions
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	fallow "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/allowlist"
+	fsub "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/subscriptions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// Helpers below are shared with other handlers that want to restrict access to users of the Functions
+// on-chain allowlist and subscriptions.
+
+// NewOnchainAllowlistFromConfig returns nil if cfg is nil, which disables allowlist checks.
+func NewOnchainAllowlistFromConfig(chainID string, cfg *fallow.OnchainAllowlistConfig, legacyChains legacyevm.LegacyChainContainer, db *sqlx.DB, qcfg pg.QConfig, lggr logger.Logger) (fallow.OnchainAllowlist, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	chain, err := legacyChains.Get(chainID)
+	if err != nil {
+		return nil, err
+	}
+	orm, err := fallow.NewORM(db, lggr, qcfg, cfg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return fallow.NewOnchainAllowlist(chain.Client(), *cfg, orm, lggr)
+}
+
+// NewOnchainSubscriptionsFromConfig returns nil if cfg is nil, which disables minimum balance checks.
+func NewOnchainSubscriptionsFromConfig(chainID string, cfg *fsub.OnchainSubscriptionsConfig, legacyChains legacyevm.LegacyChainContainer, db *sqlx.DB, qcfg pg.QConfig, lggr logger.Logger) (fsub.OnchainSubscriptions, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	chain, err := legacyChains.Get(chainID)
+	if err != nil {
+		return nil, err
+	}
+	orm, err := fsub.NewORM(db, lggr, qcfg, cfg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return fsub.NewOnchainSubscriptions(chain.Client(), *cfg, orm, lggr)
+}
+
+// CheckMinimumBalance returns an error unless one of the sender's subscriptions holds at least minimumBalance.
+func CheckMinimumBalance(subscriptions fsub.OnchainSubscriptions, minimumBalance *assets.Link, sender common.Address) error {
+	balance, err := subscriptions.GetMaxUserBalance(sender)
+	if balance == nil {
+		balance = big.NewInt(0)
+	}
+	if err != nil || balance.Cmp(minimumBalance.ToInt()) < 0 {
+		return fmt.Errorf("sender has insufficient balance: %v juels", balance.String())
+	}
+	return nil
+}
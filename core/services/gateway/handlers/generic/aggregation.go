@@ -0,0 +1,146 @@
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+const (
+	AggregationFirstResponse   = "first_response"
+	AggregationQuorumIdentical = "quorum_identical"
+	AggregationMedian          = "median"
+)
+
+var (
+	ErrNoQuorum          = errors.New("node responses can't reach quorum")
+	ErrNotEnoughNumerics = errors.New("not enough numeric node responses to compute a median")
+)
+
+// Aggregator combines responses from nodes of a DON into a single response sent back to the user.
+// It is called every time a new node response arrives, with all responses received so far
+// (at most one per node, in order of arrival). It returns:
+//   - a non-nil message, once the user response is ready
+//   - an error, if a valid response can no longer be produced
+//   - nil, nil if more node responses are needed
+//
+// The returned message must be one of the node responses, so that the user can verify its signature.
+type Aggregator interface {
+	Aggregate(responses []*api.Message, donConfig *config.DONConfig) (*api.Message, error)
+}
+
+func NewAggregator(cfg MethodConfig) (Aggregator, error) {
+	switch cfg.Aggregation {
+	case AggregationFirstResponse, "":
+		return &firstResponseAggregator{}, nil
+	case AggregationQuorumIdentical:
+		return &quorumIdenticalAggregator{}, nil
+	case AggregationMedian:
+		return &medianAggregator{field: cfg.MedianField}, nil
+	default:
+		return nil, fmt.Errorf("unsupported aggregation method %s", cfg.Aggregation)
+	}
+}
+
+// firstResponseAggregator returns the first response received from any node.
+type firstResponseAggregator struct{}
+
+func (a *firstResponseAggregator) Aggregate(responses []*api.Message, _ *config.DONConfig) (*api.Message, error) {
+	if len(responses) == 0 {
+		return nil, nil
+	}
+	return responses[0], nil
+}
+
+// quorumIdenticalAggregator waits for F+1 nodes to return identical payloads (ignoring JSON whitespace).
+type quorumIdenticalAggregator struct{}
+
+func (a *quorumIdenticalAggregator) Aggregate(responses []*api.Message, donConfig *config.DONConfig) (*api.Message, error) {
+	groups := make(map[string][]*api.Message)
+	maxGroupSize := 0
+	for _, response := range responses {
+		key := canonicalPayload(response.Body.Payload)
+		groups[key] = append(groups[key], response)
+		if len(groups[key]) >= donConfig.F+1 {
+			return groups[key][0], nil
+		}
+		if len(groups[key]) > maxGroupSize {
+			maxGroupSize = len(groups[key])
+		}
+	}
+	if maxGroupSize+len(donConfig.Members)-len(responses) < donConfig.F+1 {
+		return nil, ErrNoQuorum
+	}
+	return nil, nil
+}
+
+func canonicalPayload(payload json.RawMessage) string {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, payload); err != nil {
+		return string(payload)
+	}
+	return compacted.String()
+}
+
+// medianAggregator waits for 2F+1 numeric responses and returns the one holding the median value.
+// The value is read from a top-level field of a JSON object payload or, if field is empty,
+// from the payload itself. Both JSON numbers and numeric strings are accepted.
+type medianAggregator struct {
+	field string
+}
+
+type numericResponse struct {
+	value    decimal.Decimal
+	response *api.Message
+}
+
+func (a *medianAggregator) Aggregate(responses []*api.Message, donConfig *config.DONConfig) (*api.Message, error) {
+	var numerics []numericResponse
+	for _, response := range responses {
+		value, err := a.parse(response.Body.Payload)
+		if err != nil {
+			continue
+		}
+		numerics = append(numerics, numericResponse{value: value, response: response})
+	}
+	required := 2*donConfig.F + 1
+	if len(numerics) >= required {
+		sort.SliceStable(numerics, func(i, j int) bool {
+			return numerics[i].value.LessThan(numerics[j].value)
+		})
+		return numerics[len(numerics)/2].response, nil
+	}
+	if len(numerics)+len(donConfig.Members)-len(responses) < required {
+		return nil, ErrNotEnoughNumerics
+	}
+	return nil, nil
+}
+
+func (a *medianAggregator) parse(payload json.RawMessage) (decimal.Decimal, error) {
+	raw := payload
+	if a.field != "" {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			return decimal.Decimal{}, err
+		}
+		var ok bool
+		if raw, ok = fields[a.field]; !ok {
+			return decimal.Decimal{}, fmt.Errorf("missing field %s", a.field)
+		}
+	}
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return decimal.Decimal{}, errors.New("null value")
+	}
+	var value decimal.Decimal
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return decimal.Decimal{}, err
+	}
+	return value, nil
+}
@@ -0,0 +1,125 @@
+package generic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/generic"
+)
+
+func newDONConfig(n int, f int) *config.DONConfig {
+	donConfig := &config.DONConfig{F: f}
+	for i := 0; i < n; i++ {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{Name: fmt.Sprintf("node_%d", i)})
+	}
+	return donConfig
+}
+
+func newResponses(payloads ...string) []*api.Message {
+	var responses []*api.Message
+	for i, payload := range payloads {
+		responses = append(responses, &api.Message{Body: api.MessageBody{MessageId: fmt.Sprint(i), Payload: []byte(payload)}})
+	}
+	return responses
+}
+
+func TestAggregator_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := generic.NewAggregator(generic.MethodConfig{Aggregation: "mean"})
+	require.Error(t, err)
+}
+
+func TestAggregator_FirstResponse(t *testing.T) {
+	t.Parallel()
+
+	aggregator, err := generic.NewAggregator(generic.MethodConfig{})
+	require.NoError(t, err)
+	donConfig := newDONConfig(4, 1)
+
+	result, err := aggregator.Aggregate(nil, donConfig)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	result, err = aggregator.Aggregate(newResponses(`{"a":1}`), donConfig)
+	require.NoError(t, err)
+	require.Equal(t, "0", result.Body.MessageId)
+}
+
+func TestAggregator_QuorumIdentical(t *testing.T) {
+	t.Parallel()
+
+	aggregator, err := generic.NewAggregator(generic.MethodConfig{Aggregation: generic.AggregationQuorumIdentical})
+	require.NoError(t, err)
+	donConfig := newDONConfig(4, 1)
+
+	tests := []struct {
+		name        string
+		payloads    []string
+		expectedId  string
+		expectedErr error
+	}{
+		{"not enough responses", []string{`{"a":1}`}, "", nil},
+		{"two identical", []string{`{"a":1}`, `{"a": 1}`}, "0", nil},
+		{"identical after a different one", []string{`{"a":2}`, `{"a":1}`, `{"a":1}`}, "1", nil},
+		{"still possible", []string{`{"a":1}`, `{"a":2}`, `{"a":3}`}, "", nil},
+		{"impossible", []string{`{"a":1}`, `{"a":2}`, `{"a":3}`, `{"a":4}`}, "", generic.ErrNoQuorum},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result, err := aggregator.Aggregate(newResponses(test.payloads...), donConfig)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if test.expectedId == "" {
+				require.Nil(t, result)
+			} else {
+				require.Equal(t, test.expectedId, result.Body.MessageId)
+			}
+		})
+	}
+}
+
+func TestAggregator_Median(t *testing.T) {
+	t.Parallel()
+
+	donConfig := newDONConfig(4, 1)
+
+	t.Run("whole payload", func(t *testing.T) {
+		aggregator, err := generic.NewAggregator(generic.MethodConfig{Aggregation: generic.AggregationMedian})
+		require.NoError(t, err)
+
+		result, err := aggregator.Aggregate(newResponses(`5`, `"1.5"`), donConfig)
+		require.NoError(t, err)
+		require.Nil(t, result)
+
+		result, err = aggregator.Aggregate(newResponses(`5`, `"1.5"`, `3`), donConfig)
+		require.NoError(t, err)
+		require.Equal(t, "2", result.Body.MessageId)
+	})
+
+	t.Run("field", func(t *testing.T) {
+		aggregator, err := generic.NewAggregator(generic.MethodConfig{Aggregation: generic.AggregationMedian, MedianField: "price"})
+		require.NoError(t, err)
+
+		result, err := aggregator.Aggregate(newResponses(`{"price":10}`, `{"other":1}`, `{"price":30}`, `{"price":20}`), donConfig)
+		require.NoError(t, err)
+		require.Equal(t, "3", result.Body.MessageId)
+	})
+
+	t.Run("too many non-numeric", func(t *testing.T) {
+		aggregator, err := generic.NewAggregator(generic.MethodConfig{Aggregation: generic.AggregationMedian})
+		require.NoError(t, err)
+
+		_, err = aggregator.Aggregate(newResponses(`1`, `"abc"`, `null`), donConfig)
+		require.ErrorIs(t, err, generic.ErrNotEnoughNumerics)
+	})
+}
@@ -0,0 +1,284 @@
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
+	fallow "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/allowlist"
+	fsub "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/subscriptions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+var (
+	ErrNotAllowlisted    = errors.New("sender not allowlisted")
+	ErrRateLimited       = errors.New("rate-limited")
+	ErrUnsupportedMethod = errors.New("unsupported method")
+
+	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_generic_handler_error",
+		Help: "Metric to track generic handler errors",
+	}, []string{"don_id", "error"})
+
+	promRequestResult = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_generic_handler_request_result",
+		Help: "Metric to track results of aggregated requests",
+	}, []string{"don_id", "method", "success"})
+)
+
+// GenericHandlerConfig describes which methods are exposed to users and how node responses are aggregated.
+// Access control options are shared with the functions handler.
+type GenericHandlerConfig struct {
+	ChainID string `json:"chainId"`
+	// Not specifying OnchainAllowlist config disables allowlist checks
+	OnchainAllowlist *fallow.OnchainAllowlistConfig `json:"onchainAllowlist"`
+	// Not specifying OnchainSubscriptions config disables minimum balance checks
+	OnchainSubscriptions       *fsub.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
+	MinimumSubscriptionBalance *assets.Link                     `json:"minimumSubscriptionBalance"`
+	// Not specifying RateLimiter config disables rate limiting
+	UserRateLimiter        *hc.RateLimiterConfig `json:"userRateLimiter"`
+	NodeRateLimiter        *hc.RateLimiterConfig `json:"nodeRateLimiter"`
+	SharedRateLimiterState bool                  `json:"sharedRateLimiterState"`
+	MaxPendingRequests     uint32                `json:"maxPendingRequests"`
+	RequestTimeoutMillis   int64                 `json:"requestTimeoutMillis"`
+	// Methods accepted from users, keyed by method name
+	Methods map[string]MethodConfig `json:"methods"`
+}
+
+type MethodConfig struct {
+	// One of "first_response" (default), "quorum_identical" or "median"
+	Aggregation string `json:"aggregation"`
+	// Top-level JSON field holding the value used by "median" aggregation. Empty means the whole payload.
+	MedianField string `json:"medianField"`
+	// Require the sender to hold a subscription with at least MinimumSubscriptionBalance
+	RequireMinimumBalance bool `json:"requireMinimumBalance"`
+}
+
+type genericHandler struct {
+	services.StateMachine
+
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	pendingRequests hc.RequestCache[PendingRequest]
+	aggregators     map[string]Aggregator
+	methods         map[string]MethodConfig
+	allowlist       fallow.OnchainAllowlist
+	subscriptions   fsub.OnchainSubscriptions
+	minimumBalance  *assets.Link
+	userRateLimiter *hc.RateLimiter
+	nodeRateLimiter *hc.RateLimiter
+	lggr            logger.Logger
+}
+
+type PendingRequest struct {
+	request   *api.Message
+	responses []*api.Message
+	senders   map[string]struct{}
+}
+
+var _ handlers.Handler = (*genericHandler)(nil)
+
+func NewGenericHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, legacyChains legacyevm.LegacyChainContainer, db *sqlx.DB, qcfg pg.QConfig, lggr logger.Logger) (handlers.Handler, error) {
+	var cfg GenericHandlerConfig
+	err := json.Unmarshal(handlerConfig, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	lggr = lggr.Named("GenericHandler:" + donConfig.DonId)
+	allowlist, err := functions.NewOnchainAllowlistFromConfig(cfg.ChainID, cfg.OnchainAllowlist, legacyChains, db, qcfg, lggr)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := functions.NewOnchainSubscriptionsFromConfig(cfg.ChainID, cfg.OnchainSubscriptions, legacyChains, db, qcfg, lggr)
+	if err != nil {
+		return nil, err
+	}
+	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
+	if cfg.UserRateLimiter != nil {
+		userRateLimiter, err = hc.NewRateLimiterFromConfig(*cfg.UserRateLimiter, cfg.SharedRateLimiterState, "generic/"+donConfig.DonId+"/user", db, qcfg, lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.NodeRateLimiter != nil {
+		nodeRateLimiter, err = hc.NewRateLimiterFromConfig(*cfg.NodeRateLimiter, cfg.SharedRateLimiterState, "generic/"+donConfig.DonId+"/node", db, qcfg, lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	return NewGenericHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, userRateLimiter, nodeRateLimiter, lggr)
+}
+
+func NewGenericHandler(
+	cfg GenericHandlerConfig,
+	donConfig *config.DONConfig,
+	don handlers.DON,
+	pendingRequestsCache hc.RequestCache[PendingRequest],
+	allowlist fallow.OnchainAllowlist,
+	subscriptions fsub.OnchainSubscriptions,
+	userRateLimiter *hc.RateLimiter,
+	nodeRateLimiter *hc.RateLimiter,
+	lggr logger.Logger) (handlers.Handler, error) {
+	if len(cfg.Methods) == 0 {
+		return nil, errors.New("no methods configured")
+	}
+	aggregators := make(map[string]Aggregator)
+	for method, methodConfig := range cfg.Methods {
+		if methodConfig.RequireMinimumBalance && (subscriptions == nil || cfg.MinimumSubscriptionBalance == nil) {
+			return nil, fmt.Errorf("method %s requires minimum balance but subscriptions are not configured", method)
+		}
+		aggregator, err := NewAggregator(methodConfig)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %w", method, err)
+		}
+		aggregators[method] = aggregator
+	}
+	return &genericHandler{
+		donConfig:       donConfig,
+		don:             don,
+		pendingRequests: pendingRequestsCache,
+		aggregators:     aggregators,
+		methods:         cfg.Methods,
+		allowlist:       allowlist,
+		subscriptions:   subscriptions,
+		minimumBalance:  cfg.MinimumSubscriptionBalance,
+		userRateLimiter: userRateLimiter,
+		nodeRateLimiter: nodeRateLimiter,
+		lggr:            lggr,
+	}, nil
+}
+
+func (h *genericHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	methodConfig, ok := h.methods[msg.Body.Method]
+	if !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+		return ErrUnsupportedMethod
+	}
+	sender := common.HexToAddress(msg.Body.Sender)
+	if h.allowlist != nil && !h.allowlist.Allow(sender) {
+		h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+		return ErrNotAllowlisted
+	}
+	if h.userRateLimiter != nil {
+		if err := h.userRateLimiter.AllowRequest(ctx, h.donConfig.DonId, msg.Body.Method, msg.Body.Sender); err != nil {
+			h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender, "method", msg.Body.Method, "err", err)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+			return err
+		}
+	}
+	if methodConfig.RequireMinimumBalance {
+		if err := functions.CheckMinimumBalance(h.subscriptions, h.minimumBalance, sender); err != nil {
+			h.lggr.Debugw("received a message from a user having insufficient balance", "sender", msg.Body.Sender, "err", err)
+			return err
+		}
+	}
+
+	h.lggr.Debugw("HandleUserMessage: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, senders: make(map[string]struct{})})
+	if err != nil {
+		h.lggr.Warnw("HandleUserMessage: error adding new request", "sender", msg.Body.Sender, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		return err
+	}
+	// Send to all nodes.
+	for _, member := range h.donConfig.Members {
+		err := h.don.SendToNode(ctx, member.Address, msg)
+		if err != nil {
+			h.lggr.Debugw("HandleUserMessage: failed to send to a node", "node", member.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (h *genericHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
+	if h.nodeRateLimiter != nil {
+		if err := h.nodeRateLimiter.AllowRequest(ctx, h.donConfig.DonId, msg.Body.Method, nodeAddr); err != nil {
+			h.lggr.Debugw("rate-limited", "sender", nodeAddr, "err", err)
+			return err
+		}
+	}
+	aggregator, ok := h.aggregators[msg.Body.Method]
+	if !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		return ErrUnsupportedMethod
+	}
+	return h.pendingRequests.ProcessResponse(msg, func(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+		return h.processResponse(aggregator, nodeAddr, response, responseData)
+	})
+}
+
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *genericHandler) processResponse(aggregator Aggregator, nodeAddr string, response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if _, exists := responseData.senders[nodeAddr]; exists {
+		return nil, nil, errors.New("duplicate response")
+	}
+	if response.Body.Method != responseData.request.Body.Method {
+		return nil, responseData, errors.New("invalid method")
+	}
+	responseData.senders[nodeAddr] = struct{}{}
+	responseData.responses = append(responseData.responses, response)
+
+	aggregated, err := aggregator.Aggregate(responseData.responses, h.donConfig)
+	if err != nil {
+		promRequestResult.WithLabelValues(h.donConfig.DonId, responseData.request.Body.Method, "false").Inc()
+		return &handlers.UserCallbackPayload{Msg: responseData.request, ErrCode: api.HandlerError, ErrMsg: err.Error()}, nil, nil
+	}
+	if aggregated == nil {
+		// not ready to be processed yet
+		return nil, responseData, nil
+	}
+	promRequestResult.WithLabelValues(h.donConfig.DonId, responseData.request.Body.Method, "true").Inc()
+	userResponse := *aggregated
+	userResponse.Body.Receiver = responseData.request.Body.Sender
+	return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: ""}, nil, nil
+}
+
+func (h *genericHandler) Start(ctx context.Context) error {
+	return h.StartOnce("GenericHandler", func() error {
+		h.lggr.Info("starting GenericHandler")
+		if h.allowlist != nil {
+			if err := h.allowlist.Start(ctx); err != nil {
+				return err
+			}
+		}
+		if h.subscriptions != nil {
+			if err := h.subscriptions.Start(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h *genericHandler) Close() error {
+	return h.StopOnce("GenericHandler", func() (err error) {
+		if h.allowlist != nil {
+			err = multierr.Combine(err, h.allowlist.Close())
+		}
+		if h.subscriptions != nil {
+			err = multierr.Combine(err, h.subscriptions.Close())
+		}
+		return
+	})
+}
@@ -0,0 +1,186 @@
+package generic_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	allowlist_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/allowlist/mocks"
+	subscriptions_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions/subscriptions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/generic"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+)
+
+func newGenericHandlerForATestDON(t *testing.T, nodes []gc.TestNode) (handlers.Handler, *handlers_mocks.DON, *allowlist_mocks.OnchainAllowlist, *subscriptions_mocks.OnchainSubscriptions) {
+	cfg := generic.GenericHandlerConfig{
+		MinimumSubscriptionBalance: assets.NewLinkFromJuels(100),
+		Methods: map[string]generic.MethodConfig{
+			"price_get": {Aggregation: generic.AggregationMedian, MedianField: "price"},
+			"data_put":  {Aggregation: generic.AggregationQuorumIdentical, RequireMinimumBalance: true},
+		},
+	}
+	donConfig := &config.DONConfig{
+		DonId:   "don_id",
+		Members: []config.NodeConfig{},
+		F:       1,
+	}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", id),
+			Address: n.Address,
+		})
+	}
+
+	don := handlers_mocks.NewDON(t)
+	allowlist := allowlist_mocks.NewOnchainAllowlist(t)
+	subscriptions := subscriptions_mocks.NewOnchainSubscriptions(t)
+	userRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[generic.PendingRequest](time.Hour, 1000)
+	handler, err := generic.NewGenericHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, userRateLimiter, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+	return handler, don, allowlist, subscriptions
+}
+
+func newSignedMessage(t *testing.T, id string, method string, user gc.TestNode) api.Message {
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    method,
+			DonId:     "don_id",
+			Sender:    user.Address,
+		},
+	}
+	require.NoError(t, msg.Sign(user.PrivateKey))
+	return msg
+}
+
+func sendNodeResponses(t *testing.T, handler handlers.Handler, userRequestMsg api.Message, nodes []gc.TestNode, payloads []string) {
+	for id, payload := range payloads {
+		nodeResponseMsg := userRequestMsg
+		nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+		nodeResponseMsg.Body.Payload = []byte(payload)
+		require.NoError(t, nodeResponseMsg.Sign(nodes[id].PrivateKey))
+		_ = handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[id].Address)
+	}
+}
+
+func TestGenericHandler_NoMethods(t *testing.T) {
+	t.Parallel()
+
+	_, err := generic.NewGenericHandlerFromConfig(json.RawMessage("{}"), &config.DONConfig{}, nil, nil, nil, nil, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func TestGenericHandler_MinimumBalanceWithoutSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	_, err := generic.NewGenericHandlerFromConfig(json.RawMessage(`{"methods": {"data_put": {"requireMinimumBalance": true}}}`), &config.DONConfig{}, nil, nil, nil, nil, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func TestGenericHandler_CleanStartAndClose(t *testing.T) {
+	t.Parallel()
+
+	handler, err := generic.NewGenericHandlerFromConfig(json.RawMessage(`{"methods": {"ping": {}}}`), &config.DONConfig{}, nil, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	servicetest.Run(t, handler)
+}
+
+func TestGenericHandler_HandleUserMessage_Median(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, _ := newGenericHandlerForATestDON(t, nodes)
+	userRequestMsg := newSignedMessage(t, "1234", "price_get", user)
+
+	callbackCh := make(chan handlers.UserCallbackPayload)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		response := <-callbackCh
+		require.Equal(t, api.NoError, response.ErrCode)
+		require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+		require.Equal(t, user.Address, response.Msg.Body.Receiver)
+		require.JSONEq(t, `{"price":"20"}`, string(response.Msg.Body.Payload))
+	}()
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+	sendNodeResponses(t, handler, userRequestMsg, nodes, []string{`{"price":"30"}`, `{"price":"10"}`, `{"price":"20"}`})
+	<-done
+}
+
+func TestGenericHandler_HandleUserMessage_NoQuorum(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don, allowlist, subscriptions := newGenericHandlerForATestDON(t, nodes)
+	userRequestMsg := newSignedMessage(t, "1234", "data_put", user)
+
+	callbackCh := make(chan handlers.UserCallbackPayload)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		response := <-callbackCh
+		require.Equal(t, api.HandlerError, response.ErrCode)
+		require.Equal(t, generic.ErrNoQuorum.Error(), response.ErrMsg)
+	}()
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	subscriptions.On("GetMaxUserBalance", common.HexToAddress(user.Address)).Return(big.NewInt(1000), nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+	sendNodeResponses(t, handler, userRequestMsg, nodes, []string{`1`, `2`, `3`, `4`})
+	<-done
+}
+
+func TestGenericHandler_HandleUserMessage_InsufficientBalance(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _, allowlist, subscriptions := newGenericHandlerForATestDON(t, nodes)
+	userRequestMsg := newSignedMessage(t, "1234", "data_put", user)
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	subscriptions.On("GetMaxUserBalance", common.HexToAddress(user.Address)).Return(big.NewInt(10), nil)
+	require.Error(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload)))
+}
+
+func TestGenericHandler_HandleUserMessage_NotAllowlisted(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _, allowlist, _ := newGenericHandlerForATestDON(t, nodes)
+	userRequestMsg := newSignedMessage(t, "1234", "price_get", user)
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(false, nil)
+	require.ErrorIs(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload)), generic.ErrNotAllowlisted)
+}
+
+func TestGenericHandler_HandleUserMessage_InvalidMethod(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _, _, _ := newGenericHandlerForATestDON(t, nodes)
+	userRequestMsg := newSignedMessage(t, "1234", "secrets_reveal_all_please", user)
+
+	require.ErrorIs(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload)), generic.ErrUnsupportedMethod)
+}
@@ -526,6 +526,9 @@ func TestORM_CreateJob_VRFV2Plus(t *testing.T) {
 			BackoffMaxDelay:              time.Hour,
 			GasLanePrice:                 assets.GWei(100),
 			CustomRevertsPipelineEnabled: true,
+			FulfillmentPriority:          vrfcommon.FulfillmentPriorityAge,
+			PrioritySubscriptionIDs:      []string{"2", "1"},
+			MulticallAddress:             "0xcA11bde05977b3631167028862bE2a173976CA11",
 		}).
 		Toml())
 	require.NoError(t, err)
@@ -569,6 +572,14 @@ func TestORM_CreateJob_VRFV2Plus(t *testing.T) {
 	require.ElementsMatch(t, fromAddresses, actual)
 	var vrfOwnerAddress ethkey.EIP55Address
 	require.Error(t, db.Get(&vrfOwnerAddress, `SELECT vrf_owner_address FROM vrf_specs LIMIT 1`))
+	var fulfillmentPriority string
+	require.NoError(t, db.Get(&fulfillmentPriority, `SELECT fulfillment_priority FROM vrf_specs LIMIT 1`))
+	require.Equal(t, vrfcommon.FulfillmentPriorityAge, fulfillmentPriority)
+	loaded, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	require.Equal(t, vrfcommon.FulfillmentPriorityAge, loaded.VRFSpec.FulfillmentPriority)
+	require.Equal(t, []string{"2", "1"}, []string(loaded.VRFSpec.PrioritySubscriptionIDs))
+	require.Equal(t, "0xcA11bde05977b3631167028862bE2a173976CA11", loaded.VRFSpec.MulticallAddress.String())
 	require.NoError(t, jobORM.DeleteJob(jb.ID))
 	cltest.AssertCount(t, db, "vrf_specs", 0)
 	cltest.AssertCount(t, db, "jobs", 0)
@@ -155,7 +155,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 		btORM := bridges.NewORM(db, logger.TestLogger(t), cfg.Database())
 		relayExtenders := evmtest.NewChainRelayExtenders(t, evmtest.TestChainOpts{Client: evmtest.NewEthClientMockWithDefaultChain(t), DB: db, GeneralConfig: config, KeyStore: ethKeyStore})
 		legacyChains := evmrelay.NewLegacyChainsFromRelayerExtenders(relayExtenders)
-		runner := pipeline.NewRunner(orm, btORM, config.JobPipeline(), cfg.WebServer(), legacyChains, nil, nil, lggr, nil, nil)
+		runner := pipeline.NewRunner(orm, btORM, config.JobPipeline(), cfg.WebServer(), legacyChains, nil, nil, lggr, nil, nil, bridges.NewHTTPClients(nil))
 
 		jobORM := NewTestORM(t, db, orm, btORM, keyStore, cfg.Database())
 
@@ -286,6 +286,43 @@ func (_m *ORM) FindJobIDsWithBridge(name string) ([]int32, error) {
 	return r0, r1
 }
 
+// FindJobSourceTOMLs provides a mock function with given fields: ids, qopts
+func (_m *ORM) FindJobSourceTOMLs(ids []int32, qopts ...pg.QOpt) (map[int32]string, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ids)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobSourceTOMLs")
+	}
+
+	var r0 map[int32]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int32, ...pg.QOpt) (map[int32]string, error)); ok {
+		return rf(ids, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func([]int32, ...pg.QOpt) map[int32]string); ok {
+		r0 = rf(ids, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int32, ...pg.QOpt) error); ok {
+		r1 = rf(ids, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobTx provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJobTx(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -666,6 +703,31 @@ func (_m *ORM) InsertJob(_a0 *job.Job, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// InsertJobSourceTOML provides a mock function with given fields: jobID, toml, qopts
+func (_m *ORM) InsertJobSourceTOML(jobID int32, toml string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, toml)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertJobSourceTOML")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, string, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, toml, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertWebhookSpec provides a mock function with given fields: webhookSpec, qopts
 func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -691,6 +753,31 @@ func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt)
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: id, reason, qopts
+func (_m *ORM) PauseJob(id int32, reason string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, reason)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, string, ...pg.QOpt) error); ok {
+		r0 = rf(id, reason, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineRuns provides a mock function with given fields: jobID, offset, size
 func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(jobID, offset, size)
@@ -753,6 +840,31 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
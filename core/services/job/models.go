@@ -84,7 +84,7 @@ var (
 		LegacyGasStationSidecar: false,
 		OffchainReporting2:      false, // bootstrap jobs do not require it
 		OffchainReporting:       false, // bootstrap jobs do not require it
-		Stream:                  true,
+		Stream:                  false, // streams with a native source do not require it
 		VRF:                     true,
 		Webhook:                 true,
 		Workflow:                false,
@@ -174,6 +174,16 @@ type Job struct {
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	// PausedAt is set while the job is paused, in which case its services are
+	// not running.
+	PausedAt    null.Time   `toml:"-"`
+	PauseReason null.String `toml:"-"`
+	// StreamSource configures a native source of a stream job, which is used
+	// instead of its pipeline.
+	StreamSource JSONConfig `toml:"streamSource"`
+	// SourceTOML is the spec the job was created from, if known. It is stored
+	// alongside the job, so that the job can be exported.
+	SourceTOML string `toml:"-" db:"-" json:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -191,6 +201,11 @@ func (j Job) ExternalIDEncodeStringToTopic() common.Hash {
 	return ExternalJobIDEncodeStringToTopic(j.ExternalJobID)
 }
 
+// IsPaused returns true if the job is paused.
+func (j Job) IsPaused() bool {
+	return j.PausedAt.Valid
+}
+
 // ExternalIDEncodeBytesToTopic encodes the external job ID (UUID) into a log topic (32 bytes)
 // by taking the 16 bytes underlying the UUID and right padding it.
 func (j Job) ExternalIDEncodeBytesToTopic() common.Hash {
@@ -424,8 +439,27 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SigningSecret, when set, allows runs to be triggered by requests
+	// signed with HMAC-SHA256 using this secret. It is stored encrypted with
+	// the keystore password in EncryptedSigningSecret, and is only set on
+	// specs which have not been stored yet.
+	SigningSecret          null.String `toml:"signingSecret" db:"-" json:"-"`
+	EncryptedSigningSecret []byte      `toml:"-" json:"-"`
+	// SignatureMaxAge bounds how far the timestamp of a signed request may be
+	// from the current time.
+	SignatureMaxAge models.Interval `toml:"signatureMaxAge"`
+	// JWKSURL, when set, allows runs to be triggered by requests carrying a
+	// JWT bearer token signed by one of the keys published at this URL.
+	JWKSURL     null.String `toml:"jwksURL" db:"jwks_url"`
+	JWTIssuer   null.String `toml:"jwtIssuer" db:"jwt_issuer"`
+	JWTAudience null.String `toml:"jwtAudience" db:"jwt_audience"`
+	CreatedAt   time.Time   `json:"createdAt" toml:"-"`
+	UpdatedAt   time.Time   `json:"updatedAt" toml:"-"`
+}
+
+// HasSigningSecret reports whether the spec accepts signed requests.
+func (w WebhookSpec) HasSigningSecret() bool {
+	return w.SigningSecret.Valid || len(w.EncryptedSigningSecret) > 0
 }
 
 func (w WebhookSpec) GetID() string {
@@ -453,12 +487,31 @@ type DirectRequestSpec struct {
 }
 
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
+	ID           int32  `toml:"-"`
+	CronSchedule string `toml:"schedule"`
+	// Timezone is the IANA time zone the schedule is evaluated in, as an
+	// alternative to a CRON_TZ prefix in the schedule.
+	Timezone string `toml:"timezone"`
+	// Jitter delays each scheduled run by a random duration up to this value.
+	Jitter models.Interval `toml:"jitter"`
+	// MaxConcurrentRuns skips a scheduled run when this many runs are still in
+	// progress. Zero means unlimited.
+	MaxConcurrentRuns uint32 `toml:"maxConcurrentRuns"`
+	// CatchUpLimit is the maximum number of schedules missed since the last
+	// recorded run that are run when the job starts. Zero disables catch-up.
+	CatchUpLimit uint32    `toml:"catchUpLimit"`
 	CreatedAt    time.Time `toml:"-"`
 	UpdatedAt    time.Time `toml:"-"`
 }
 
+// Schedule returns the cron schedule, evaluated in Timezone if set.
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" {
+		return s.CronSchedule
+	}
+	return "CRON_TZ=" + s.Timezone + " " + s.CronSchedule
+}
+
 func (s CronSpec) GetID() string {
 	return fmt.Sprintf("%v", s.ID)
 }
@@ -487,10 +540,16 @@ type FluxMonitorSpec struct {
 	DrumbeatSchedule    string
 	DrumbeatRandomDelay time.Duration
 	DrumbeatEnabled     bool
-	MinPayment          *commonassets.Link
-	EVMChainID          *big.Big  `toml:"evmChainID"`
-	CreatedAt           time.Time `toml:"-"`
-	UpdatedAt           time.Time `toml:"-"`
+	// HeartbeatSchedule is a cron schedule on which a submission is forced,
+	// unless the feed was updated since the previous heartbeat.
+	HeartbeatSchedule string
+	// MinSubmissionInterval is the minimum time between two polls of this
+	// node which lead to a submission.
+	MinSubmissionInterval time.Duration
+	MinPayment            *commonassets.Link
+	EVMChainID            *big.Big  `toml:"evmChainID"`
+	CreatedAt             time.Time `toml:"-"`
+	UpdatedAt             time.Time `toml:"-"`
 }
 
 type KeeperSpec struct {
@@ -553,6 +612,21 @@ type VRFSpec struct {
 	// only.
 	BackoffMaxDelay time.Duration `toml:"backoffMaxDelay"`
 
+	// FulfillmentPriority is the order in which the requests of a subscription are processed:
+	// "gasLimit" (cheapest callback first), "age" (oldest first) or "payment" (largest
+	// payment first: the maximum LINK or native fee of the simulated fulfillments, and the
+	// callback gas limit before they are simulated). Optional, defaults to "gasLimit". V2 only.
+	FulfillmentPriority string `toml:"fulfillmentPriority"`
+
+	// PrioritySubscriptionIDs are the decimal IDs of subscriptions whose requests are processed
+	// before those of any other subscription, in the given order. Optional. V2 only.
+	PrioritySubscriptionIDs pq.StringArray `toml:"prioritySubscriptionIDs" db:"priority_subscription_ids"`
+
+	// MulticallAddress is the address of a Multicall3 contract. If set, the fulfillments of
+	// each chunk of requests are simulated in a single eth_call through it, instead of in the
+	// ethcall tasks of the pipeline, which are skipped. Optional. V2 only.
+	MulticallAddress *ethkey.EIP55Address `toml:"multicallAddress"`
+
 	CreatedAt time.Time `toml:"-"`
 	UpdatedAt time.Time `toml:"-"`
 }
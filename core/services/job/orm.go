@@ -47,6 +47,7 @@ var (
 type ORM interface {
 	InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error
 	InsertJob(job *Job, qopts ...pg.QOpt) error
+	InsertJobSourceTOML(jobID int32, toml string, qopts ...pg.QOpt) error
 	CreateJob(jb *Job, qopts ...pg.QOpt) error
 	FindJobs(offset, limit int) ([]Job, int, error)
 	FindJobTx(ctx context.Context, id int32) (Job, error)
@@ -55,7 +56,10 @@ type ORM interface {
 	FindJobIDByAddress(address ethkey.EIP55Address, evmChainID *big.Big, qopts ...pg.QOpt) (int32, error)
 	FindOCR2JobIDByAddress(contractID string, feedID *common.Hash, qopts ...pg.QOpt) (int32, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
+	FindJobSourceTOMLs(ids []int32, qopts ...pg.QOpt) (map[int32]string, error)
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	PauseJob(id int32, reason string, qopts ...pg.QOpt) error
+	ResumeJob(id int32, qopts ...pg.QOpt) error
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
@@ -171,9 +175,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			}
 			var specID int32
 			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
-					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
+					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, heartbeat_schedule, min_submission_interval, min_payment, evm_chain_id, created_at, updated_at)
 			VALUES (:contract_address, :threshold, :absolute_threshold, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
-					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :evm_chain_id, NOW(), NOW())
+					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :heartbeat_schedule, :min_submission_interval, :min_payment, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.FluxMonitorSpec); err != nil {
 				return errors.Wrap(err, "failed to create FluxMonitorSpec")
@@ -309,8 +313,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, timezone, jitter, max_concurrent_runs, catch_up_limit, created_at, updated_at)
+			VALUES (:cron_schedule, :timezone, :jitter, :max_concurrent_runs, :catch_up_limit, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -327,6 +331,7 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled,
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay, gas_lane_price,
                 vrf_owner_address, custom_reverts_pipeline_enabled,
+				fulfillment_priority, priority_subscription_ids, multicall_address,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations,
@@ -334,6 +339,7 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay, :gas_lane_price,
 			    :vrf_owner_address, :custom_reverts_pipeline_enabled,
+				:fulfillment_priority, :priority_subscription_ids, :multicall_address,
 				NOW(), NOW())
 			RETURNING id;`
 
@@ -457,7 +463,14 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 		err = o.InsertJob(jb, pg.WithQueryer(tx))
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+
+		if jb.SourceTOML != "" {
+			err = o.InsertJobSourceTOML(jobID, jb.SourceTOML, pg.WithQueryer(tx))
+		}
+		return err
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -526,8 +539,18 @@ func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Maste
 
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	if webhookSpec.SigningSecret.Valid {
+		if o.keyStore == nil {
+			return errors.New("webhook signing secrets require the keystore")
+		}
+		encrypted, err := o.keyStore.EncryptSecret([]byte(webhookSpec.SigningSecret.String))
+		if err != nil {
+			return errors.Wrap(err, "failed to encrypt signingSecret")
+		}
+		webhookSpec.EncryptedSigningSecret = encrypted
+	}
+	query := `INSERT INTO webhook_specs (encrypted_signing_secret, signature_max_age, jwks_url, jwt_issuer, jwt_audience, created_at, updated_at)
+			VALUES (:encrypted_signing_secret, :signature_max_age, :jwks_url, :jwt_issuer, :jwt_audience, NOW(), NOW())
 			RETURNING *;`
 	return q.GetNamed(query, webhookSpec, webhookSpec)
 }
@@ -538,18 +561,18 @@ func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 
 	// if job has id, emplace otherwise insert with a new id.
 	if job.ID == 0 {
-		query = `INSERT INTO jobs (pipeline_spec_id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+		query = `INSERT INTO jobs (pipeline_spec_id, name, stream_id, stream_source, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
                 legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
-		VALUES (:pipeline_spec_id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
+		VALUES (:pipeline_spec_id, :name, :stream_id, :stream_source, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
 		        :legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
 		RETURNING *;`
 	} else {
-		query = `INSERT INTO jobs (id, pipeline_spec_id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+		query = `INSERT INTO jobs (id, pipeline_spec_id, name, stream_id, stream_source, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id, 
                   legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
-		VALUES (:id, :pipeline_spec_id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
+		VALUES (:id, :pipeline_spec_id, :name, :stream_id, :stream_source, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id, 
 				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
 		RETURNING *;`
@@ -557,6 +580,20 @@ func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	return q.GetNamed(query, job, job)
 }
 
+// InsertJobSourceTOML records the TOML spec a job was created from, replacing
+// any spec recorded before. The signingSecret of webhook specs is not recorded.
+func (o *orm) InsertJobSourceTOML(jobID int32, toml string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	toml, _, err := RemoveSigningSecret(toml)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert job TOML source")
+	}
+	sql := `INSERT INTO job_toml_sources (job_id, toml, created_at) VALUES ($1, $2, NOW())
+		ON CONFLICT (job_id) DO UPDATE SET toml = EXCLUDED.toml;`
+	_, err = q.Exec(sql, jobID, toml)
+	return errors.Wrap(err, "failed to insert job TOML source")
+}
+
 // DeleteJob removes a job
 func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	o.lggr.Debugw("Deleting job", "jobID", id)
@@ -675,6 +712,31 @@ func (o *orm) DismissError(ctx context.Context, ID int64) error {
 	return nil
 }
 
+// PauseJob marks a job as paused. Pausing a paused job only updates the reason.
+func (o *orm) PauseJob(id int32, reason string, qopts ...pg.QOpt) error {
+	stmt := `UPDATE jobs SET paused_at = COALESCE(paused_at, NOW()), pause_reason = $2 WHERE id = $1;`
+	return errors.Wrap(o.updateJob(stmt, qopts, id, sql.NullString{String: reason, Valid: reason != ""}), "PauseJob failed")
+}
+
+// ResumeJob clears the paused state of a job.
+func (o *orm) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	stmt := `UPDATE jobs SET paused_at = NULL, pause_reason = NULL WHERE id = $1;`
+	return errors.Wrap(o.updateJob(stmt, qopts, id), "ResumeJob failed")
+}
+
+// updateJob executes an update of a single job, returning sql.ErrNoRows if
+// the job does not exist.
+func (o *orm) updateJob(stmt string, qopts []pg.QOpt, args ...interface{}) error {
+	n, err := o.q.WithOpts(qopts...).ExecQWithRowsAffected(stmt, args...)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error) {
 	stmt := `SELECT * FROM job_spec_errors WHERE id = $1;`
 
@@ -915,6 +977,26 @@ func (o *orm) findJob(jb *Job, col string, arg interface{}, qopts ...pg.QOpt) er
 	return nil
 }
 
+// FindJobSourceTOMLs returns the TOML specs the given jobs were created
+// from, keyed by job ID. Jobs without a recorded spec are omitted.
+func (o *orm) FindJobSourceTOMLs(ids []int32, qopts ...pg.QOpt) (map[int32]string, error) {
+	stmt := `SELECT job_id, toml FROM job_toml_sources WHERE job_id = ANY($1);`
+
+	var rows []struct {
+		JobID int32
+		TOML  string `db:"toml"`
+	}
+	if err := o.q.WithOpts(qopts...).Select(&rows, stmt, ids); err != nil {
+		return nil, errors.Wrap(err, "FindJobSourceTOMLs failed")
+	}
+
+	sources := make(map[int32]string, len(rows))
+	for _, row := range rows {
+		sources[row.JobID] = row.TOML
+	}
+	return sources, nil
+}
+
 func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		query := `SELECT jobs.id, dot_dag_source FROM jobs JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE dot_dag_source ILIKE '%' || $1 || '%' ORDER BY id`
@@ -1307,7 +1389,8 @@ func loadVRFJob(tx pg.Queryer, job *Job, id *int32) error {
 // FromAddresses field. pq.ByteaArray must be used instead.
 type vrfSpecRow struct {
 	*VRFSpec
-	FromAddresses pq.ByteaArray
+	FromAddresses           pq.ByteaArray
+	PrioritySubscriptionIDs pq.StringArray `db:"priority_subscription_ids"`
 }
 
 func toVRFSpecRow(spec *VRFSpec) vrfSpecRow {
@@ -1315,7 +1398,9 @@ func toVRFSpecRow(spec *VRFSpec) vrfSpecRow {
 	for i, a := range spec.FromAddresses {
 		addresses[i] = a.Bytes()
 	}
-	return vrfSpecRow{VRFSpec: spec, FromAddresses: addresses}
+	// a nil array would be inserted as NULL
+	subIDs := append(pq.StringArray{}, spec.PrioritySubscriptionIDs...)
+	return vrfSpecRow{VRFSpec: spec, FromAddresses: addresses, PrioritySubscriptionIDs: subIDs}
 }
 
 func (r vrfSpecRow) toVRFSpec() *VRFSpec {
@@ -1323,6 +1408,7 @@ func (r vrfSpecRow) toVRFSpec() *VRFSpec {
 		r.VRFSpec.FromAddresses = append(r.VRFSpec.FromAddresses,
 			ethkey.EIP55AddressFromAddress(common.BytesToAddress(a)))
 	}
+	r.VRFSpec.PrioritySubscriptionIDs = r.PrioritySubscriptionIDs
 	return r.VRFSpec
 }
 
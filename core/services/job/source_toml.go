@@ -0,0 +1,348 @@
+package job
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+// SigningSecretKey is the key of the signingSecret of webhook specs. The
+// secret is stored encrypted in the webhook spec, and never in the TOML
+// source of the job.
+const SigningSecretKey = "signingSecret"
+
+// RemoveSigningSecret removes the top level signingSecret from a TOML spec and
+// returns it. The key is found by parsing the spec, so that quoted keys and
+// multi-line strings are handled, and the rest of the spec is kept as is.
+func RemoveSigningSecret(tomlString string) (string, null.String, error) {
+	data := []byte(tomlString)
+	p := unstable.Parser{}
+	p.Reset(data)
+	for p.NextExpression() {
+		expr := p.Expression()
+		if expr.Kind == unstable.Table || expr.Kind == unstable.ArrayTable {
+			// The keys of tables do not belong to the webhook spec.
+			break
+		}
+		if expr.Kind != unstable.KeyValue {
+			continue
+		}
+		keys := expr.Key()
+		if !keys.Next() || !keys.IsLast() || string(keys.Node().Data) != SigningSecretKey {
+			continue
+		}
+		value := expr.Value()
+		if value.Kind != unstable.String {
+			return "", null.String{}, errors.Errorf("%s must be a string", SigningSecretKey)
+		}
+		start := int(keys.Node().Raw.Offset)
+		end := int(value.Raw.Offset + value.Raw.Length)
+		return string(removeLine(data, start, end)), null.StringFrom(string(value.Data)), nil
+	}
+	if err := p.Error(); err != nil {
+		return "", null.String{}, errors.Wrap(err, "failed to parse TOML")
+	}
+	return tomlString, null.String{}, nil
+}
+
+// removeLine removes data[start:end] along with the rest of the line it is on,
+// so that no empty line nor trailing comment is left behind.
+func removeLine(data []byte, start, end int) []byte {
+	start = bytes.LastIndexByte(data[:start], '\n') + 1
+	if i := bytes.IndexByte(data[end:], '\n'); i >= 0 {
+		end += i + 1
+	} else {
+		end = len(data)
+	}
+	removed := make([]byte, 0, len(data)-(end-start))
+	removed = append(removed, data[:start]...)
+	return append(removed, data[end:]...)
+}
+
+// sourceTOMLHeader holds the keys which BuildSourceTOML writes first.
+type sourceTOMLHeader struct {
+	Type          string `toml:"type"`
+	SchemaVersion uint32 `toml:"schemaVersion"`
+	Name          string `toml:"name,omitempty"`
+	ExternalJobID string `toml:"externalJobID"`
+}
+
+type sourceTOMLObservationSource struct {
+	ObservationSource string `toml:"observationSource,multiline,omitempty"`
+}
+
+// encodeObservationSource encodes the observation source as a multi-line
+// literal string, so that it is written as is, unless it cannot be one.
+func encodeObservationSource(source string) ([]byte, error) {
+	literal := source != "" && !strings.Contains(source, "'''") &&
+		strings.IndexFunc(source, func(r rune) bool {
+			return r != '\t' && r != '\n' && r != '\r' && (r < 0x20 || r == 0x7f)
+		}) < 0
+	if !literal {
+		return toml.Marshal(sourceTOMLObservationSource{source})
+	}
+	if !strings.HasSuffix(source, "\n") {
+		source += "\n"
+	}
+	return []byte("observationSource = '''\n" + source + "'''\n"), nil
+}
+
+// BuildSourceTOML builds the TOML spec of a stored job, for jobs whose spec
+// was not recorded. Every field of the type specific spec is written under
+// its TOML key, except for IDs, timestamps and the signingSecret, and fields
+// with zero values are omitted. The spec is therefore normalized, and can be
+// compared to the spec built from another job, but it may not be accepted
+// as is by the validator of every job type.
+func BuildSourceTOML(jb Job) (string, error) {
+	fields := map[string]interface{}{}
+	if jb.GasLimit.Valid {
+		fields["gasLimit"] = jb.GasLimit.Uint32
+	}
+	if jb.ForwardingAllowed {
+		fields["forwardingAllowed"] = true
+	}
+	if jb.MaxTaskDuration != 0 {
+		fields["maxTaskDuration"] = jb.MaxTaskDuration.Duration().String()
+	}
+	if jb.StreamID != nil {
+		fields["streamID"] = *jb.StreamID
+	}
+	if len(jb.StreamSource) > 0 {
+		fields["streamSource"] = normalizeNumbers(map[string]interface{}(jb.StreamSource))
+	}
+	if spec := typeSpec(jb); spec != nil {
+		if err := addSpecFields(fields, reflect.ValueOf(spec).Elem()); err != nil {
+			return "", errors.Wrapf(err, "failed to build %s spec", jb.Type)
+		}
+	}
+	if jb.WebhookSpec != nil && len(jb.WebhookSpec.ExternalInitiatorWebhookSpecs) > 0 {
+		var eis []map[string]interface{}
+		for _, eiSpec := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			if eiSpec.ExternalInitiator.Name == "" {
+				return "", errors.Errorf("external initiator %d is not loaded", eiSpec.ExternalInitiatorID)
+			}
+			var spec interface{}
+			if err := json.Unmarshal([]byte(eiSpec.Spec.Raw), &spec); err != nil {
+				return "", errors.Wrapf(err, "invalid spec of external initiator %s", eiSpec.ExternalInitiator.Name)
+			}
+			eis = append(eis, map[string]interface{}{"name": eiSpec.ExternalInitiator.Name, "spec": normalizeNumbers(spec)})
+		}
+		fields["externalInitiators"] = eis
+	}
+
+	// Tables must follow the other keys, and so does the observation source
+	// which is written as a multi-line string.
+	tables := map[string]interface{}{}
+	for key, value := range fields {
+		if isTOMLTable(value) {
+			tables[key] = value
+			delete(fields, key)
+		}
+	}
+	observationSource := jb.Pipeline.Source
+	if observationSource == "" && jb.PipelineSpec != nil {
+		observationSource = jb.PipelineSpec.DotDagSource
+	}
+
+	var b strings.Builder
+	for _, v := range []interface{}{
+		sourceTOMLHeader{jb.Type.String(), jb.SchemaVersion, jb.Name.ValueOrZero(), jb.ExternalJobID.String()},
+		fields,
+		observationSource,
+		tables,
+	} {
+		var encoded []byte
+		var err error
+		if source, ok := v.(string); ok {
+			encoded, err = encodeObservationSource(source)
+		} else {
+			encoded, err = toml.Marshal(v)
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "failed to build TOML spec")
+		}
+		if _, ok := v.(map[string]interface{}); ok && len(encoded) > 0 && bytes.HasPrefix(encoded, []byte("[")) {
+			b.WriteString("\n")
+		}
+		b.Write(encoded)
+	}
+	return b.String(), nil
+}
+
+// typeSpec returns the type specific spec of a job, or nil if the type of the
+// job has none.
+func typeSpec(jb Job) interface{} {
+	var spec interface{}
+	switch jb.Type {
+	case OffchainReporting:
+		spec = jb.OCROracleSpec
+	case OffchainReporting2:
+		spec = jb.OCR2OracleSpec
+	case DirectRequest:
+		spec = jb.DirectRequestSpec
+	case FluxMonitor:
+		spec = jb.FluxMonitorSpec
+	case Keeper:
+		spec = jb.KeeperSpec
+	case Cron:
+		spec = jb.CronSpec
+	case VRF:
+		spec = jb.VRFSpec
+	case Webhook:
+		spec = jb.WebhookSpec
+	case BlockhashStore:
+		spec = jb.BlockhashStoreSpec
+	case BlockHeaderFeeder:
+		spec = jb.BlockHeaderFeederSpec
+	case LegacyGasStationServer:
+		spec = jb.LegacyGasStationServerSpec
+	case LegacyGasStationSidecar:
+		spec = jb.LegacyGasStationSidecarSpec
+	case Bootstrap:
+		spec = jb.BootstrapSpec
+	case Gateway:
+		spec = jb.GatewaySpec
+	default:
+		return nil
+	}
+	if v := reflect.ValueOf(spec); v.IsNil() {
+		return nil
+	}
+	return spec
+}
+
+// addSpecFields adds the fields of a type specific spec to fields, keyed by
+// their TOML keys. Fields without a toml tag are keyed by their name starting
+// in lower case, which is how the case-insensitive validators decode them.
+func addSpecFields(fields map[string]interface{}, spec reflect.Value) error {
+	t := spec.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get("toml"), ",")
+		switch {
+		case !f.IsExported() || key == "-" || key == SigningSecretKey:
+			continue
+		case f.Name == "ID" || f.Name == "CreatedAt" || f.Name == "UpdatedAt" || strings.HasSuffix(f.Name, "SpecID"):
+			continue
+		case f.Name == "ExternalInitiatorWebhookSpecs":
+			// Added by BuildSourceTOML, as externalInitiators.
+			continue
+		case key == "":
+			key = strings.ToLower(f.Name[:1]) + f.Name[1:]
+		}
+		value, ok, err := tomlValue(spec.Field(i))
+		if err != nil {
+			return errors.Wrapf(err, "field %s", f.Name)
+		}
+		if ok {
+			fields[key] = value
+		}
+	}
+	return nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// tomlValue returns the value of a spec field to encode in TOML, or false if
+// the field is not set. Zero values are not set, unless they are pointed to.
+func tomlValue(v reflect.Value) (interface{}, bool, error) {
+	if !v.IsValid() || v.IsZero() {
+		return nil, false, nil
+	}
+	if v.Kind() == reflect.Ptr {
+		return encodeTOMLValue(v.Elem())
+	}
+	return encodeTOMLValue(v)
+}
+
+func encodeTOMLValue(v reflect.Value) (interface{}, bool, error) {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String(), true, nil
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		mapType := reflect.TypeOf(map[string]interface{}{})
+		if !v.CanConvert(mapType) {
+			return nil, false, errors.Errorf("unsupported map type %s", v.Type())
+		}
+		return normalizeNumbers(v.Convert(mapType).Interface()), true, nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break // byte slices are text marshalled, or unsupported
+		}
+		values := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, ok, err := tomlValue(v.Index(i))
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				value = reflect.Zero(v.Type().Elem()).Interface()
+			}
+			values = append(values, value)
+		}
+		return values, true, nil
+	}
+	if v.Type().Implements(textMarshalerType) || reflect.PointerTo(v.Type()).Implements(textMarshalerType) {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		text, err := p.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, false, err
+		}
+		return string(text), true, nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return v.Bool(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), true, nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true, nil
+	}
+	return nil, false, errors.Errorf("unsupported type %s", v.Type())
+}
+
+func isTOMLTable(value interface{}) bool {
+	if _, ok := value.([]map[string]interface{}); ok {
+		return true
+	}
+	return reflect.ValueOf(value).Kind() == reflect.Map
+}
+
+// normalizeNumbers converts the whole numbers of a map decoded from JSON to
+// integers, so that they are not written as floats.
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			normalized[key] = normalizeNumbers(value)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, value := range v {
+			normalized[i] = normalizeNumbers(value)
+		}
+		return normalized
+	}
+	return value
+}
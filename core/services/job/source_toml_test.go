@@ -0,0 +1,142 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestRemoveSigningSecret(t *testing.T) {
+	t.Parallel()
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	for _, tc := range []struct {
+		name       string
+		spec       string
+		want       string
+		wantSecret null.String
+	}{
+		{
+			name:       "bare key",
+			spec:       "type = \"webhook\"\nsigningSecret = \"" + secret + "\"\nschemaVersion = 1\n",
+			want:       "type = \"webhook\"\nschemaVersion = 1\n",
+			wantSecret: null.StringFrom(secret),
+		},
+		{
+			name:       "quoted key and trailing comment",
+			spec:       "type = \"webhook\"\n  \"signingSecret\" = '" + secret + "' # secret\nschemaVersion = 1\n",
+			want:       "type = \"webhook\"\nschemaVersion = 1\n",
+			wantSecret: null.StringFrom(secret),
+		},
+		{
+			name:       "multi-line string",
+			spec:       "type = \"webhook\"\nsigningSecret = \"\"\"\n" + secret + "\"\"\"\nschemaVersion = 1",
+			want:       "type = \"webhook\"\nschemaVersion = 1",
+			wantSecret: null.StringFrom(secret),
+		},
+		{
+			name: "key in the observation source",
+			spec: "type = \"webhook\"\nobservationSource = \"\"\"\nsigningSecret = 1\n\"\"\"\n",
+			want: "type = \"webhook\"\nobservationSource = \"\"\"\nsigningSecret = 1\n\"\"\"\n",
+		},
+		{
+			name: "key in a table",
+			spec: "type = \"webhook\"\n[table]\nsigningSecret = \"" + secret + "\"\n",
+			want: "type = \"webhook\"\n[table]\nsigningSecret = \"" + secret + "\"\n",
+		},
+		{
+			name: "key in an inline table",
+			spec: "type = \"webhook\"\ntable = { signingSecret = \"" + secret + "\" }\n",
+			want: "type = \"webhook\"\ntable = { signingSecret = \"" + secret + "\" }\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			spec, secret, err := RemoveSigningSecret(tc.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, spec)
+			assert.Equal(t, tc.wantSecret, secret)
+		})
+	}
+
+	t.Run("invalid TOML", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := RemoveSigningSecret("signingSecret = ")
+		require.Error(t, err)
+	})
+}
+
+func TestBuildSourceTOML(t *testing.T) {
+	t.Parallel()
+
+	externalJobID := uuid.MustParse("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46")
+
+	t.Run("cron", func(t *testing.T) {
+		t.Parallel()
+		built, err := BuildSourceTOML(Job{
+			ID:              1,
+			Type:            Cron,
+			SchemaVersion:   1,
+			Name:            null.StringFrom("cron"),
+			ExternalJobID:   externalJobID,
+			MaxTaskDuration: models.Interval(time.Minute),
+			CronSpec:        &CronSpec{ID: 2, CronSchedule: "CRON_TZ=UTC * 0 0 1 1 *", CreatedAt: time.Now()},
+			PipelineSpec:    &pipeline.Spec{DotDagSource: "ds [type=http url=\"https://example.com\"];"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `type = 'cron'
+schemaVersion = 1
+name = 'cron'
+externalJobID = '0eec7e1d-d0d2-476c-a1a8-72dfb6633f46'
+maxTaskDuration = '1m0s'
+schedule = 'CRON_TZ=UTC * 0 0 1 1 *'
+observationSource = '''
+ds [type=http url="https://example.com"];
+'''
+`, built)
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		t.Parallel()
+		jb := Job{
+			Type:          Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: externalJobID,
+			WebhookSpec: &WebhookSpec{
+				SigningSecret:   null.StringFrom("0123456789abcdef0123456789abcdef"),
+				SignatureMaxAge: models.Interval(time.Minute),
+				ExternalInitiatorWebhookSpecs: []ExternalInitiatorWebhookSpec{{
+					ExternalInitiator: bridges.ExternalInitiator{Name: "ei"},
+					Spec:              models.JSON{Result: gjson.Parse(`{"count":1}`)},
+				}},
+			},
+		}
+		built, err := BuildSourceTOML(jb)
+		require.NoError(t, err)
+		assert.Equal(t, `type = 'webhook'
+schemaVersion = 1
+externalJobID = '0eec7e1d-d0d2-476c-a1a8-72dfb6633f46'
+signatureMaxAge = '1m0s'
+
+[[externalInitiators]]
+name = 'ei'
+
+[externalInitiators.spec]
+count = 1
+`, built)
+
+		jb.WebhookSpec.ExternalInitiatorWebhookSpecs[0].ExternalInitiator = bridges.ExternalInitiator{}
+		_, err = BuildSourceTOML(jb)
+		require.Error(t, err)
+	})
+}
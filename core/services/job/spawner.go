@@ -10,6 +10,7 @@ import (
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/jmoiron/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
@@ -32,6 +33,11 @@ type (
 		CreateJob(jb *Job, qopts ...pg.QOpt) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		// PauseJob stops the services of a job while keeping the job, so that
+		// it can be resumed later. Paused jobs are not started on boot.
+		PauseJob(jobID int32, reason string, qopts ...pg.QOpt) error
+		// ResumeJob resumes a paused job and starts its services.
+		ResumeJob(jobID int32, qopts ...pg.QOpt) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -143,6 +149,10 @@ func (js *spawner) startAllServices(ctx context.Context) {
 	}
 
 	for _, spec := range specs {
+		if spec.IsPaused() {
+			js.lggr.Infow("Not starting paused job", "jobID", spec.ID, "reason", spec.PauseReason.ValueOrZero())
+			continue
+		}
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
@@ -358,6 +368,66 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) PauseJob(jobID int32, reason string, qopts ...pg.QOpt) error {
+	q := js.q.WithOpts(qopts...)
+	pctx, cancel := js.chStop.Ctx(q.ParentCtx)
+	defer cancel()
+
+	if err := js.orm.PauseJob(jobID, reason, pg.WithQueryer(q.Queryer), pg.WithParentCtx(pctx)); err != nil {
+		return err
+	}
+
+	if js.isActive(jobID) {
+		js.stopService(jobID)
+	}
+	js.lggr.Infow("Paused job", "jobID", jobID, "reason", reason)
+
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	q := js.q.WithOpts(qopts...)
+	pctx, cancel := js.chStop.Ctx(q.ParentCtx)
+	defer cancel()
+
+	// The job is only marked as resumed once its services are running, so a
+	// failed start leaves it paused.
+	started := false
+	if !js.isActive(jobID) {
+		jb, err := js.orm.FindJob(pctx, jobID)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "job %d not found", jobID)
+		}
+		jb.PausedAt = null.Time{}
+		if err = js.StartService(pctx, jb, pg.WithQueryer(q.Queryer)); err != nil {
+			js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+			if js.isActive(jobID) {
+				js.stopService(jobID)
+			}
+			return err
+		}
+		started = true
+	}
+	if err := js.orm.ResumeJob(jobID, pg.WithQueryer(q.Queryer), pg.WithParentCtx(pctx)); err != nil {
+		if started {
+			js.stopService(jobID)
+		}
+		return err
+	}
+	js.lggr.Infow("Resumed job", "jobID", jobID)
+
+	return nil
+}
+
+func (js *spawner) isActive(jobID int32) bool {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	_, exists := js.activeJobs[jobID]
+	return exists
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -2,6 +2,8 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -266,6 +268,76 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops job services on 'PauseJob()' and restarts them on 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.Database(), config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db, lggr, config.Database()), keyStore, config.Database())
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config.Database(), mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		eventuallyStart.AwaitOrFail(t)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(jobA.ID, "maintenance"))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		paused, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, paused.IsPaused())
+		assert.Equal(t, "maintenance", paused.PauseReason.ValueOrZero())
+
+		// Paused jobs are not started on boot
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		defer func() { assert.NoError(t, spawner.Close()) }()
+		assert.Empty(t, spawner.ActiveJobs())
+
+		// A job whose services fail to start stays paused
+		serviceA1.On("Start", mock.Anything).Return(errors.New("failed to start")).Once()
+		require.Error(t, spawner.ResumeJob(jobA.ID))
+		assert.Empty(t, spawner.ActiveJobs())
+		stillPaused, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, stillPaused.IsPaused())
+
+		eventuallyRestart := cltest.NewAwaiter()
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyRestart.ItHappened() })
+		require.NoError(t, spawner.ResumeJob(jobA.ID))
+		eventuallyRestart.AwaitOrFail(t)
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		resumed, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, resumed.IsPaused())
+
+		require.ErrorIs(t, spawner.PauseJob(-1, ""), sql.ErrNoRows)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
@@ -306,7 +378,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		ocr2DelegateConfig := ocr2.NewDelegateConfig(config.OCR2(), config.Mercury(), config.Threshold(), config.Insecure(), config.JobPipeline(), config.Database(), processConfig)
 
 		d := ocr2.NewDelegate(nil, orm, nil, nil, nil, nil, nil, monitoringEndpoint, legacyChains, lggr, ocr2DelegateConfig,
-			keyStore.OCR2(), keyStore.DKGSign(), keyStore.DKGEncrypt(), ethKeyStore, testRelayGetter, mailMon, capabilities.NewRegistry(lggr))
+			keyStore.OCR2(), keyStore.DKGSign(), keyStore.DKGEncrypt(), ethKeyStore, testRelayGetter, mailMon, capabilities.NewRegistry(lggr), nil)
 		delegateOCR2 := &delegate{jobOCR2VRF.Type, []job.ServiceCtx{}, 0, nil, d}
 
 		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
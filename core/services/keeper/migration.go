@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// Migration links a legacy keeper job to the OCR2 automation job replacing it.
+// Both jobs run side by side until the OCR2 job is confirmed active, after which
+// the keeper job stops performing upkeeps.
+type Migration struct {
+	KeeperJobID int32
+	OCR2JobID   int32 `db:"ocr2_job_id"`
+	CreatedAt   time.Time
+	ConfirmedAt *time.Time
+}
+
+// Statuses of the migration of a keeper job.
+const (
+	MigrationStatusNotMigrated = "not_migrated"
+	MigrationStatusShadow      = "shadow"
+	MigrationStatusConfirmed   = "confirmed"
+)
+
+// Status returns the status of the migration, which is either shadow or confirmed.
+func (m Migration) Status() string {
+	if m.ConfirmedAt != nil {
+		return MigrationStatusConfirmed
+	}
+	return MigrationStatusShadow
+}
+
+// AutomationSpecParams are the settings of the OCR2 automation job replacing a
+// keeper job which cannot be derived from the keeper job itself.
+type AutomationSpecParams struct {
+	// ContractID is the address of the OCR2 automation registry.
+	ContractID string
+	// ContractVersion is the version of the OCR2 automation registry, defaults to v2.1.
+	ContractVersion string
+	OCRKeyBundleID  string
+	// TransmitterID defaults to the from address of the keeper job.
+	TransmitterID         string
+	P2PV2Bootstrappers    []string
+	MercuryCredentialName string
+}
+
+type automationRelayConfig struct {
+	ChainID int64 `toml:"chainID"`
+}
+
+type automationPluginConfig struct {
+	ContractVersion       string `toml:"contractVersion"`
+	MercuryCredentialName string `toml:"mercuryCredentialName,omitempty"`
+}
+
+type automationSpec struct {
+	Type                        string                 `toml:"type"`
+	PluginType                  string                 `toml:"pluginType"`
+	Relay                       string                 `toml:"relay"`
+	SchemaVersion               uint32                 `toml:"schemaVersion"`
+	Name                        string                 `toml:"name"`
+	ForwardingAllowed           bool                   `toml:"forwardingAllowed,omitempty"`
+	ContractID                  string                 `toml:"contractID"`
+	OCRKeyBundleID              string                 `toml:"ocrKeyBundleID"`
+	TransmitterID               string                 `toml:"transmitterID"`
+	ContractConfigConfirmations uint16                 `toml:"contractConfigConfirmations,omitempty"`
+	P2PV2Bootstrappers          []string               `toml:"p2pv2Bootstrappers"`
+	RelayConfig                 automationRelayConfig  `toml:"relayConfig"`
+	PluginConfig                automationPluginConfig `toml:"pluginConfig"`
+}
+
+// GenerateAutomationSpec generates the TOML spec of an OCR2 automation job
+// equivalent to the keeper job jb, on the same chain. forwardingAllowed is kept
+// and minIncomingConfirmations becomes the contractConfigConfirmations of the
+// OCR2 job. The settings of the keeper job without an OCR2 automation
+// equivalent are returned as dropped, with the reason they were dropped.
+func GenerateAutomationSpec(jb job.Job, params AutomationSpecParams) (spec string, dropped []string, err error) {
+	if jb.KeeperSpec == nil {
+		return "", nil, errors.Errorf("job %d is not a keeper job", jb.ID)
+	}
+	if jb.KeeperSpec.EVMChainID == nil {
+		return "", nil, errors.New("keeper job has no evmChainID")
+	}
+	if !common.IsHexAddress(params.ContractID) {
+		return "", nil, errors.Errorf("contractID of the OCR2 automation registry must be an address, got %q", params.ContractID)
+	}
+	if common.HexToAddress(params.ContractID) == jb.KeeperSpec.ContractAddress.Address() {
+		return "", nil, errors.New("contractID must be an OCR2 automation registry, not the legacy registry of the keeper job")
+	}
+	if params.OCRKeyBundleID == "" {
+		return "", nil, errors.New("ocrKeyBundleID is required")
+	}
+	if len(params.P2PV2Bootstrappers) == 0 {
+		return "", nil, errors.New("at least one p2pv2Bootstrapper is required")
+	}
+
+	name := jb.Name.ValueOrZero()
+	if name == "" {
+		name = fmt.Sprintf("keeper-%d", jb.ID)
+	}
+	ocr2Spec := automationSpec{
+		Type:               job.OffchainReporting2.String(),
+		PluginType:         "ocr2automation",
+		Relay:              "evm",
+		SchemaVersion:      1,
+		Name:               name + " (ocr2automation)",
+		ForwardingAllowed:  jb.ForwardingAllowed,
+		ContractID:         params.ContractID,
+		OCRKeyBundleID:     params.OCRKeyBundleID,
+		TransmitterID:      params.TransmitterID,
+		P2PV2Bootstrappers: params.P2PV2Bootstrappers,
+		RelayConfig:        automationRelayConfig{ChainID: jb.KeeperSpec.EVMChainID.Int64()},
+		PluginConfig: automationPluginConfig{
+			ContractVersion:       params.ContractVersion,
+			MercuryCredentialName: params.MercuryCredentialName,
+		},
+	}
+	if ocr2Spec.TransmitterID == "" {
+		ocr2Spec.TransmitterID = jb.KeeperSpec.FromAddress.String()
+	}
+	if ocr2Spec.PluginConfig.ContractVersion == "" {
+		ocr2Spec.PluginConfig.ContractVersion = "v2.1"
+	}
+	if confs := jb.KeeperSpec.MinIncomingConfirmations; confs != nil {
+		if *confs > math.MaxUint16 {
+			dropped = append(dropped, fmt.Sprintf("minIncomingConfirmations: %d exceeds the maximum contractConfigConfirmations of %d", *confs, math.MaxUint16))
+		} else {
+			ocr2Spec.ContractConfigConfirmations = uint16(*confs)
+		}
+	}
+	if jb.GasLimit.Valid {
+		dropped = append(dropped, fmt.Sprintf("gasLimit: %d is not used by OCR2 automation jobs, which transmit with the EVM.OCR2.Automation.GasLimit of the chain", jb.GasLimit.Uint32))
+	}
+	if !jb.MaxTaskDuration.IsZero() {
+		dropped = append(dropped, fmt.Sprintf("maxTaskDuration: %s is not used by OCR2 automation jobs, which have no pipeline", jb.MaxTaskDuration.Duration()))
+	}
+
+	var b bytes.Buffer
+	if err := toml.NewEncoder(&b).Order(toml.OrderPreserve).Indentation("").Encode(ocr2Spec); err != nil {
+		return "", nil, errors.Wrap(err, "failed to marshal OCR2 automation spec")
+	}
+	return b.String(), dropped, nil
+}
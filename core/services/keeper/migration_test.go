@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+)
+
+func TestGenerateAutomationSpec(t *testing.T) {
+	t.Parallel()
+
+	jb, err := keeper.ValidatedKeeperSpec(`
+type            = "keeper"
+schemaVersion   = 1
+name            = "legacy keeper"
+contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+fromAddress     = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+evmChainID      = 4
+`)
+	require.NoError(t, err)
+
+	params := keeper.AutomationSpecParams{
+		ContractID:            "0x613a38AC1659769640aaE063C651F48E0250454C",
+		OCRKeyBundleID:        "f4a5ec34d0d5a6b6a8f4b0e8b8cc3bbd8f40b7b6c2ba58b3ae00d0b8e8fa1e37",
+		P2PV2Bootstrappers:    []string{"12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"},
+		MercuryCredentialName: "cred",
+	}
+
+	t.Run("generates a valid spec", func(t *testing.T) {
+		spec, dropped, err := keeper.GenerateAutomationSpec(jb, params)
+		require.NoError(t, err)
+		assert.Empty(t, dropped)
+
+		cfg := configtest.NewGeneralConfig(t, nil)
+		ocr2Job, err := validate.ValidatedOracleSpecToml(cfg.OCR2(), cfg.Insecure(), spec)
+		require.NoError(t, err)
+		assert.Equal(t, "legacy keeper (ocr2automation)", ocr2Job.Name.ValueOrZero())
+		assert.Equal(t, params.ContractID, ocr2Job.OCR2OracleSpec.ContractID)
+		// The transmitter defaults to the from address of the keeper job.
+		assert.Equal(t, "0xa8037A20989AFcBC51798de9762b351D63ff462e", ocr2Job.OCR2OracleSpec.TransmitterID.String)
+		assert.Equal(t, int64(4), ocr2Job.OCR2OracleSpec.RelayConfig["chainID"])
+		assert.Equal(t, "v2.1", ocr2Job.OCR2OracleSpec.PluginConfig["contractVersion"])
+		assert.Equal(t, "cred", ocr2Job.OCR2OracleSpec.PluginConfig["mercuryCredentialName"])
+	})
+
+	t.Run("maps the keeper settings", func(t *testing.T) {
+		jb, err := keeper.ValidatedKeeperSpec(`
+type                     = "keeper"
+schemaVersion            = 1
+name                     = "legacy keeper"
+forwardingAllowed        = true
+gasLimit                 = 500000
+contractAddress          = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+fromAddress              = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+minIncomingConfirmations = 12
+evmChainID               = 4
+`)
+		require.NoError(t, err)
+
+		spec, dropped, err := keeper.GenerateAutomationSpec(jb, params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gasLimit: 500000 is not used by OCR2 automation jobs, which transmit with the EVM.OCR2.Automation.GasLimit of the chain"}, dropped)
+
+		cfg := configtest.NewGeneralConfig(t, nil)
+		ocr2Job, err := validate.ValidatedOracleSpecToml(cfg.OCR2(), cfg.Insecure(), spec)
+		require.NoError(t, err)
+		assert.True(t, ocr2Job.ForwardingAllowed)
+		assert.Equal(t, uint16(12), ocr2Job.OCR2OracleSpec.ContractConfigConfirmations)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		p := params
+		p.ContractID = ""
+		_, _, err := keeper.GenerateAutomationSpec(jb, p)
+		require.EqualError(t, err, `contractID of the OCR2 automation registry must be an address, got ""`)
+
+		p = params
+		p.ContractID = jb.KeeperSpec.ContractAddress.String()
+		_, _, err = keeper.GenerateAutomationSpec(jb, p)
+		require.EqualError(t, err, "contractID must be an OCR2 automation registry, not the legacy registry of the keeper job")
+
+		p = params
+		p.OCRKeyBundleID = ""
+		_, _, err = keeper.GenerateAutomationSpec(jb, p)
+		require.EqualError(t, err, "ocrKeyBundleID is required")
+
+		p = params
+		p.P2PV2Bootstrappers = nil
+		_, _, err = keeper.GenerateAutomationSpec(jb, p)
+		require.EqualError(t, err, "at least one p2pv2Bootstrapper is required")
+	})
+}
@@ -231,3 +231,54 @@ func (korm ORM) SetLastRunInfoForUpkeepOnJob(jobID int32, upkeepID *big.Big, hei
 	}
 	return rowsAffected, nil
 }
+
+// InsertMigration records the migration of a keeper job to an OCR2 automation job.
+func (korm ORM) InsertMigration(keeperJobID, ocr2JobID int32, qopts ...pg.QOpt) (migration Migration, err error) {
+	err = korm.q.WithOpts(qopts...).Get(&migration, `
+INSERT INTO keeper_migrations (keeper_job_id, ocr2_job_id) VALUES ($1, $2)
+RETURNING *`, keeperJobID, ocr2JobID)
+	return migration, errors.Wrap(err, "InsertMigration failed")
+}
+
+// MigrationForJob returns the migration of the keeper job with the given ID.
+func (korm ORM) MigrationForJob(keeperJobID int32, qopts ...pg.QOpt) (migration Migration, err error) {
+	err = korm.q.WithOpts(qopts...).Get(&migration, `SELECT * FROM keeper_migrations WHERE keeper_job_id = $1`, keeperJobID)
+	return migration, errors.Wrapf(err, "failed to get migration of job_id %d", keeperJobID)
+}
+
+// ErrAutomationJobNotConfigured is returned when confirming a migration whose
+// OCR2 automation job has not stored the contract config of its registry yet.
+var ErrAutomationJobNotConfigured = errors.New("OCR2 automation job has not stored the contract config of its registry yet")
+
+// ConfirmMigration confirms the migration of the keeper job with the given ID,
+// after which the keeper job stops performing upkeeps. Migrations are only
+// confirmed by operators once the upkeeps of the legacy registry are performed
+// by the OCR2 automation registry, which cannot be determined from the DB, and
+// the OCR2 automation job must have stored the contract config of its registry.
+// Confirming a confirmed migration returns it unchanged.
+func (korm ORM) ConfirmMigration(keeperJobID int32, qopts ...pg.QOpt) (migration Migration, err error) {
+	err = korm.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&migration, `SELECT * FROM keeper_migrations WHERE keeper_job_id = $1 FOR UPDATE`, keeperJobID); err != nil {
+			return errors.Wrapf(err, "failed to get migration of job_id %d", keeperJobID)
+		}
+		if migration.ConfirmedAt != nil {
+			return nil
+		}
+
+		var configured bool
+		if err = tx.Get(&configured, `
+SELECT EXISTS (
+	SELECT 1 FROM jobs
+	JOIN ocr2_contract_configs ON ocr2_contract_configs.ocr2_oracle_spec_id = jobs.ocr2_oracle_spec_id
+	WHERE jobs.id = $1
+)`, migration.OCR2JobID); err != nil {
+			return errors.Wrap(err, "failed to check the contract config of the OCR2 automation job")
+		}
+		if !configured {
+			return ErrAutomationJobNotConfigured
+		}
+
+		return errors.Wrap(tx.Get(&migration, `UPDATE keeper_migrations SET confirmed_at = NOW() WHERE keeper_job_id = $1 RETURNING *`, keeperJobID), "failed to confirm migration")
+	})
+	return migration, err
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"database/sql"
 	"fmt"
 	"math/big"
 	"sort"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -520,3 +522,57 @@ func TestKeeperDB_Uint256ToBit(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeperDB_Migration(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config.Database()).Eth()
+
+	_, keeperJob := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+
+	var ocr2SpecID, pipelineSpecID, ocr2JobID int32
+	require.NoError(t, db.Get(&ocr2SpecID, `INSERT INTO ocr2_oracle_specs (
+relay, relay_config, contract_id, p2pv2_bootstrappers, ocr_key_bundle_id, monitoring_endpoint, transmitter_id,
+blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations, plugin_type, plugin_config, created_at, updated_at) VALUES (
+'evm', '{}', $1, '{}', $2, '', $3, 0, 0, 0, 'ocr2automation', '{}', NOW(), NOW()
+) RETURNING id`, cltest.NewEIP55Address().String(), cltest.DefaultOCR2KeyBundleID, cltest.NewEIP55Address().String()))
+	require.NoError(t, db.Get(&pipelineSpecID, `INSERT INTO pipeline_specs (dot_dag_source, created_at) VALUES ('', NOW()) RETURNING id`))
+	require.NoError(t, db.Get(&ocr2JobID, `INSERT INTO jobs (pipeline_spec_id, external_job_id, schema_version, type, ocr2_oracle_spec_id, created_at)
+VALUES ($1, $2, 1, 'offchainreporting2', $3, NOW()) RETURNING id`, pipelineSpecID, uuid.New(), ocr2SpecID))
+
+	_, err := orm.MigrationForJob(keeperJob.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	migration, err := orm.InsertMigration(keeperJob.ID, ocr2JobID)
+	require.NoError(t, err)
+	assert.Equal(t, keeperJob.ID, migration.KeeperJobID)
+	assert.Equal(t, ocr2JobID, migration.OCR2JobID)
+	assert.Equal(t, keeper.MigrationStatusShadow, migration.Status())
+
+	// The OCR2 job has no contract config yet.
+	_, err = orm.ConfirmMigration(keeperJob.ID)
+	require.ErrorIs(t, err, keeper.ErrAutomationJobNotConfigured)
+	migration, err = orm.MigrationForJob(keeperJob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keeper.MigrationStatusShadow, migration.Status())
+
+	_, err = db.Exec(`INSERT INTO ocr2_contract_configs (ocr2_oracle_spec_id, plugin_id, config_digest, config_count, signers, transmitters, f, onchain_config, offchain_config_version, offchain_config, created_at, updated_at)
+VALUES ($1, 0, $2, 1, '{}', '{}', 1, '', 1, '', NOW(), NOW())`, ocr2SpecID, make([]byte, 32))
+	require.NoError(t, err)
+
+	confirmed, err := orm.ConfirmMigration(keeperJob.ID)
+	require.NoError(t, err)
+	require.NotNil(t, confirmed.ConfirmedAt)
+
+	// Already confirmed.
+	again, err := orm.ConfirmMigration(keeperJob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, confirmed.ConfirmedAt, again.ConfirmedAt)
+
+	_, err = orm.ConfirmMigration(keeperJob.ID + 1000)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	migration, err = orm.MigrationForJob(keeperJob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, keeper.MigrationStatusConfirmed, migration.Status())
+}
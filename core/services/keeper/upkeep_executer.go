@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"sync"
@@ -30,6 +31,9 @@ import (
 const (
 	executionQueueSize  = 10
 	maxUpkeepPerformGas = 5_000_000 // Max perform gas for upkeep is 5M on all chains for v1.x
+	// migrationCheckInterval is how often the executer checks whether the
+	// migration of its job to an OCR2 automation job was confirmed.
+	migrationCheckInterval = time.Minute
 )
 
 // UpkeepExecuter fulfills Service and HeadTrackable interfaces
@@ -69,6 +73,11 @@ type UpkeepExecuter struct {
 	logger                 logger.Logger
 	wgDone                 sync.WaitGroup
 	effectiveKeeperAddress common.Address
+
+	// migrationCheckedAt and migrationConfirmed cache the confirmation of the
+	// migration of the job, and are only accessed by run.
+	migrationCheckedAt time.Time
+	migrationConfirmed bool
 }
 
 // NewUpkeepExecuter is the constructor of UpkeepExecuter
@@ -152,6 +161,11 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 		return
 	}
 
+	if ex.migrated() {
+		ex.logger.Debugw("job was migrated to an OCR2 automation job, not checking upkeeps", "blockheight", head.Number)
+		return
+	}
+
 	ex.logger.Debugw("checking active upkeeps", "blockheight", head.Number)
 
 	registry, err := ex.orm.RegistryByContractAddress(ex.job.KeeperSpec.ContractAddress)
@@ -200,6 +214,34 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	ex.logger.Debugw("Finished checking upkeeps", "blockNum", head.Number)
 }
 
+// migrated checks whether the migration of the job to an OCR2 automation job was
+// confirmed. Until then both jobs perform upkeeps, afterwards only the OCR2 job does.
+// The migration is checked at most once per migrationCheckInterval, and the job
+// resumes once its migration is deleted along with the OCR2 job.
+func (ex *UpkeepExecuter) migrated() bool {
+	if !ex.migrationCheckedAt.IsZero() && time.Since(ex.migrationCheckedAt) < migrationCheckInterval {
+		return ex.migrationConfirmed
+	}
+
+	confirmed := false
+	migration, err := ex.orm.MigrationForJob(ex.job.ID)
+	if err == nil {
+		confirmed = migration.ConfirmedAt != nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		ex.logger.Error(errors.Wrap(err, "unable to load migration"))
+		return ex.migrationConfirmed
+	}
+	ex.migrationCheckedAt = time.Now()
+
+	if confirmed && !ex.migrationConfirmed {
+		ex.logger.Infow("migration to an OCR2 automation job was confirmed, stopping to perform upkeeps", "ocr2JobID", migration.OCR2JobID)
+	} else if !confirmed && ex.migrationConfirmed {
+		ex.logger.Infow("migration to an OCR2 automation job was deleted, resuming to perform upkeeps")
+	}
+	ex.migrationConfirmed = confirmed
+	return confirmed
+}
+
 // execute triggers the pipeline run
 func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
 	defer done()
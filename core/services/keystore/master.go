@@ -1,11 +1,13 @@
 package keystore
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
 	"sync"
 
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/pkg/errors"
 
 	"github.com/jmoiron/sqlx"
@@ -52,6 +54,11 @@ type Master interface {
 	VRF() VRF
 	Unlock(password string) error
 	IsEmpty() (bool, error)
+	// EncryptSecret encrypts a secret stored outside of the keystore, such
+	// as a bridge credential, with the keystore password.
+	EncryptSecret(plaintext []byte) ([]byte, error)
+	// DecryptSecret decrypts a secret encrypted by EncryptSecret.
+	DecryptSecret(ciphertext []byte) ([]byte, error)
 }
 
 type master struct {
@@ -199,6 +206,36 @@ func (km *keyManager) Unlock(password string) error {
 	return nil
 }
 
+func (km *keyManager) EncryptSecret(plaintext []byte) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(secretPassword(km.password)), km.scryptParams.N, km.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt secret")
+	}
+	return json.Marshal(&cryptoJSON)
+}
+
+func (km *keyManager) DecryptSecret(ciphertext []byte) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(ciphertext, &cryptoJSON); err != nil {
+		return nil, errors.Wrap(err, "could not decode secret")
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(cryptoJSON, secretPassword(km.password))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt secret")
+	}
+	return plaintext, nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
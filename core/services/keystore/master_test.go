@@ -61,3 +61,28 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_EncryptSecret(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg.Database())
+
+	_, err := keyStore.EncryptSecret([]byte("secret"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ciphertext, err := keyStore.EncryptSecret([]byte("secret"))
+	require.NoError(t, err)
+	require.NotContains(t, string(ciphertext), "secret")
+
+	plaintext, err := keyStore.DecryptSecret(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(plaintext))
+
+	keyStore.ResetXXXTestOnly()
+	_, err = keyStore.DecryptSecret(ciphertext)
+	require.ErrorIs(t, err, keystore.ErrLocked)
+}
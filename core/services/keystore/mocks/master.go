@@ -92,6 +92,66 @@ func (_m *Master) DKGSign() keystore.DKGSign {
 	return r0
 }
 
+// DecryptSecret provides a mock function with given fields: ciphertext
+func (_m *Master) DecryptSecret(ciphertext []byte) ([]byte, error) {
+	ret := _m.Called(ciphertext)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptSecret")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(ciphertext)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(ciphertext)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(ciphertext)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EncryptSecret provides a mock function with given fields: plaintext
+func (_m *Master) EncryptSecret(plaintext []byte) ([]byte, error) {
+	ret := _m.Called(plaintext)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncryptSecret")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(plaintext)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(plaintext)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(plaintext)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Eth provides a mock function with given fields:
 func (_m *Master) Eth() keystore.Eth {
 	ret := _m.Called()
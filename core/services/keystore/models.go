@@ -403,3 +403,9 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 func adulteratedPassword(password string) string {
 	return "master-password-" + password
 }
+
+// secretPassword keeps the encryption of secrets stored outside of the
+// keystore apart from the key ring's.
+func secretPassword(password string) string {
+	return "secret-password-" + password
+}
@@ -0,0 +1,43 @@
+package llo
+
+import (
+	"fmt"
+	"maps"
+	"math"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/protobuf"
+)
+
+// ReportFormatProtobuf is the report format of channels encoded as LLOReport
+// protobuf messages. chainlink-common does not define it, and allocates report
+// formats upwards from 1 with math.MaxUint32 reserved, so it takes the value
+// just below math.MaxUint32, which won't be allocated to another format.
+const ReportFormatProtobuf llotypes.ReportFormat = math.MaxUint32 - 1
+
+// reportCodecs holds the codec of every supported channel report format.
+//
+// NOTE: All codecs must be specified here
+var reportCodecs = map[llotypes.ReportFormat]llo.ReportCodec{
+	llotypes.ReportFormatEVM:  evm.ReportCodec{},
+	llotypes.ReportFormatJSON: llo.JSONReportCodec{},
+	ReportFormatProtobuf:      protobuf.ReportCodec{},
+}
+
+// NewReportCodecs returns the codecs keyed by channel report format.
+func NewReportCodecs() map[llotypes.ReportFormat]llo.ReportCodec {
+	return maps.Clone(reportCodecs)
+}
+
+// ValidateChannelDefinition returns an error if the report format of the
+// channel definition has no codec.
+func ValidateChannelDefinition(cd llotypes.ChannelDefinition) error {
+	if _, ok := reportCodecs[cd.ReportFormat]; !ok {
+		return fmt.Errorf("unsupported report format: %s", cd.ReportFormat)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package llo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func Test_ReportCodecs(t *testing.T) {
+	codecs := NewReportCodecs()
+
+	for _, rf := range []llotypes.ReportFormat{llotypes.ReportFormatEVM, llotypes.ReportFormatJSON, ReportFormatProtobuf} {
+		assert.Contains(t, codecs, rf)
+		assert.NoError(t, ValidateChannelDefinition(llotypes.ChannelDefinition{ReportFormat: rf}))
+	}
+
+	err := ValidateChannelDefinition(llotypes.ChannelDefinition{ReportFormat: llotypes.ReportFormatSolana})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported report format: solana")
+
+	t.Run("returns a copy", func(t *testing.T) {
+		delete(codecs, llotypes.ReportFormatEVM)
+		assert.NotNil(t, NewReportCodecs()[llotypes.ReportFormatEVM])
+	})
+}
+
+func Test_NewStaticChannelDefinitionCache(t *testing.T) {
+	_, err := NewStaticChannelDefinitionCache(logger.TestLogger(t), `{"1":{"reportFormat":4294967294,"chainSelector":42,"streamIds":[1]}}`)
+	require.NoError(t, err)
+
+	_, err = NewStaticChannelDefinitionCache(logger.TestLogger(t), `{"1":{"reportFormat":43,"chainSelector":42,"streamIds":[1]}}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid definition for channel 1")
+}
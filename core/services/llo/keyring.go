@@ -53,9 +53,11 @@ func (okr *onchainKeyring) MaxSignatureLength() (n int) {
 
 func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo]) (signature []byte, err error) {
 	rf := r.Info.ReportFormat
-	// HACK: sign/verify JSON payloads with EVM keys for now, this makes
-	// debugging and testing easier
-	if rf == llotypes.ReportFormatJSON {
+	// HACK: sign/verify JSON and protobuf payloads with EVM keys for now,
+	// this makes debugging and testing easier. Like JSON reports, protobuf
+	// reports are not verified onchain, so consumers verify them against the
+	// EVM onchain public keys of the DON until they get their own keyring.
+	if rf == llotypes.ReportFormatJSON || rf == ReportFormatProtobuf {
 		rf = llotypes.ReportFormatEVM
 	}
 	if key, exists := okr.keys[rf]; exists {
@@ -66,9 +68,9 @@ func (okr *onchainKeyring) Sign(digest types.ConfigDigest, seqNr uint64, r ocr3t
 
 func (okr *onchainKeyring) Verify(key types.OnchainPublicKey, digest types.ConfigDigest, seqNr uint64, r ocr3types.ReportWithInfo[llotypes.ReportInfo], signature []byte) bool {
 	rf := r.Info.ReportFormat
-	// HACK: sign/verify JSON payloads with EVM keys for now, this makes
-	// debugging and testing easier
-	if rf == llotypes.ReportFormatJSON {
+	// HACK: sign/verify JSON and protobuf payloads with EVM keys for now, see
+	// Sign
+	if rf == llotypes.ReportFormatJSON || rf == ReportFormatProtobuf {
 		rf = llotypes.ReportFormatEVM
 	}
 	if verifier, exists := okr.keys[rf]; exists {
@@ -110,6 +110,15 @@ func Test_Keyring(t *testing.T) {
 		}
 	})
 
+	t.Run("Sign with EVM key for JSON and protobuf", func(t *testing.T) {
+		for _, format := range []llotypes.ReportFormat{llotypes.ReportFormatJSON, ReportFormatProtobuf} {
+			sig, err := kr.Sign(cd, seqNr, ocr3types.ReportWithInfo[llotypes.ReportInfo]{Info: llotypes.ReportInfo{ReportFormat: format}})
+			require.NoError(t, err)
+
+			assert.Equal(t, []byte(fmt.Sprintf("sig-%d", llotypes.ReportFormatEVM)), sig)
+		}
+	})
+
 	t.Run("MaxSignatureLength", func(t *testing.T) {
 		assert.Equal(t, 8+4+2+1, kr.MaxSignatureLength())
 	})
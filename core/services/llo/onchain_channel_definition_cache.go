@@ -213,13 +213,22 @@ func (c *channelDefinitionCache) applyLog(log logpoller.Log) error {
 func (c *channelDefinitionCache) applyNewChannelDefinition(log *channel_config_store.ChannelConfigStoreNewChannelDefinition) {
 	streamIDs := make([]llotypes.StreamID, len(log.ChannelDefinition.StreamIDs))
 	copy(streamIDs, log.ChannelDefinition.StreamIDs)
-	c.definitionsMu.Lock()
-	defer c.definitionsMu.Unlock()
-	c.definitions[log.ChannelId] = llotypes.ChannelDefinition{
+	dfn := llotypes.ChannelDefinition{
 		ReportFormat:  llotypes.ReportFormat(log.ChannelDefinition.ReportFormat),
 		ChainSelector: log.ChannelDefinition.ChainSelector,
 		StreamIDs:     streamIDs,
 	}
+	c.definitionsMu.Lock()
+	defer c.definitionsMu.Unlock()
+	if err := ValidateChannelDefinition(dfn); err != nil {
+		// don't return error here, an invalid definition must not block the
+		// definitions that follow it; the channel is dropped instead of
+		// reporting with a stale definition
+		c.lggr.Errorw("Ignoring invalid channel definition", "channelID", log.ChannelId, "err", err)
+		delete(c.definitions, log.ChannelId)
+		return
+	}
+	c.definitions[log.ChannelId] = dfn
 }
 
 func (c *channelDefinitionCache) applyChannelDefinitionRemoved(log *channel_config_store.ChannelConfigStoreChannelDefinitionRemoved) {
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/llo-feeds/generated/channel_config_store"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 func Test_ChannelDefinitionCache(t *testing.T) {
@@ -23,4 +26,28 @@ func Test_ChannelDefinitionCache(t *testing.T) {
 
 		assert.Equal(t, dfns, cdc.Definitions())
 	})
+
+	t.Run("applyNewChannelDefinition", func(t *testing.T) {
+		cdc := &channelDefinitionCache{lggr: logger.TestLogger(t), definitions: make(llotypes.ChannelDefinitions)}
+
+		log := &channel_config_store.ChannelConfigStoreNewChannelDefinition{
+			ChannelId: 1,
+			ChannelDefinition: channel_config_store.IChannelConfigStoreChannelDefinition{
+				ReportFormat:  uint32(ReportFormatProtobuf),
+				ChainSelector: 42,
+				StreamIDs:     []uint32{1, 2},
+			},
+		}
+		cdc.applyNewChannelDefinition(log)
+		assert.Equal(t, llotypes.ChannelDefinitions{
+			1: {ReportFormat: ReportFormatProtobuf, ChainSelector: 42, StreamIDs: []llotypes.StreamID{1, 2}},
+		}, cdc.Definitions())
+
+		t.Run("drops the channel if its report format is unsupported", func(t *testing.T) {
+			log.ChannelDefinition.ReportFormat = 43
+			cdc.applyNewChannelDefinition(log)
+
+			assert.Empty(t, cdc.Definitions())
+		})
+	})
 }
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.8
+// source: core/services/llo/protobuf/report.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LLOReport is the protobuf encoding of an LLO channel report. Values are
+// encoded as two's complement big-endian integers, in stream order.
+type LLOReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigDigest      []byte   `protobuf:"bytes,1,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	ChainSelector     uint64   `protobuf:"varint,2,opt,name=chainSelector,proto3" json:"chainSelector,omitempty"`
+	SeqNr             uint64   `protobuf:"varint,3,opt,name=seqNr,proto3" json:"seqNr,omitempty"`
+	ChannelId         uint32   `protobuf:"varint,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ValidAfterSeconds uint32   `protobuf:"varint,5,opt,name=validAfterSeconds,proto3" json:"validAfterSeconds,omitempty"`
+	ValidUntilSeconds uint32   `protobuf:"varint,6,opt,name=validUntilSeconds,proto3" json:"validUntilSeconds,omitempty"`
+	Values            [][]byte `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
+	Specimen          bool     `protobuf:"varint,8,opt,name=specimen,proto3" json:"specimen,omitempty"`
+}
+
+func (x *LLOReport) Reset() {
+	*x = LLOReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_llo_protobuf_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLOReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLOReport) ProtoMessage() {}
+
+func (x *LLOReport) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_llo_protobuf_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLOReport.ProtoReflect.Descriptor instead.
+func (*LLOReport) Descriptor() ([]byte, []int) {
+	return file_core_services_llo_protobuf_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LLOReport) GetConfigDigest() []byte {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return nil
+}
+
+func (x *LLOReport) GetChainSelector() uint64 {
+	if x != nil {
+		return x.ChainSelector
+	}
+	return 0
+}
+
+func (x *LLOReport) GetSeqNr() uint64 {
+	if x != nil {
+		return x.SeqNr
+	}
+	return 0
+}
+
+func (x *LLOReport) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *LLOReport) GetValidAfterSeconds() uint32 {
+	if x != nil {
+		return x.ValidAfterSeconds
+	}
+	return 0
+}
+
+func (x *LLOReport) GetValidUntilSeconds() uint32 {
+	if x != nil {
+		return x.ValidUntilSeconds
+	}
+	return 0
+}
+
+func (x *LLOReport) GetValues() [][]byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *LLOReport) GetSpecimen() bool {
+	if x != nil {
+		return x.Specimen
+	}
+	return false
+}
+
+var File_core_services_llo_protobuf_report_proto protoreflect.FileDescriptor
+
+var file_core_services_llo_protobuf_report_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x6c, 0x6c, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c, 0x6c, 0x6f, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x22, 0x99, 0x02, 0x0a, 0x09, 0x4c, 0x4c, 0x4f, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x65, 0x71, 0x4e, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x6d, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x6d, 0x65, 0x6e, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b,
+	0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x76, 0x32, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x6c,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_core_services_llo_protobuf_report_proto_rawDescOnce sync.Once
+	file_core_services_llo_protobuf_report_proto_rawDescData = file_core_services_llo_protobuf_report_proto_rawDesc
+)
+
+func file_core_services_llo_protobuf_report_proto_rawDescGZIP() []byte {
+	file_core_services_llo_protobuf_report_proto_rawDescOnce.Do(func() {
+		file_core_services_llo_protobuf_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_services_llo_protobuf_report_proto_rawDescData)
+	})
+	return file_core_services_llo_protobuf_report_proto_rawDescData
+}
+
+var file_core_services_llo_protobuf_report_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_core_services_llo_protobuf_report_proto_goTypes = []interface{}{
+	(*LLOReport)(nil), // 0: llo_protobuf.LLOReport
+}
+var file_core_services_llo_protobuf_report_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_core_services_llo_protobuf_report_proto_init() }
+func file_core_services_llo_protobuf_report_proto_init() {
+	if File_core_services_llo_protobuf_report_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_core_services_llo_protobuf_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LLOReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_core_services_llo_protobuf_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_core_services_llo_protobuf_report_proto_goTypes,
+		DependencyIndexes: file_core_services_llo_protobuf_report_proto_depIdxs,
+		MessageInfos:      file_core_services_llo_protobuf_report_proto_msgTypes,
+	}.Build()
+	File_core_services_llo_protobuf_report_proto = out.File
+	file_core_services_llo_protobuf_report_proto_rawDesc = nil
+	file_core_services_llo_protobuf_report_proto_goTypes = nil
+	file_core_services_llo_protobuf_report_proto_depIdxs = nil
+}
@@ -0,0 +1,98 @@
+package protobuf
+
+import (
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+var _ llo.ReportCodec = ReportCodec{}
+
+// ReportCodec encodes LLO reports as LLOReport protobuf messages, for
+// off-chain consumers.
+type ReportCodec struct{}
+
+func NewReportCodec() ReportCodec {
+	return ReportCodec{}
+}
+
+func (ReportCodec) Encode(report llo.Report) ([]byte, error) {
+	values := make([][]byte, len(report.Values))
+	for i, v := range report.Values {
+		if v == nil {
+			return nil, fmt.Errorf("failed to encode report: nil value at index %d", i)
+		}
+		values[i] = encodeInt(v)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(&LLOReport{
+		ConfigDigest:      report.ConfigDigest[:],
+		ChainSelector:     report.ChainSelector,
+		SeqNr:             report.SeqNr,
+		ChannelId:         uint32(report.ChannelID),
+		ValidAfterSeconds: report.ValidAfterSeconds,
+		ValidUntilSeconds: report.ValidUntilSeconds,
+		Values:            values,
+		Specimen:          report.Specimen,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+	return b, nil
+}
+
+func (ReportCodec) Decode(encoded []byte) (llo.Report, error) {
+	var r LLOReport
+	if err := proto.Unmarshal(encoded, &r); err != nil {
+		return llo.Report{}, fmt.Errorf("failed to decode report: %w", err)
+	}
+	cd, err := types.BytesToConfigDigest(r.ConfigDigest)
+	if err != nil {
+		return llo.Report{}, fmt.Errorf("invalid ConfigDigest; %w", err)
+	}
+	values := make([]*big.Int, len(r.Values))
+	for i, v := range r.Values {
+		values[i] = decodeInt(v)
+	}
+	return llo.Report{
+		ConfigDigest:      cd,
+		ChainSelector:     r.ChainSelector,
+		SeqNr:             r.SeqNr,
+		ChannelID:         llotypes.ChannelID(r.ChannelId),
+		ValidAfterSeconds: r.ValidAfterSeconds,
+		ValidUntilSeconds: r.ValidUntilSeconds,
+		Values:            values,
+		Specimen:          r.Specimen,
+	}, nil
+}
+
+// encodeInt returns the shortest two's complement big-endian encoding of v.
+// Zero is encoded as an empty byte slice.
+func encodeInt(v *big.Int) []byte {
+	if v.Sign() >= 0 {
+		b := v.Bytes()
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -v-1 has the same bit length as the magnitude bits of the encoding
+	n := new(big.Int).Not(v).BitLen()/8 + 1
+	m := new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+	m.Add(m, v)
+	return m.FillBytes(make([]byte, n))
+}
+
+func decodeInt(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return v
+}
@@ -0,0 +1,110 @@
+package protobuf
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+func newValidReport() llo.Report {
+	return llo.Report{
+		ConfigDigest:      types.ConfigDigest{1, 2, 3},
+		ChainSelector:     5009297550715157269,
+		SeqNr:             32,
+		ChannelID:         llotypes.ChannelID(31),
+		ValidAfterSeconds: 33,
+		ValidUntilSeconds: 34,
+		Values:            []*big.Int{big.NewInt(35), big.NewInt(-36), big.NewInt(0)},
+		Specimen:          true,
+	}
+}
+
+func Test_ReportCodec(t *testing.T) {
+	rc := ReportCodec{}
+
+	t.Run("Encode errors on nil value", func(t *testing.T) {
+		report := newValidReport()
+		report.Values = []*big.Int{big.NewInt(1), nil}
+
+		_, err := rc.Encode(report)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nil value at index 1")
+	})
+
+	t.Run("Encode is deterministic", func(t *testing.T) {
+		a, err := rc.Encode(newValidReport())
+		require.NoError(t, err)
+		b, err := rc.Encode(newValidReport())
+		require.NoError(t, err)
+
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("Decode reverses Encode", func(t *testing.T) {
+		report := newValidReport()
+		int192Max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 191), big.NewInt(1))
+		int192Min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 191))
+		report.Values = append(report.Values, int192Max, int192Min)
+
+		encoded, err := rc.Encode(report)
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+
+		assert.Equal(t, report.ConfigDigest, decoded.ConfigDigest)
+		assert.Equal(t, report.ChainSelector, decoded.ChainSelector)
+		assert.Equal(t, report.SeqNr, decoded.SeqNr)
+		assert.Equal(t, report.ChannelID, decoded.ChannelID)
+		assert.Equal(t, report.ValidAfterSeconds, decoded.ValidAfterSeconds)
+		assert.Equal(t, report.ValidUntilSeconds, decoded.ValidUntilSeconds)
+		require.Len(t, decoded.Values, len(report.Values))
+		for i := range report.Values {
+			assert.Zero(t, report.Values[i].Cmp(decoded.Values[i]), "value %d: expected %s, got %s", i, report.Values[i], decoded.Values[i])
+		}
+		assert.Equal(t, report.Specimen, decoded.Specimen)
+	})
+
+	t.Run("Decode errors on invalid input", func(t *testing.T) {
+		_, err := rc.Decode([]byte("foo"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode report")
+	})
+
+	t.Run("Decode errors on invalid config digest", func(t *testing.T) {
+		encoded, err := proto.Marshal(&LLOReport{ConfigDigest: []byte{1, 2, 3}})
+		require.NoError(t, err)
+
+		_, err = rc.Decode(encoded)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid ConfigDigest")
+	})
+}
+
+func Test_encodeInt(t *testing.T) {
+	for _, tc := range []struct {
+		v   int64
+		enc []byte
+	}{
+		{0, []byte{}},
+		{1, []byte{0x01}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x00, 0x80}},
+		{-1, []byte{0xff}},
+		{-128, []byte{0x80}},
+		{-129, []byte{0xff, 0x7f}},
+	} {
+		enc := encodeInt(big.NewInt(tc.v))
+		assert.Equal(t, tc.enc, enc, "encodeInt(%d)", tc.v)
+		assert.Equal(t, tc.v, decodeInt(enc).Int64(), "decodeInt(%x)", enc)
+	}
+}
@@ -3,6 +3,7 @@ package llo
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
@@ -27,6 +28,11 @@ func NewStaticChannelDefinitionCache(lggr logger.Logger, dfnstr string) (llotype
 	if err := json.Unmarshal([]byte(dfnstr), &definitions); err != nil {
 		return nil, err
 	}
+	for id, dfn := range definitions {
+		if err := ValidateChannelDefinition(dfn); err != nil {
+			return nil, fmt.Errorf("invalid definition for channel %d: %w", id, err)
+		}
+	}
 	return &staticCDC{services.StateMachine{}, lggr.Named("StaticChannelDefinitionCache"), definitions}, nil
 }
 
@@ -3,9 +3,15 @@ package llo
 import (
 	"context"
 	"crypto/ed25519"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/jpillora/backoff"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
@@ -16,24 +22,70 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // LLO Transmitter implementation, based on
 // core/services/relay/evm/mercury/transmitter.go
 
-// TODO: prom metrics (common with mercury/transmitter.go?)
-// https://smartcontract-it.atlassian.net/browse/MERC-3659
-
 const (
 	// Mercury server error codes
 	DuplicateReport = 2
-	// TODO: revisit these values in light of parallel composition
-	// https://smartcontract-it.atlassian.net/browse/MERC-3659
-	// maxTransmitQueueSize = 10_000
-	// maxDeleteQueueSize   = 10_000
-	// transmitTimeout      = 5 * time.Second
+)
+
+var (
+	maxTransmitQueueSize  = 10_000
+	transmitTimeout       = 5 * time.Second
+	flushDeletesFrequency = time.Second
+	pruneFrequency        = time.Hour
+)
+
+var (
+	transmitSuccessCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_success_count",
+		Help: "Number of successful transmissions (duplicates are counted as success)",
+	},
+		[]string{"serverURL"},
+	)
+	transmitDuplicateCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_duplicate_count",
+		Help: "Number of transmissions where the server told us it was a duplicate",
+	},
+		[]string{"serverURL"},
+	)
+	transmitConnectionErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_connection_error_count",
+		Help: "Number of errored transmissions that failed due to problem with the connection",
+	},
+		[]string{"serverURL"},
+	)
+	transmitQueueInsertErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_queue_insert_error_count",
+		Help: "Running count of DB errors when trying to insert an item into the queue DB",
+	},
+		[]string{"serverURL"},
+	)
+	transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llo_mercury_transmit_queue_load",
+		Help: "Current count of items in the transmit queue",
+	},
+		[]string{"serverURL", "capacity"},
+	)
+	transmitQueuePushErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_queue_push_error_count",
+		Help: "Running count of DB errors when trying to push an item onto the queue",
+	},
+		[]string{"serverURL"},
+	)
+	transmitServerErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "llo_mercury_transmit_server_error_count",
+		Help: "Number of errored transmissions that failed due to an error returned by the mercury server",
+	},
+		[]string{"serverURL", "code"},
+	)
 )
 
 var PayloadTypes = getPayloadTypes()
@@ -60,38 +112,116 @@ type Transmitter interface {
 	services.Service
 }
 
+var _ Transmitter = (*transmitter)(nil)
+
+// transmitter persists reports and transmits them to each of its servers,
+// deleting them once the server acknowledges them.
 type transmitter struct {
 	services.StateMachine
 	lggr        logger.Logger
-	rpcClient   wsrpc.Client
+	orm         TransmitterORM
+	servers     map[string]*server
 	fromAccount string
+
+	configsMu sync.Mutex
+	// configsSeenAt records when the job first transmitted a report of each
+	// config digest, see Transmission.ConfigSeenAt.
+	configsSeenAt map[types.ConfigDigest]time.Time
 }
 
-func NewTransmitter(lggr logger.Logger, rpcClient wsrpc.Client, fromAccount ed25519.PublicKey) Transmitter {
+// server transmits the reports of a transmitter to one server, from its own
+// queue, retrying until the server acknowledges them.
+type server struct {
+	lggr      logger.Logger
+	url       string
+	rpcClient wsrpc.Client
+	pm        *mercury.Persistence[*Transmission]
+	q         *mercury.Queue[*Transmission]
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+
+	transmitSuccessCount          prometheus.Counter
+	transmitDuplicateCount        prometheus.Counter
+	transmitConnectionErrorCount  prometheus.Counter
+	transmitQueueInsertErrorCount prometheus.Counter
+	transmitQueuePushErrorCount   prometheus.Counter
+}
+
+// NewTransmitter returns a transmitter which transmits reports to each of
+// clients, by server URL, persisting them with orm until acknowledged.
+func NewTransmitter(lggr logger.Logger, clients map[string]wsrpc.Client, fromAccount ed25519.PublicKey, orm TransmitterORM) Transmitter {
+	lggr = lggr.Named("LLOTransmitter")
+	servers := make(map[string]*server, len(clients))
+	for serverURL, client := range clients {
+		sLggr := lggr.With("serverURL", serverURL)
+		servers[serverURL] = &server{
+			lggr:                          sLggr,
+			url:                           serverURL,
+			rpcClient:                     client,
+			pm:                            mercury.NewPersistence[*Transmission](sLggr.Named("LLOPersistenceManager"), &serverTransmissionStore{orm, serverURL}, maxTransmitQueueSize, flushDeletesFrequency, pruneFrequency),
+			stopCh:                        make(services.StopChan),
+			transmitSuccessCount:          transmitSuccessCount.WithLabelValues(serverURL),
+			transmitDuplicateCount:        transmitDuplicateCount.WithLabelValues(serverURL),
+			transmitConnectionErrorCount:  transmitConnectionErrorCount.WithLabelValues(serverURL),
+			transmitQueueInsertErrorCount: transmitQueueInsertErrorCount.WithLabelValues(serverURL),
+			transmitQueuePushErrorCount:   transmitQueuePushErrorCount.WithLabelValues(serverURL),
+		}
+	}
 	return &transmitter{
-		services.StateMachine{},
-		lggr,
-		rpcClient,
-		fmt.Sprintf("%x", fromAccount),
+		lggr:          lggr,
+		orm:           orm,
+		servers:       servers,
+		fromAccount:   fmt.Sprintf("%x", fromAccount),
+		configsSeenAt: make(map[types.ConfigDigest]time.Time),
 	}
 }
 
 func (t *transmitter) Start(ctx context.Context) error {
-	return nil
+	return t.StartOnce("LLOTransmitter", func() error {
+		for _, s := range t.servers {
+			transmissions, err := s.start(ctx)
+			if err != nil {
+				return err
+			}
+			t.configsMu.Lock()
+			for _, tr := range transmissions {
+				if seenAt, ok := t.configsSeenAt[tr.ConfigDigest]; !ok || tr.ConfigSeenAt.Before(seenAt) {
+					t.configsSeenAt[tr.ConfigDigest] = tr.ConfigSeenAt
+				}
+			}
+			t.configsMu.Unlock()
+		}
+		return nil
+	})
 }
 
 func (t *transmitter) Close() error {
-	return nil
+	return t.StopOnce("LLOTransmitter", func() error {
+		var merr error
+		for _, s := range t.servers {
+			merr = errors.Join(merr, s.close())
+		}
+		return merr
+	})
 }
 
 func (t *transmitter) HealthReport() map[string]error {
 	report := map[string]error{t.Name(): t.Healthy()}
-	services.CopyHealth(report, t.rpcClient.HealthReport())
+	for _, s := range t.servers {
+		services.CopyHealth(report, s.rpcClient.HealthReport())
+		if s.q != nil {
+			services.CopyHealth(report, s.q.HealthReport())
+		}
+	}
 	return report
 }
 
 func (t *transmitter) Name() string { return t.lggr.Name() }
 
+// Transmit persists the report for all servers at once and queues it for
+// transmission to each of them. It returns an error if the report could not
+// be persisted, or could be queued for none of the servers.
 func (t *transmitter) Transmit(
 	ctx context.Context,
 	digest types.ConfigDigest,
@@ -102,7 +232,7 @@ func (t *transmitter) Transmit(
 	var payload []byte
 
 	switch report.Info.ReportFormat {
-	case llotypes.ReportFormatJSON:
+	case llotypes.ReportFormatJSON, ReportFormatProtobuf:
 		fallthrough
 	case llotypes.ReportFormatEVM:
 		payload, err = encodeEVM(digest, seqNr, report.Report, sigs)
@@ -119,10 +249,153 @@ func (t *transmitter) Transmit(
 		ReportFormat: uint32(report.Info.ReportFormat),
 	}
 
-	// TODO: persistenceManager and queueing, error handling, retry etc
-	// https://smartcontract-it.atlassian.net/browse/MERC-3659
-	_, err = t.rpcClient.Transmit(ctx, req)
-	return err
+	t.lggr.Tracew("Transmit enqueue", "req.Payload", req.Payload, "seqNr", seqNr, "digest", digest)
+
+	seenAt := t.configSeenAt(digest)
+	servers := make([]*server, 0, len(t.servers))
+	transmissions := make([]*Transmission, 0, len(t.servers))
+	for _, s := range t.servers {
+		servers = append(servers, s)
+		transmissions = append(transmissions, &Transmission{ServerURL: s.url, ConfigDigest: digest, ConfigSeenAt: seenAt, SeqNr: seqNr, Req: req})
+	}
+	if err := t.orm.Insert(ctx, transmissions); err != nil {
+		for _, s := range servers {
+			s.transmitQueueInsertErrorCount.Inc()
+		}
+		return err
+	}
+
+	var merr error
+	var queued int
+	for i, s := range servers {
+		if ok := s.q.Push(transmissions[i]); !ok {
+			s.transmitQueuePushErrorCount.Inc()
+			merr = errors.Join(merr, fmt.Errorf("transmit queue for server %s is closed", s.url))
+			continue
+		}
+		queued++
+	}
+	if queued == 0 && merr != nil {
+		return merr
+	}
+	if merr != nil {
+		t.lggr.Errorw("Transmit failed for some servers", "err", merr, "seqNr", seqNr)
+	}
+	return nil
+}
+
+// configSeenAt returns when the job first transmitted a report of digest,
+// recording now if it has not yet. The time is truncated to the precision
+// of the database, so that it compares equal once loaded back.
+func (t *transmitter) configSeenAt(digest types.ConfigDigest) time.Time {
+	t.configsMu.Lock()
+	defer t.configsMu.Unlock()
+	seenAt, ok := t.configsSeenAt[digest]
+	if !ok {
+		seenAt = time.Now().UTC().Truncate(time.Microsecond)
+		t.configsSeenAt[digest] = seenAt
+	}
+	return seenAt
+}
+
+// start starts transmitting to the server, returning the persisted
+// transmissions it loaded.
+func (s *server) start(ctx context.Context) ([]*Transmission, error) {
+	s.lggr.Debugw("Loading transmit requests from database")
+	if err := s.pm.Start(ctx); err != nil {
+		return nil, err
+	}
+	transmissions, err := s.pm.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.q = mercury.NewQueue(s.lggr, transmitQueueLoad.WithLabelValues(s.url, fmt.Sprintf("%d", maxTransmitQueueSize)), maxTransmitQueueSize, transmissions, s.pm)
+
+	if err := s.rpcClient.Start(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.q.Start(ctx); err != nil {
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.runQueueLoop()
+	return transmissions, nil
+}
+
+func (s *server) close() error {
+	var merr error
+	if s.q != nil {
+		merr = errors.Join(merr, s.q.Close())
+	}
+	merr = errors.Join(merr, s.pm.Close())
+	close(s.stopCh)
+	s.wg.Wait()
+	return errors.Join(merr, s.rpcClient.Close())
+}
+
+func (s *server) runQueueLoop() {
+	defer s.wg.Done()
+	// Exponential backoff with very short retry interval (since latency is a priority)
+	// 5ms, 10ms, 20ms, 40ms etc
+	b := backoff.Backoff{
+		Min:    5 * time.Millisecond,
+		Max:    1 * time.Second,
+		Factor: 2,
+		Jitter: true,
+	}
+	runloopCtx, cancel := s.stopCh.Ctx(context.Background())
+	defer cancel()
+	for {
+		t := s.q.BlockingPop()
+		if t == nil {
+			// queue was closed
+			return
+		}
+		ctx, cancel := context.WithTimeout(runloopCtx, utils.WithJitter(transmitTimeout))
+		res, err := s.rpcClient.Transmit(ctx, t.Req)
+		cancel()
+		if runloopCtx.Err() != nil {
+			// runloop context is only canceled on transmitter close so we can
+			// exit the runloop here
+			return
+		} else if err != nil {
+			s.transmitConnectionErrorCount.Inc()
+			s.lggr.Errorw("Transmit report failed", "err", err, "seqNr", t.SeqNr, "digest", t.ConfigDigest)
+			if ok := s.q.Push(t); !ok {
+				s.lggr.Error("Failed to push report to transmit queue; queue is closed")
+				return
+			}
+			// Wait a backoff duration before pulling the most recent transmission
+			// the heap
+			select {
+			case <-time.After(b.Duration()):
+				continue
+			case <-s.stopCh:
+				return
+			}
+		}
+
+		b.Reset()
+		if res.Error == "" {
+			s.transmitSuccessCount.Inc()
+			s.lggr.Debugw("Transmit report success", "seqNr", t.SeqNr, "digest", t.ConfigDigest, "response", res)
+		} else {
+			// We don't need to retry here because the mercury server
+			// has confirmed it received the report. We only need to retry
+			// on networking/unknown errors
+			switch res.Code {
+			case DuplicateReport:
+				s.transmitSuccessCount.Inc()
+				s.transmitDuplicateCount.Inc()
+				s.lggr.Debugw("Transmit report success; duplicate report", "seqNr", t.SeqNr, "digest", t.ConfigDigest, "response", res)
+			default:
+				transmitServerErrorCount.WithLabelValues(s.url, fmt.Sprintf("%d", res.Code)).Inc()
+				s.lggr.Errorw("Transmit report failed; mercury server returned error", "response", res, "seqNr", t.SeqNr, "digest", t.ConfigDigest, "err", res.Error, "code", res.Code)
+			}
+		}
+
+		s.pm.AsyncDelete(t.Req)
+	}
 }
 
 func encodeEVM(digest types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []types.AttributedOnchainSignature) ([]byte, error) {
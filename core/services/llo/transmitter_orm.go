@@ -0,0 +1,205 @@
+package llo
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+// Transmission is a report awaiting transmission to a server.
+type Transmission struct {
+	ServerURL    string
+	ConfigDigest types.ConfigDigest
+	// ConfigSeenAt is when the job first transmitted a report of
+	// ConfigDigest. Sequence numbers restart with each config, so it orders
+	// transmissions of different configs.
+	ConfigSeenAt time.Time
+	SeqNr        uint64
+	Req          *pb.TransmitRequest
+}
+
+var _ mercury.QueueItem[*Transmission] = (*Transmission)(nil)
+
+func (t *Transmission) TransmitRequest() *pb.TransmitRequest { return t.Req }
+
+// Less reports whether t is of a later config than other, or of the same
+// config with a higher sequence number, so that the latest transmission is
+// popped first.
+func (t *Transmission) Less(other *Transmission) bool {
+	if !t.ConfigSeenAt.Equal(other.ConfigSeenAt) {
+		return t.ConfigSeenAt.After(other.ConfigSeenAt)
+	}
+	return t.SeqNr > other.SeqNr
+}
+
+// TransmitterORM persists the transmissions of a job until servers
+// acknowledge them.
+type TransmitterORM interface {
+	Insert(ctx context.Context, transmissions []*Transmission) error
+	Delete(ctx context.Context, serverURL string, reqs []*pb.TransmitRequest) error
+	Get(ctx context.Context, serverURL string) ([]*Transmission, error)
+	Prune(ctx context.Context, serverURL string, maxSize int) error
+}
+
+var _ TransmitterORM = &transmitterORM{}
+
+type transmitterORM struct {
+	q     pg.Queryer
+	jobID int32
+}
+
+func NewTransmitterORM(q pg.Queryer, jobID int32) TransmitterORM {
+	return &transmitterORM{q, jobID}
+}
+
+// Insert inserts the transmissions, ignoring those which already exist.
+func (o *transmitterORM) Insert(ctx context.Context, transmissions []*Transmission) error {
+	if len(transmissions) == 0 {
+		return nil
+	}
+
+	type row struct {
+		JobID        int32     `db:"job_id"`
+		ServerURL    string    `db:"server_url"`
+		PayloadHash  []byte    `db:"payload_hash"`
+		Payload      []byte    `db:"payload"`
+		ReportFormat uint32    `db:"report_format"`
+		ConfigDigest []byte    `db:"config_digest"`
+		ConfigSeenAt time.Time `db:"config_seen_at"`
+		SeqNr        int64     `db:"seq_nr"`
+	}
+	rows := make([]row, len(transmissions))
+	for i, t := range transmissions {
+		rows[i] = row{
+			JobID:        o.jobID,
+			ServerURL:    t.ServerURL,
+			PayloadHash:  hashPayload(t.Req.Payload),
+			Payload:      t.Req.Payload,
+			ReportFormat: t.Req.ReportFormat,
+			ConfigDigest: t.ConfigDigest[:],
+			ConfigSeenAt: t.ConfigSeenAt,
+			SeqNr:        int64(t.SeqNr),
+		}
+	}
+	_, err := sqlx.NamedExecContext(ctx, o.q, `
+INSERT INTO llo_mercury_transmit_queue (job_id, server_url, payload_hash, payload, report_format, config_digest, config_seen_at, seq_nr)
+VALUES (:job_id, :server_url, :payload_hash, :payload, :report_format, :config_digest, :config_seen_at, :seq_nr)
+ON CONFLICT (job_id, server_url, payload_hash) DO NOTHING
+`, rows)
+	if err != nil {
+		return fmt.Errorf("failed to insert transmissions: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the transmissions of reqs to serverURL, if they exist.
+func (o *transmitterORM) Delete(ctx context.Context, serverURL string, reqs []*pb.TransmitRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	var hashes pq.ByteaArray
+	for _, req := range reqs {
+		hashes = append(hashes, hashPayload(req.Payload))
+	}
+	_, err := o.q.ExecContext(ctx, `
+DELETE FROM llo_mercury_transmit_queue
+WHERE job_id = $1 AND server_url = $2 AND payload_hash = ANY($3)
+`, o.jobID, serverURL, hashes)
+	if err != nil {
+		return fmt.Errorf("failed to delete transmissions for server %s: %w", serverURL, err)
+	}
+	return nil
+}
+
+// Get returns the transmissions to serverURL, latest config and sequence
+// number first.
+func (o *transmitterORM) Get(ctx context.Context, serverURL string) ([]*Transmission, error) {
+	rows, err := o.q.QueryContext(ctx, `
+SELECT payload, report_format, config_digest, config_seen_at, seq_nr
+FROM llo_mercury_transmit_queue
+WHERE job_id = $1 AND server_url = $2
+ORDER BY config_seen_at DESC, seq_nr DESC
+`, o.jobID, serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transmissions for server %s: %w", serverURL, err)
+	}
+	defer rows.Close()
+
+	var transmissions []*Transmission
+	for rows.Next() {
+		t := &Transmission{ServerURL: serverURL, Req: &pb.TransmitRequest{}}
+		var digest []byte
+		var seenAt time.Time
+		var seqNr int64
+		if err := rows.Scan(&t.Req.Payload, &t.Req.ReportFormat, &digest, &seenAt, &seqNr); err != nil {
+			return nil, err
+		}
+		t.ConfigSeenAt = seenAt.UTC()
+		if t.ConfigDigest, err = types.BytesToConfigDigest(digest); err != nil {
+			return nil, err
+		}
+		t.SeqNr = uint64(seqNr)
+		transmissions = append(transmissions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transmissions, nil
+}
+
+// Prune keeps at most maxSize transmissions to serverURL, deleting those of
+// the oldest configs and sequence numbers.
+func (o *transmitterORM) Prune(ctx context.Context, serverURL string, maxSize int) error {
+	_, err := o.q.ExecContext(ctx, `
+DELETE FROM llo_mercury_transmit_queue
+WHERE job_id = $1 AND server_url = $2 AND
+payload_hash NOT IN (
+	SELECT payload_hash
+	FROM llo_mercury_transmit_queue
+	WHERE job_id = $1 AND server_url = $2
+	ORDER BY config_seen_at DESC, seq_nr DESC
+	LIMIT $3
+)
+`, o.jobID, serverURL, maxSize)
+	if err != nil {
+		return fmt.Errorf("failed to prune transmissions for server %s: %w", serverURL, err)
+	}
+	return nil
+}
+
+// serverTransmissionStore is the mercury.TransmissionStore of the
+// transmissions of a job to one server.
+type serverTransmissionStore struct {
+	orm       TransmitterORM
+	serverURL string
+}
+
+var _ mercury.TransmissionStore[*Transmission] = (*serverTransmissionStore)(nil)
+
+func (s *serverTransmissionStore) Delete(ctx context.Context, reqs []*pb.TransmitRequest) error {
+	return s.orm.Delete(ctx, s.serverURL, reqs)
+}
+
+func (s *serverTransmissionStore) Load(ctx context.Context) ([]*Transmission, error) {
+	return s.orm.Get(ctx, s.serverURL)
+}
+
+func (s *serverTransmissionStore) Prune(ctx context.Context, maxSize int) error {
+	return s.orm.Prune(ctx, s.serverURL, maxSize)
+}
+
+func hashPayload(payload []byte) []byte {
+	checksum := sha256.Sum256(payload)
+	return checksum[:]
+}
@@ -0,0 +1,84 @@
+package llo
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+func Test_TransmitterORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS llo_mercury_transmit_queue_job_id_fkey DEFERRED`)
+	jobID := rand.Int31() // foreign key constraints disabled so value doesn't matter
+	orm := NewTransmitterORM(db, jobID)
+	otherJobORM := NewTransmitterORM(db, jobID+1)
+	ctx := testutils.Context(t)
+
+	oldConfigSeenAt := time.Unix(1000, 0).UTC()
+	transmission := func(serverURL string, seqNr uint64) *Transmission {
+		return &Transmission{
+			ServerURL:    serverURL,
+			ConfigDigest: types.ConfigDigest{1, 2, 3},
+			ConfigSeenAt: oldConfigSeenAt,
+			SeqNr:        seqNr,
+			Req:          &pb.TransmitRequest{Payload: []byte{byte(seqNr)}, ReportFormat: 1},
+		}
+	}
+
+	require.NoError(t, orm.Insert(ctx, []*Transmission{transmission("server1", 1), transmission("server1", 3), transmission("server1", 2), transmission("server2", 1)}))
+	require.NoError(t, orm.Insert(ctx, []*Transmission{transmission("server1", 3)}), "duplicates are ignored")
+	require.NoError(t, otherJobORM.Insert(ctx, []*Transmission{transmission("server1", 4)}))
+
+	transmissions, err := orm.Get(ctx, "server1")
+	require.NoError(t, err)
+	require.Len(t, transmissions, 3)
+	assert.Equal(t, transmission("server1", 3), transmissions[0])
+	assert.Equal(t, uint64(2), transmissions[1].SeqNr)
+	assert.Equal(t, uint64(1), transmissions[2].SeqNr)
+
+	require.NoError(t, orm.Delete(ctx, "server1", []*pb.TransmitRequest{transmission("server1", 2).Req}))
+	transmissions, err = orm.Get(ctx, "server1")
+	require.NoError(t, err)
+	require.Len(t, transmissions, 2)
+
+	require.NoError(t, orm.Prune(ctx, "server1", 1))
+	transmissions, err = orm.Get(ctx, "server1")
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	assert.Equal(t, uint64(3), transmissions[0].SeqNr)
+
+	transmissions, err = orm.Get(ctx, "server2")
+	require.NoError(t, err)
+	assert.Len(t, transmissions, 1, "other servers are unaffected")
+	transmissions, err = otherJobORM.Get(ctx, "server1")
+	require.NoError(t, err)
+	assert.Len(t, transmissions, 1, "other jobs are unaffected")
+
+	newConfig := &Transmission{
+		ServerURL:    "server1",
+		ConfigDigest: types.ConfigDigest{4, 5, 6},
+		ConfigSeenAt: oldConfigSeenAt.Add(time.Hour),
+		SeqNr:        1,
+		Req:          &pb.TransmitRequest{Payload: []byte("new config"), ReportFormat: 1},
+	}
+	require.NoError(t, orm.Insert(ctx, []*Transmission{newConfig}))
+	transmissions, err = orm.Get(ctx, "server1")
+	require.NoError(t, err)
+	require.Len(t, transmissions, 2)
+	assert.Equal(t, newConfig, transmissions[0], "transmissions of a later config come first despite their lower sequence numbers")
+
+	require.NoError(t, orm.Prune(ctx, "server1", 1))
+	transmissions, err = orm.Get(ctx, "server1")
+	require.NoError(t, err)
+	require.Len(t, transmissions, 1)
+	assert.Equal(t, newConfig, transmissions[0], "transmissions of older configs are pruned first")
+}
@@ -1,7 +1,229 @@
 package llo
 
-import "testing"
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
+)
+
+type fakeTransmitterORM struct {
+	mu            sync.Mutex
+	transmissions map[string][]*Transmission
+	insertErr     error
+}
+
+var _ TransmitterORM = (*fakeTransmitterORM)(nil)
+
+func newFakeTransmitterORM() *fakeTransmitterORM {
+	return &fakeTransmitterORM{transmissions: make(map[string][]*Transmission)}
+}
+
+func (o *fakeTransmitterORM) Insert(_ context.Context, transmissions []*Transmission) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.insertErr != nil {
+		return o.insertErr
+	}
+	for _, t := range transmissions {
+		o.transmissions[t.ServerURL] = append(o.transmissions[t.ServerURL], t)
+	}
+	return nil
+}
+
+func (o *fakeTransmitterORM) Delete(_ context.Context, serverURL string, reqs []*pb.TransmitRequest) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var remaining []*Transmission
+	for _, t := range o.transmissions[serverURL] {
+		deleted := false
+		for _, req := range reqs {
+			if string(req.Payload) == string(t.Req.Payload) {
+				deleted = true
+			}
+		}
+		if !deleted {
+			remaining = append(remaining, t)
+		}
+	}
+	o.transmissions[serverURL] = remaining
+	return nil
+}
+
+func (o *fakeTransmitterORM) Get(_ context.Context, serverURL string) ([]*Transmission, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	transmissions := append([]*Transmission{}, o.transmissions[serverURL]...)
+	sort.Slice(transmissions, func(i, j int) bool { return transmissions[i].Less(transmissions[j]) })
+	return transmissions, nil
+}
+
+func (o *fakeTransmitterORM) Prune(context.Context, string, int) error { return nil }
+
+func (o *fakeTransmitterORM) count(serverURL string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.transmissions[serverURL])
+}
+
+func Test_Transmission_Less(t *testing.T) {
+	oldConfig := time.Unix(1000, 0).UTC()
+	newConfig := time.Unix(2000, 0).UTC()
+	transmission := func(seenAt time.Time, seqNr uint64) *Transmission {
+		return &Transmission{ConfigSeenAt: seenAt, SeqNr: seqNr, Req: &pb.TransmitRequest{Payload: []byte{byte(seqNr)}}}
+	}
+
+	t.Run("orders transmissions of a config by sequence number", func(t *testing.T) {
+		q := mercury.NewQueue(logger.TestLogger(t), transmitQueueLoad.WithLabelValues("server", "0"), 0, []*Transmission{transmission(oldConfig, 2), transmission(oldConfig, 5)}, nil)
+		require.True(t, q.Push(transmission(oldConfig, 3)))
+		require.True(t, q.Push(transmission(oldConfig, 9)))
+
+		var seqNrs []uint64
+		for !q.IsEmpty() {
+			seqNrs = append(seqNrs, q.BlockingPop().SeqNr)
+		}
+		assert.Equal(t, []uint64{9, 5, 3, 2}, seqNrs, "the latest transmission is popped first")
+	})
+
+	t.Run("orders transmissions of a later config first", func(t *testing.T) {
+		q := mercury.NewQueue(logger.TestLogger(t), transmitQueueLoad.WithLabelValues("server", "0"), 0, []*Transmission{transmission(oldConfig, 100), transmission(newConfig, 1)}, nil)
+		require.True(t, q.Push(transmission(newConfig, 2)))
+		require.True(t, q.Push(transmission(oldConfig, 101)))
+
+		var seqNrs []uint64
+		for !q.IsEmpty() {
+			seqNrs = append(seqNrs, q.BlockingPop().SeqNr)
+		}
+		assert.Equal(t, []uint64{2, 1, 101, 100}, seqNrs, "sequence numbers restart with a new config")
+	})
+}
 
 func Test_Transmitter(t *testing.T) {
-	// TODO: https://smartcontract-it.atlassian.net/browse/MERC-3659
+	lggr := logger.TestLogger(t)
+	oldFlushDeletesFrequency := flushDeletesFrequency
+	flushDeletesFrequency = 10 * time.Millisecond
+	t.Cleanup(func() { flushDeletesFrequency = oldFlushDeletesFrequency })
+
+	report := ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+		Report: types.Report{1, 2, 3},
+		Info:   llotypes.ReportInfo{ReportFormat: llotypes.ReportFormatEVM},
+	}
+
+	t.Run("transmits to all servers and deletes acknowledged reports", func(t *testing.T) {
+		orm := newFakeTransmitterORM()
+		var calls1, calls2 atomic.Int32
+		clients := map[string]wsrpc.Client{
+			"server1": mocks.MockWSRPCClient{TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				calls1.Add(1)
+				return &pb.TransmitResponse{}, nil
+			}},
+			"server2": mocks.MockWSRPCClient{TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				// Connection errors are retried; duplicates count as acknowledged.
+				if calls2.Add(1) == 1 {
+					return nil, errors.New("connection error")
+				}
+				return &pb.TransmitResponse{Code: DuplicateReport, Error: "duplicate"}, nil
+			}},
+		}
+		tr := NewTransmitter(lggr, clients, ed25519.PublicKey("pubkey"), orm)
+		require.NoError(t, tr.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, tr.Close()) })
+
+		require.NoError(t, tr.Transmit(testutils.Context(t), types.ConfigDigest{1}, 42, report, nil))
+
+		require.Eventually(t, func() bool { return calls1.Load() == 1 && calls2.Load() == 2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		require.Eventually(t, func() bool { return orm.count("server1") == 0 && orm.count("server2") == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, int32(1), calls1.Load(), "acknowledged reports are not retried")
+	})
+
+	t.Run("transmits persisted reports on start", func(t *testing.T) {
+		orm := newFakeTransmitterORM()
+		req := &pb.TransmitRequest{Payload: []byte("persisted")}
+		require.NoError(t, orm.Insert(testutils.Context(t), []*Transmission{{ServerURL: "server1", SeqNr: 1, Req: req}}))
+
+		transmitted := make(chan *pb.TransmitRequest, 1)
+		clients := map[string]wsrpc.Client{
+			"server1": mocks.MockWSRPCClient{TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				transmitted <- in
+				return &pb.TransmitResponse{}, nil
+			}},
+		}
+		tr := NewTransmitter(lggr, clients, ed25519.PublicKey("pubkey"), orm)
+		require.NoError(t, tr.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, tr.Close()) })
+
+		select {
+		case in := <-transmitted:
+			assert.Equal(t, req.Payload, in.Payload)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("persisted report was not transmitted")
+		}
+		require.Eventually(t, func() bool { return orm.count("server1") == 0 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("keeps the time configs of persisted reports were first seen", func(t *testing.T) {
+		orm := newFakeTransmitterORM()
+		seenAt := time.Unix(1000, 0).UTC()
+		req := &pb.TransmitRequest{Payload: []byte("persisted")}
+		require.NoError(t, orm.Insert(testutils.Context(t), []*Transmission{{ServerURL: "server1", ConfigDigest: types.ConfigDigest{1}, ConfigSeenAt: seenAt, SeqNr: 1, Req: req}}))
+
+		clients := map[string]wsrpc.Client{
+			"server1": mocks.MockWSRPCClient{TransmitF: func(ctx context.Context, in *pb.TransmitRequest) (*pb.TransmitResponse, error) {
+				return nil, errors.New("connection error")
+			}},
+		}
+		tr := NewTransmitter(lggr, clients, ed25519.PublicKey("pubkey"), orm)
+		require.NoError(t, tr.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, tr.Close()) })
+
+		require.NoError(t, tr.Transmit(testutils.Context(t), types.ConfigDigest{1}, 2, report, nil))
+		require.NoError(t, tr.Transmit(testutils.Context(t), types.ConfigDigest{2}, 1, report, nil))
+
+		transmissions, err := orm.Get(testutils.Context(t), "server1")
+		require.NoError(t, err)
+		require.Len(t, transmissions, 3)
+		assert.Equal(t, types.ConfigDigest{2}, transmissions[0].ConfigDigest, "a new config is latest")
+		assert.True(t, transmissions[0].ConfigSeenAt.After(seenAt))
+		assert.Equal(t, uint64(2), transmissions[1].SeqNr)
+		assert.Equal(t, seenAt, transmissions[1].ConfigSeenAt)
+	})
+
+	t.Run("returns error if the report cannot be persisted", func(t *testing.T) {
+		orm := newFakeTransmitterORM()
+		orm.insertErr = errors.New("db is down")
+		clients := map[string]wsrpc.Client{
+			"server1": mocks.MockWSRPCClient{},
+		}
+		tr := NewTransmitter(lggr, clients, ed25519.PublicKey("pubkey"), orm)
+		require.NoError(t, tr.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, tr.Close()) })
+
+		err := tr.Transmit(testutils.Context(t), types.ConfigDigest{1}, 42, report, nil)
+		assert.EqualError(t, err, "db is down")
+	})
+
+	t.Run("rejects unsupported report formats", func(t *testing.T) {
+		tr := NewTransmitter(lggr, map[string]wsrpc.Client{}, ed25519.PublicKey("pubkey"), newFakeTransmitterORM())
+		err := tr.Transmit(testutils.Context(t), types.ConfigDigest{1}, 42, ocr3types.ReportWithInfo[llotypes.ReportInfo]{Info: llotypes.ReportInfo{ReportFormat: 42}}, nil)
+		assert.ErrorContains(t, err, "unsupported report format")
+	})
 }
@@ -65,6 +65,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2vrf/reasonablegasprice"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2vrf/reportserializer"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/promwrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
@@ -114,6 +115,7 @@ type Delegate struct {
 	mercuryORM            evmmercury.ORM
 	pipelineRunner        pipeline.Runner
 	streamRegistry        streams.Getter
+	observationCache      *ocrcommon.ObservationCache
 	peerWrapper           *ocrcommon.SingletonPeerWrapper
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator
 	cfg                   DelegateConfig
@@ -128,6 +130,7 @@ type Delegate struct {
 
 	legacyChains         legacyevm.LegacyChainContainer // legacy: use relayers instead
 	capabilitiesRegistry types.CapabilitiesRegistry
+	decryptionQueues     *threshold.DecryptionQueues
 }
 
 type DelegateConfig interface {
@@ -200,6 +203,7 @@ type mercuryConfig interface {
 	Credentials(credName string) *types.MercuryCredentials
 	Cache() coreconfig.MercuryCache
 	TLS() coreconfig.MercuryTLS
+	Streams() coreconfig.MercuryStreams
 }
 
 type thresholdConfig interface {
@@ -239,6 +243,7 @@ func NewDelegate(
 	relayers RelayGetter,
 	mailMon *mailbox.Monitor,
 	capabilitiesRegistry types.CapabilitiesRegistry,
+	decryptionQueues *threshold.DecryptionQueues,
 ) *Delegate {
 	return &Delegate{
 		db:                    db,
@@ -247,6 +252,7 @@ func NewDelegate(
 		mercuryORM:            mercuryORM,
 		pipelineRunner:        pipelineRunner,
 		streamRegistry:        streamRegistry,
+		observationCache:      ocrcommon.NewObservationCache(),
 		peerWrapper:           peerWrapper,
 		monitoringEndpointGen: monitoringEndpointGen,
 		legacyChains:          legacyChains,
@@ -260,6 +266,7 @@ func NewDelegate(
 		isNewlyCreatedJob:     false,
 		mailMon:               mailMon,
 		capabilitiesRegistry:  capabilitiesRegistry,
+		decryptionQueues:      decryptionQueues,
 	}
 }
 
@@ -962,7 +969,7 @@ func (d *Delegate) newServicesMedian(
 		return nil, ErrRelayNotEnabled{Err: err, PluginName: "median", Relay: spec.Relay}
 	}
 
-	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, d.pipelineRunner, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog)
+	medianServices, err2 := median.NewMedianServices(ctx, jb, d.isNewlyCreatedJob, relayer, d.pipelineRunner, d.streamRegistry, d.observationCache, lggr, oracleArgsNoPlugin, mConfig, enhancedTelemChan, errorLog)
 
 	if ocrcommon.ShouldCollectEnhancedTelemetry(&jb) {
 		enhancedTelemService := ocrcommon.NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), d.monitoringEndpointGen.GenMonitoringEndpoint(rid.Network, rid.ChainID, spec.ContractID, synchronization.EnhancedEA), lggr.Named("EnhancedTelemetry"))
@@ -1660,6 +1667,7 @@ func (d *Delegate) newServicesOCR2Functions(
 		EthKeystore:       d.ethKs,
 		ThresholdKeyShare: thresholdKeyShare,
 		LogPollerWrapper:  functionsProvider.LogPollerWrapper(),
+		DecryptionQueues:  d.decryptionQueues,
 	}
 
 	functionsServices, err := functions.NewFunctionsServices(ctx, &functionsOracleArgs, &thresholdOracleArgs, &s4OracleArgs, &functionsServicesConfig)
@@ -4,7 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math/big"
+	"reflect"
 	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/encoding"
@@ -14,7 +19,55 @@ func CanAggregate(N int, F int, observations []*encoding.ProcessedRequest) bool
 	return N > 0 && F >= 0 && len(observations) > 0 && len(observations) <= N && len(observations) >= 2*F+1
 }
 
-func Aggregate(aggMethod config.AggregationMethod, observations []*encoding.ProcessedRequest) (*encoding.ProcessedRequest, error) {
+// AggregationOptions parameterize the aggregation methods which interpret
+// results as ABI-encoded values.
+type AggregationOptions struct {
+	// ResultTypes are the ABI types of the fields of results.
+	ResultTypes abi.Arguments
+	// TrimPercentage is the percentage of the lowest and of the highest values
+	// discarded by AGGREGATION_TRIMMED_MEAN.
+	TrimPercentage uint32
+	// F is the maximum number of faulty oracles. Field-wise aggregation
+	// requires F+1 results which can be decoded, so that at least one of them
+	// comes from an honest oracle.
+	F int
+}
+
+// NewAggregationOptions returns the options of the default aggregation method
+// of a reporting plugin config for a DON tolerating f faulty oracles, or an
+// error if they are invalid for it.
+func NewAggregationOptions(cfg *config.ReportingPluginConfig, f int) (AggregationOptions, error) {
+	aggMethod := cfg.GetDefaultAggregationMethod()
+	resultTypes := cfg.GetAggregationResultTypes()
+	switch aggMethod {
+	case config.AggregationMethod_AGGREGATION_TRIMMED_MEAN:
+		if len(resultTypes) == 0 {
+			resultTypes = []string{"uint256"}
+		}
+	case config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN:
+		if len(resultTypes) == 0 {
+			return AggregationOptions{}, fmt.Errorf("%s requires aggregationResultTypes", aggMethod)
+		}
+	}
+	if cfg.GetAggregationTrimPercentage() >= 50 {
+		return AggregationOptions{}, fmt.Errorf("aggregationTrimPercentage must be below 50, got %d", cfg.GetAggregationTrimPercentage())
+	}
+
+	args := make(abi.Arguments, len(resultTypes))
+	for i, resultType := range resultTypes {
+		typ, err := abi.NewType(resultType, "", nil)
+		if err != nil {
+			return AggregationOptions{}, fmt.Errorf("invalid aggregation result type %q: %w", resultType, err)
+		}
+		if aggMethod == config.AggregationMethod_AGGREGATION_TRIMMED_MEAN && !isIntegerType(typ) {
+			return AggregationOptions{}, fmt.Errorf("%s requires integer result types, got %q", aggMethod, resultType)
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return AggregationOptions{ResultTypes: args, TrimPercentage: cfg.GetAggregationTrimPercentage(), F: f}, nil
+}
+
+func Aggregate(aggMethod config.AggregationMethod, opts AggregationOptions, observations []*encoding.ProcessedRequest) (*encoding.ProcessedRequest, error) {
 	if len(observations) == 0 {
 		return nil, fmt.Errorf("empty observation list passed for aggregation")
 	}
@@ -56,11 +109,26 @@ func Aggregate(aggMethod config.AggregationMethod, observations []*encoding.Proc
 		// Errors are always aggregated using MODE method
 		finalResult.Error = aggregateMode(rawData)
 	} else {
+		finalResult.AggregationMethod = uint32(aggMethod)
 		switch aggMethod {
 		case config.AggregationMethod_AGGREGATION_MODE:
 			finalResult.Result = aggregateMode(rawData)
 		case config.AggregationMethod_AGGREGATION_MEDIAN:
 			finalResult.Result = aggregateMedian(rawData)
+		case config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN:
+			result, err := aggregateFieldwise(aggMethod, opts, rawData)
+			if err != nil {
+				finalResult.Error = []byte(err.Error())
+			} else {
+				finalResult.Result = result
+			}
+		case config.AggregationMethod_AGGREGATION_ALL_EQUAL:
+			// Errored observations are results which are not equal to the others
+			if len(errored) > 0 || !allEqual(rawData) {
+				finalResult.Error = []byte("aggregation failed: results of nodes are not all equal")
+			} else {
+				finalResult.Result = rawData[0]
+			}
 		default:
 			return nil, fmt.Errorf("unsupported aggregation method: %s", aggMethod)
 		}
@@ -117,3 +185,121 @@ func aggregateMedian(items [][]byte) []byte {
 	})
 	return items[(len(items)-1)/2]
 }
+
+func allEqual(items [][]byte) bool {
+	for _, item := range items[1:] {
+		if !bytes.Equal(item, items[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// aggregateFieldwise decodes results as values of opts.ResultTypes, and
+// aggregates each field separately: integers with a trimmed mean or a median,
+// and other types using MODE method. Results which can't be decoded are
+// ignored, but at least opts.F+1 results must be decoded.
+func aggregateFieldwise(aggMethod config.AggregationMethod, opts AggregationOptions, items [][]byte) ([]byte, error) {
+	if len(opts.ResultTypes) == 0 {
+		return nil, fmt.Errorf("aggregation failed: no result types configured for %s", aggMethod)
+	}
+	var decoded [][]interface{}
+	for _, item := range items {
+		values, err := opts.ResultTypes.UnpackValues(item)
+		if err != nil {
+			continue
+		}
+		decoded = append(decoded, values)
+	}
+	if len(decoded) < opts.F+1 {
+		return nil, fmt.Errorf("aggregation failed: %d results could be decoded as %s, at least %d required", len(decoded), resultTypesString(opts.ResultTypes), opts.F+1)
+	}
+
+	aggregated := make([]interface{}, len(opts.ResultTypes))
+	for i, arg := range opts.ResultTypes {
+		if !isIntegerType(arg.Type) {
+			aggregated[i] = aggregateModeValues(arg, decoded, i)
+			continue
+		}
+		ints := make([]*big.Int, len(decoded))
+		for j, values := range decoded {
+			ints[j] = toBigInt(values[i])
+		}
+		sort.Slice(ints, func(a, b int) bool { return ints[a].Cmp(ints[b]) < 0 })
+		var value *big.Int
+		if aggMethod == config.AggregationMethod_AGGREGATION_TRIMMED_MEAN {
+			value = trimmedMean(ints, opts.TrimPercentage)
+		} else {
+			value = ints[(len(ints)-1)/2]
+		}
+		aggregated[i] = fromBigInt(arg.Type, value)
+	}
+	return opts.ResultTypes.Pack(aggregated...)
+}
+
+// trimmedMean returns the mean of sorted values without trimPercentage of the
+// lowest and of the highest ones, rounded towards zero.
+func trimmedMean(sorted []*big.Int, trimPercentage uint32) *big.Int {
+	trim := len(sorted) * int(trimPercentage) / 100
+	kept := sorted[trim : len(sorted)-trim]
+	sum := new(big.Int)
+	for _, v := range kept {
+		sum.Add(sum, v)
+	}
+	return sum.Quo(sum, big.NewInt(int64(len(kept))))
+}
+
+func aggregateModeValues(arg abi.Argument, decoded [][]interface{}, field int) interface{} {
+	encoded := make([][]byte, len(decoded))
+	for j, values := range decoded {
+		// values were decoded as arg, so they can be encoded as arg
+		encoded[j], _ = abi.Arguments{arg}.Pack(values[field])
+	}
+	mode := aggregateMode(encoded)
+	for j, e := range encoded {
+		if bytes.Equal(e, mode) {
+			return decoded[j][field]
+		}
+	}
+	return decoded[0][field]
+}
+
+func isIntegerType(typ abi.Type) bool {
+	return typ.T == abi.IntTy || typ.T == abi.UintTy
+}
+
+// toBigInt converts an integer decoded by go-ethereum, which is a *big.Int or a
+// native integer type depending on its size, to a *big.Int.
+func toBigInt(v interface{}) *big.Int {
+	if b, ok := v.(*big.Int); ok {
+		return new(big.Int).Set(b)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.CanInt() {
+		return big.NewInt(rv.Int())
+	}
+	return new(big.Int).SetUint64(rv.Uint())
+}
+
+// fromBigInt converts v to the Go type go-ethereum encodes as typ.
+func fromBigInt(typ abi.Type, v *big.Int) interface{} {
+	goType := typ.GetType()
+	if goType == reflect.TypeOf(&big.Int{}) {
+		return v
+	}
+	rv := reflect.New(goType).Elem()
+	if rv.CanInt() {
+		rv.SetInt(v.Int64())
+	} else {
+		rv.SetUint(v.Uint64())
+	}
+	return rv.Interface()
+}
+
+func resultTypesString(args abi.Arguments) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type.String()
+	}
+	return fmt.Sprintf("(%s)", strings.Join(types, ","))
+}
@@ -1,6 +1,7 @@
 package functions_test
 
 import (
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -37,6 +38,11 @@ func reqMeta(id int, result []byte, err []byte, callbackGas uint32, coordinatorC
 	}
 }
 
+func aggregatedWith(method config.AggregationMethod, r *encoding.ProcessedRequest) *encoding.ProcessedRequest {
+	r.AggregationMethod = uint32(method)
+	return r
+}
+
 func TestCanAggregate(t *testing.T) {
 	t.Parallel()
 	obs := make([]*encoding.ProcessedRequest, 10)
@@ -93,7 +99,7 @@ func TestAggregate_Successful(t *testing.T) {
 				reqS(21, "19", ""),
 				reqS(21, "10", ""),
 			},
-			reqS(21, "10", ""),
+			aggregatedWith(config.AggregationMethod_AGGREGATION_MEDIAN, reqS(21, "10", "")),
 		},
 		{
 			"Median Even",
@@ -104,7 +110,7 @@ func TestAggregate_Successful(t *testing.T) {
 				req(21, []byte{5, 100}, []byte{}),
 				req(21, []byte{12, 2}, []byte{}),
 			},
-			req(21, []byte{9, 11}, []byte{}),
+			aggregatedWith(config.AggregationMethod_AGGREGATION_MEDIAN, req(21, []byte{9, 11}, []byte{})),
 		},
 		{
 			"Median Even Aligned",
@@ -115,7 +121,7 @@ func TestAggregate_Successful(t *testing.T) {
 				req(21, []byte{0, 0, 5, 100}, []byte{}),
 				req(21, []byte{0, 0, 12, 2}, []byte{}),
 			},
-			req(21, []byte{0, 0, 9, 11}, []byte{}),
+			aggregatedWith(config.AggregationMethod_AGGREGATION_MEDIAN, req(21, []byte{0, 0, 9, 11}, []byte{})),
 		},
 		{
 			"Metadata With Results",
@@ -126,7 +132,7 @@ func TestAggregate_Successful(t *testing.T) {
 				reqMeta(21, []byte{1}, []byte{}, 100, []byte{0}, []byte{4}),
 				reqMeta(21, []byte{1}, []byte{}, 100, []byte{2}, []byte{1}),
 			},
-			reqMeta(21, []byte{1}, []byte{}, 100, []byte{2}, []byte{4}),
+			aggregatedWith(config.AggregationMethod_AGGREGATION_MEDIAN, reqMeta(21, []byte{1}, []byte{}, 100, []byte{2}, []byte{4})),
 		},
 		{
 			"Metadata With Errors",
@@ -143,9 +149,127 @@ func TestAggregate_Successful(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			result, err := functions.Aggregate(test.mode, test.input)
+			result, err := functions.Aggregate(test.mode, functions.AggregationOptions{}, test.input)
 			require.NoError(t, err)
 			require.Equal(t, test.expected, result)
 		})
 	}
 }
+
+func abiEncode(t *testing.T, opts functions.AggregationOptions, values ...interface{}) []byte {
+	encoded, err := opts.ResultTypes.Pack(values...)
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestNewAggregationOptions(t *testing.T) {
+	t.Parallel()
+
+	opts, err := functions.NewAggregationOptions(&config.ReportingPluginConfig{}, 0)
+	require.NoError(t, err)
+	require.Empty(t, opts.ResultTypes)
+
+	opts, err = functions.NewAggregationOptions(&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, AggregationTrimPercentage: 20}, 1)
+	require.NoError(t, err)
+	require.Len(t, opts.ResultTypes, 1)
+	require.Equal(t, "uint256", opts.ResultTypes[0].Type.String())
+	require.Equal(t, uint32(20), opts.TrimPercentage)
+	require.Equal(t, 1, opts.F)
+
+	opts, err = functions.NewAggregationOptions(&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN, AggregationResultTypes: []string{"int256", "address", "uint8"}}, 0)
+	require.NoError(t, err)
+	require.Len(t, opts.ResultTypes, 3)
+
+	for _, tc := range []struct {
+		cfg *config.ReportingPluginConfig
+		err string
+	}{
+		{&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN}, "AGGREGATION_FIELDWISE_MEDIAN requires aggregationResultTypes"},
+		{&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, AggregationResultTypes: []string{"string"}}, `AGGREGATION_TRIMMED_MEAN requires integer result types, got "string"`},
+		{&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, AggregationTrimPercentage: 50}, "aggregationTrimPercentage must be below 50, got 50"},
+		{&config.ReportingPluginConfig{DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN, AggregationResultTypes: []string{"uint"}}, `invalid aggregation result type "uint"`},
+	} {
+		_, err = functions.NewAggregationOptions(tc.cfg, 0)
+		require.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestAggregate_TrimmedMean(t *testing.T) {
+	t.Parallel()
+
+	opts, err := functions.NewAggregationOptions(&config.ReportingPluginConfig{
+		DefaultAggregationMethod:  config.AggregationMethod_AGGREGATION_TRIMMED_MEAN,
+		AggregationResultTypes:    []string{"uint256", "int8"},
+		AggregationTrimPercentage: 20,
+	}, 1)
+	require.NoError(t, err)
+
+	result, err := functions.Aggregate(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, opts, []*encoding.ProcessedRequest{
+		req(21, abiEncode(t, opts, big.NewInt(1000000), int8(-100)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(100), int8(-3)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(102), int8(-4)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(103), int8(-4)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(0), int8(100)), []byte{}),
+		req(21, []byte("not ABI-encoded"), []byte{}),
+	})
+	require.NoError(t, err)
+	// 5 decoded results, trimming one on each side
+	require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, req(21, abiEncode(t, opts, big.NewInt(101), int8(-3)), []byte{})), result)
+
+	result, err = functions.Aggregate(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, opts, []*encoding.ProcessedRequest{
+		reqS(21, "a", ""),
+		reqS(21, "b", ""),
+		reqS(21, "c", ""),
+	})
+	require.NoError(t, err)
+	require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, reqS(21, "", "aggregation failed: 0 results could be decoded as (uint256,int8), at least 2 required")), result)
+
+	// a single decoded result may come from a faulty oracle
+	result, err = functions.Aggregate(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, opts, []*encoding.ProcessedRequest{
+		req(21, abiEncode(t, opts, big.NewInt(1000000), int8(-100)), []byte{}),
+		reqS(21, "b", ""),
+		reqS(21, "c", ""),
+	})
+	require.NoError(t, err)
+	require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_TRIMMED_MEAN, reqS(21, "", "aggregation failed: 1 results could be decoded as (uint256,int8), at least 2 required")), result)
+}
+
+func TestAggregate_FieldwiseMedian(t *testing.T) {
+	t.Parallel()
+
+	opts, err := functions.NewAggregationOptions(&config.ReportingPluginConfig{
+		DefaultAggregationMethod: config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN,
+		AggregationResultTypes:   []string{"int256", "string", "uint32"},
+	}, 1)
+	require.NoError(t, err)
+
+	result, err := functions.Aggregate(config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN, opts, []*encoding.ProcessedRequest{
+		req(21, abiEncode(t, opts, big.NewInt(-5), "USD", uint32(7)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(10), "EUR", uint32(1)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(3), "USD", uint32(9)), []byte{}),
+		req(21, abiEncode(t, opts, big.NewInt(4), "USD", uint32(2)), []byte{}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN, req(21, abiEncode(t, opts, big.NewInt(3), "USD", uint32(2)), []byte{})), result)
+}
+
+func TestAggregate_AllEqual(t *testing.T) {
+	t.Parallel()
+
+	result, err := functions.Aggregate(config.AggregationMethod_AGGREGATION_ALL_EQUAL, functions.AggregationOptions{}, []*encoding.ProcessedRequest{
+		reqS(21, "abcd", ""),
+		reqS(21, "abcd", ""),
+		reqS(21, "abcd", ""),
+	})
+	require.NoError(t, err)
+	require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_ALL_EQUAL, reqS(21, "abcd", "")), result)
+
+	for _, input := range [][]*encoding.ProcessedRequest{
+		{reqS(21, "abcd", ""), reqS(21, "abcd", ""), reqS(21, "abce", "")},
+		{reqS(21, "abcd", ""), reqS(21, "abcd", ""), reqS(21, "", "timeout")},
+	} {
+		result, err = functions.Aggregate(config.AggregationMethod_AGGREGATION_ALL_EQUAL, functions.AggregationOptions{}, input)
+		require.NoError(t, err)
+		require.Equal(t, aggregatedWith(config.AggregationMethod_AGGREGATION_ALL_EQUAL, reqS(21, "", "aggregation failed: results of nodes are not all equal")), result)
+	}
+}
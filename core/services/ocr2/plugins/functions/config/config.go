@@ -57,6 +57,7 @@ type DecryptionQueueConfig struct {
 	MaxCiphertextIdLength    uint32 `json:"maxCiphertextIdLength"`
 	CompletedCacheTimeoutSec uint32 `json:"completedCacheTimeoutSec"`
 	DecryptRequestTimeoutSec uint32 `json:"decryptRequestTimeoutSec"`
+	PersistPendingRequests   bool   `json:"persistPendingRequests"` // Keep pending ciphertexts in the DB so that they are restored after a restart
 }
 
 func ValidatePluginConfig(config PluginConfig) error {
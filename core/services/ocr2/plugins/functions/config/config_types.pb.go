@@ -25,6 +25,14 @@ type AggregationMethod int32
 const (
 	AggregationMethod_AGGREGATION_MODE   AggregationMethod = 0
 	AggregationMethod_AGGREGATION_MEDIAN AggregationMethod = 1
+	// Mean of integer results after discarding aggregationTrimPercentage of the
+	// lowest and of the highest ones, computed for each field of aggregationResultTypes.
+	AggregationMethod_AGGREGATION_TRIMMED_MEAN AggregationMethod = 2
+	// Median of each field of ABI-encoded tuple results of aggregationResultTypes.
+	// Fields which are not integers are aggregated using MODE method.
+	AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN AggregationMethod = 3
+	// All results must be equal, otherwise the request fails with an error.
+	AggregationMethod_AGGREGATION_ALL_EQUAL AggregationMethod = 4
 )
 
 // Enum value maps for AggregationMethod.
@@ -32,10 +40,16 @@ var (
 	AggregationMethod_name = map[int32]string{
 		0: "AGGREGATION_MODE",
 		1: "AGGREGATION_MEDIAN",
+		2: "AGGREGATION_TRIMMED_MEAN",
+		3: "AGGREGATION_FIELDWISE_MEDIAN",
+		4: "AGGREGATION_ALL_EQUAL",
 	}
 	AggregationMethod_value = map[string]int32{
-		"AGGREGATION_MODE":   0,
-		"AGGREGATION_MEDIAN": 1,
+		"AGGREGATION_MODE":             0,
+		"AGGREGATION_MEDIAN":           1,
+		"AGGREGATION_TRIMMED_MEAN":     2,
+		"AGGREGATION_FIELDWISE_MEDIAN": 3,
+		"AGGREGATION_ALL_EQUAL":        4,
 	}
 )
 
@@ -273,6 +287,11 @@ type ReportingPluginConfig struct {
 	// Needs to be set in tandem with gas estimator (e.g. [EVM.GasEstimator.LimitJobType] OCR = <limit>)
 	// otherwise the report won't go through TX Manager or fail later.
 	MaxReportTotalCallbackGas uint32 `protobuf:"varint,9,opt,name=maxReportTotalCallbackGas,proto3" json:"maxReportTotalCallbackGas,omitempty"`
+	// ABI types of the fields of results, e.g. ["uint256", "int256"], used by
+	// AGGREGATION_TRIMMED_MEAN (defaults to ["uint256"]) and AGGREGATION_FIELDWISE_MEDIAN.
+	AggregationResultTypes []string `protobuf:"bytes,10,rep,name=aggregationResultTypes,proto3" json:"aggregationResultTypes,omitempty"`
+	// Percentage of the lowest and of the highest results discarded by AGGREGATION_TRIMMED_MEAN. Must be below 50.
+	AggregationTrimPercentage uint32 `protobuf:"varint,11,opt,name=aggregationTrimPercentage,proto3" json:"aggregationTrimPercentage,omitempty"`
 }
 
 func (x *ReportingPluginConfig) Reset() {
@@ -370,6 +389,20 @@ func (x *ReportingPluginConfig) GetMaxReportTotalCallbackGas() uint32 {
 	return 0
 }
 
+func (x *ReportingPluginConfig) GetAggregationResultTypes() []string {
+	if x != nil {
+		return x.AggregationResultTypes
+	}
+	return nil
+}
+
+func (x *ReportingPluginConfig) GetAggregationTrimPercentage() uint32 {
+	if x != nil {
+		return x.AggregationTrimPercentage
+	}
+	return 0
+}
+
 var File_core_services_ocr2_plugins_functions_config_config_types_proto protoreflect.FileDescriptor
 
 var file_core_services_ocr2_plugins_functions_config_config_types_proto_rawDesc = []byte{
@@ -428,7 +461,7 @@ var file_core_services_ocr2_plugins_functions_config_config_types_proto_rawDesc
 	0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
 	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x17, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xff, 0x04, 0x0a, 0x15, 0x52, 0x65, 0x70,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf5, 0x05, 0x0a, 0x15, 0x52, 0x65, 0x70,
 	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x65,
 	0x6e, 0x67, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
@@ -468,15 +501,28 @@ var file_core_services_ocr2_plugins_functions_config_config_types_proto_rawDesc
 	0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61,
 	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x19, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x2a, 0x41, 0x0a, 0x11, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x14, 0x0a, 0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x42, 0x2d, 0x5a,
-	0x2b, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6f,
-	0x63, 0x72, 0x32, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x69, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x69, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x2a, 0x9c, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x44, 0x49,
+	0x41, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49,
+	0x41, 0x4e, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x42,
+	0x2d, 0x5a, 0x2b, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x6f, 0x63, 0x72, 0x32, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12,6 +12,16 @@ type ReportCodec interface {
 	DecodeReport(raw []byte) ([]*ProcessedRequest, error)
 }
 
+// The processing metadata of a request in a report is its coordinator contract
+// address, followed by a byte with its aggregation method if the method is
+// firstReportedAggregationMethod (AGGREGATION_TRIMMED_MEAN) or later. Requests
+// aggregated with MODE and MEDIAN keep the original format, so their reports
+// are still transmitted by nodes which are not upgraded yet.
+const (
+	firstReportedAggregationMethod = 2
+	coordinatorContractLength      = 20
+)
+
 type reportCodecV1 struct {
 	reportTypes abi.Arguments
 }
@@ -76,6 +86,12 @@ func (c *reportCodecV1) EncodeReport(requests []*ProcessedRequest) ([]byte, erro
 		errors[i] = requests[i].Error
 		onchainMetadata[i] = requests[i].OnchainMetadata
 		processingMetadata[i] = requests[i].CoordinatorContract
+		if method := requests[i].AggregationMethod; method >= firstReportedAggregationMethod {
+			if len(requests[i].CoordinatorContract) != coordinatorContractLength || method > 0xff {
+				return nil, fmt.Errorf("unable to encode aggregation method %d with coordinator contract %x", method, requests[i].CoordinatorContract)
+			}
+			processingMetadata[i] = append(append([]byte{}, requests[i].CoordinatorContract...), byte(method))
+		}
 		// CallbackGasLimit is not ABI-encoded
 	}
 	return c.reportTypes.Pack(ids, results, errors, onchainMetadata, processingMetadata)
@@ -123,6 +139,10 @@ func (c *reportCodecV1) DecodeReport(raw []byte) ([]*ProcessedRequest, error) {
 			CoordinatorContract: processingMeta[i],
 			// CallbackGasLimit is not ABI-encoded
 		}
+		if len(processingMeta[i]) == coordinatorContractLength+1 {
+			decoded[i].CoordinatorContract = processingMeta[i][:coordinatorContractLength]
+			decoded[i].AggregationMethod = uint32(processingMeta[i][coordinatorContractLength])
+		}
 	}
 	return decoded, nil
 }
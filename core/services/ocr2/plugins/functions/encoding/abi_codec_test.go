@@ -46,6 +46,45 @@ func TestABICodec_EncodeDecodeV1Success(t *testing.T) {
 	}
 }
 
+func TestABICodec_EncodeDecodeV1AggregationMethod(t *testing.T) {
+	t.Parallel()
+	codec, err := encoding.NewReportCodec(1)
+	require.NoError(t, err)
+	contract := []byte(fmt.Sprintf("%020d", 1))
+
+	var report = []*encoding.ProcessedRequest{
+		{
+			RequestID:           []byte(fmt.Sprintf("%032d", 123)),
+			Result:              []byte("abcd"),
+			CoordinatorContract: contract,
+			OnchainMetadata:     []byte("commitment_1"),
+			AggregationMethod:   1, // AGGREGATION_MEDIAN is not recorded
+		},
+		{
+			RequestID:           []byte(fmt.Sprintf("%032d", 4321)),
+			Result:              []byte("0xababababab"),
+			CoordinatorContract: contract,
+			OnchainMetadata:     []byte("commitment_2"),
+			AggregationMethod:   4,
+		},
+	}
+
+	encoded, err := codec.EncodeReport(report)
+	require.NoError(t, err)
+	decoded, err := codec.DecodeReport(encoded)
+	require.NoError(t, err)
+
+	require.Len(t, decoded, 2)
+	require.Equal(t, contract, decoded[0].CoordinatorContract)
+	require.Equal(t, uint32(0), decoded[0].AggregationMethod)
+	require.Equal(t, contract, decoded[1].CoordinatorContract)
+	require.Equal(t, uint32(4), decoded[1].AggregationMethod)
+
+	report[1].CoordinatorContract = []byte("contract_2")
+	_, err = codec.EncodeReport(report)
+	require.ErrorContains(t, err, "unable to encode aggregation method 4")
+}
+
 func TestABICodec_SliceToByte32(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +50,9 @@ type FunctionsServicesConfig struct {
 	EthKeystore       keystore.Eth
 	ThresholdKeyShare []byte
 	LogPollerWrapper  evmrelayTypes.LogPollerWrapper
+	// DecryptionQueues holds the decryption queue of the job while it runs.
+	// Optional.
+	DecryptionQueues *threshold.DecryptionQueues
 }
 
 const (
@@ -77,14 +80,22 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 	var decryptor threshold.Decryptor
 	// thresholdOracleArgs nil check will be removed once the Threshold plugin is fully integrated w/ Functions
 	if len(conf.ThresholdKeyShare) > 0 && thresholdOracleArgs != nil && pluginConfig.DecryptionQueueConfig != nil {
+		var thresholdORM threshold.ORM
+		if pluginConfig.DecryptionQueueConfig.PersistPendingRequests {
+			thresholdORM = threshold.NewORM(conf.DB, conf.Logger, conf.QConfig, conf.Job.ID)
+		}
 		decryptionQueue := threshold.NewDecryptionQueue(
+			conf.Job.ID,
 			int(pluginConfig.DecryptionQueueConfig.MaxQueueLength),
 			int(pluginConfig.DecryptionQueueConfig.MaxCiphertextBytes),
 			int(pluginConfig.DecryptionQueueConfig.MaxCiphertextIdLength),
 			time.Duration(pluginConfig.DecryptionQueueConfig.CompletedCacheTimeoutSec)*time.Second,
+			time.Duration(pluginConfig.DecryptionQueueConfig.DecryptRequestTimeoutSec)*time.Second,
+			thresholdORM,
 			conf.Logger.Named("DecryptionQueue"),
 		)
 		decryptor = decryptionQueue
+		allServices = append(allServices, conf.DecryptionQueues.Service(conf.Job.ID, decryptionQueue))
 		thresholdServicesConfig := threshold.ThresholdServicesConfig{
 			DecryptionQueue:    decryptionQueue,
 			KeyshareWithPubKey: conf.ThresholdKeyShare,
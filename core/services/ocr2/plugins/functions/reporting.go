@@ -38,6 +38,7 @@ type functionsReporting struct {
 	reportCodec         encoding.ReportCodec
 	genericConfig       *types.ReportingPluginConfig
 	specificConfig      *config.ReportingPluginConfigWrapper
+	aggregationOptions  AggregationOptions
 	contractVersion     uint32
 	offchainTransmitter functions.OffchainTransmitter
 }
@@ -65,6 +66,11 @@ var (
 		Help: "Metric to track number of reporting plugin Report calls",
 	}, []string{"jobID"})
 
+	promReportingPluginsAggregations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "functions_reporting_plugin_aggregations",
+		Help: "Metric to track number of requests aggregated in the report phase, by aggregation method",
+	}, []string{"jobID", "aggregationMethod"})
+
 	promReportingPluginsReportNumObservations = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "functions_reporting_plugin_report_num_observations",
 		Help: "Metric to track number of observations available in the report phase",
@@ -100,6 +106,14 @@ func (f FunctionsReportingPluginFactory) NewReportingPlugin(rpConfig types.Repor
 		})
 		return nil, types.ReportingPluginInfo{}, err
 	}
+	aggregationOptions, err := NewAggregationOptions(pluginConfig.Config, rpConfig.F)
+	if err != nil {
+		f.Logger.Error("invalid aggregation config", commontypes.LogFields{
+			"digest": rpConfig.ConfigDigest.String(),
+			"err":    err,
+		})
+		return nil, types.ReportingPluginInfo{}, err
+	}
 	codec, err := encoding.NewReportCodec(f.ContractVersion)
 	if err != nil {
 		f.Logger.Error("unable to create a report codec object", commontypes.LogFields{})
@@ -121,6 +135,7 @@ func (f FunctionsReportingPluginFactory) NewReportingPlugin(rpConfig types.Repor
 		reportCodec:         codec,
 		genericConfig:       &rpConfig,
 		specificConfig:      pluginConfig,
+		aggregationOptions:  aggregationOptions,
 		contractVersion:     f.ContractVersion,
 		offchainTransmitter: f.OffchainTransmitter,
 	}
@@ -339,7 +354,7 @@ func (r *functionsReporting) Report(ctx context.Context, ts types.ReportTimestam
 
 		// TODO: support per-request aggregation method
 		// https://app.shortcut.com/chainlinklabs/story/57701/per-request-plugin-config
-		aggregated, errAgg := Aggregate(defaultAggMethod, observations)
+		aggregated, errAgg := Aggregate(defaultAggMethod, r.aggregationOptions, observations)
 		if errAgg != nil {
 			r.logger.Error("FunctionsReporting Report: error when aggregating reqId", commontypes.LogFields{
 				"epoch":     ts.Epoch,
@@ -362,11 +377,13 @@ func (r *functionsReporting) Report(ctx context.Context, ts types.ReportTimestam
 		}
 		totalCallbackGas += aggregated.CallbackGasLimit
 		r.logger.Debug("FunctionsReporting Report: aggregated successfully", commontypes.LogFields{
-			"epoch":         ts.Epoch,
-			"round":         ts.Round,
-			"requestID":     reqId,
-			"nObservations": len(observations),
+			"epoch":             ts.Epoch,
+			"round":             ts.Round,
+			"requestID":         reqId,
+			"nObservations":     len(observations),
+			"aggregationMethod": defaultAggMethod.String(),
 		})
+		promReportingPluginsAggregations.WithLabelValues(r.jobID.String(), defaultAggMethod.String()).Inc()
 		var requestCoordinator common.Address
 		requestCoordinator.SetBytes(aggregated.CoordinatorContract)
 		reportCoordinator, err = ShouldIncludeCoordinator(&requestCoordinator, reportCoordinator)
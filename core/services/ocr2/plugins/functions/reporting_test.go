@@ -129,6 +129,32 @@ func newObservation(t *testing.T, observerId uint8, requests ...*encoding.Proces
 	}
 }
 
+func TestFunctionsReporting_NewReportingPlugin_InvalidAggregationConfig(t *testing.T) {
+	t.Parallel()
+	factory := functions.FunctionsReportingPluginFactory{
+		Logger:              commonlogger.NewOCRWrapper(logger.TestLogger(t), true, func(msg string) {}),
+		PluginORM:           functions_mocks.NewORM(t),
+		ContractVersion:     1,
+		OffchainTransmitter: functions_mocks.NewOffchainTransmitter(t),
+	}
+
+	pluginConfig := config.ReportingPluginConfigWrapper{
+		Config: &config.ReportingPluginConfig{
+			MaxRequestBatchSize:       10,
+			MaxReportTotalCallbackGas: 300000,
+			DefaultAggregationMethod:  config.AggregationMethod_AGGREGATION_FIELDWISE_MEDIAN,
+		},
+	}
+	pluginConfigBytes, err := config.EncodeReportingPluginConfig(&pluginConfig)
+	require.NoError(t, err)
+	_, _, err = factory.NewReportingPlugin(types.ReportingPluginConfig{
+		N:              4,
+		F:              1,
+		OffchainConfig: pluginConfigBytes,
+	})
+	require.Error(t, err)
+}
+
 func TestFunctionsReporting_Query(t *testing.T) {
 	t.Parallel()
 	const batchSize = 10
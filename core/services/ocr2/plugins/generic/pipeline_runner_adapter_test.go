@@ -52,6 +52,7 @@ func TestAdapter_Integration(t *testing.T) {
 		logger,
 		http.DefaultClient,
 		http.DefaultClient,
+		bridges.NewHTTPClients(http.DefaultClient),
 	)
 	pra := generic.NewPipelineRunnerAdapter(logger, job.Job{}, pr)
 	results, err := pra.ExecuteRun(testutils.Context(t), spec, types.Vars{Vars: map[string]interface{}{"val": 1}}, types.Options{})
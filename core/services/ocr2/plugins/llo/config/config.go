@@ -21,6 +21,10 @@ import (
 type PluginConfig struct {
 	RawServerURL string              `json:"serverURL" toml:"serverURL"`
 	ServerPubKey utils.PlainHexBytes `json:"serverPubKey" toml:"serverPubKey"`
+	// Servers maps the URLs of the servers to transmit reports to, to their
+	// public keys. It is an alternative to RawServerURL and ServerPubKey for
+	// transmitting to more than one server.
+	Servers map[string]utils.PlainHexBytes `json:"servers" toml:"servers"`
 
 	ChannelDefinitionsContractAddress   common.Address `json:"channelDefinitionsContractAddress" toml:"channelDefinitionsContractAddress"`
 	ChannelDefinitionsContractFromBlock int64          `json:"channelDefinitionsContractFromBlock" toml:"channelDefinitionsContractFromBlock"`
@@ -42,20 +46,26 @@ type PluginConfig struct {
 }
 
 func (p PluginConfig) Validate() (merr error) {
-	if p.RawServerURL == "" {
-		merr = errors.New("llo: ServerURL must be specified")
+	if len(p.Servers) > 0 {
+		if p.RawServerURL != "" || len(p.ServerPubKey) != 0 {
+			merr = errors.New("llo: Servers and RawServerURL/ServerPubKey may not be specified together")
+		}
+		for serverURL, serverPubKey := range p.Servers {
+			if err := validateServerURL(serverURL); err != nil {
+				merr = errors.Join(merr, err)
+			}
+			if len(serverPubKey) != 32 {
+				merr = errors.Join(merr, fmt.Errorf("llo: ServerPubKey for server %q must be a 32-byte hex string", serverURL))
+			}
+		}
 	} else {
-		var normalizedURI string
-		if schemeRegexp.MatchString(p.RawServerURL) {
-			normalizedURI = p.RawServerURL
-		} else {
-			normalizedURI = fmt.Sprintf("wss://%s", p.RawServerURL)
+		if p.RawServerURL == "" {
+			merr = errors.New("llo: ServerURL must be specified")
+		} else if err := validateServerURL(p.RawServerURL); err != nil {
+			merr = err
 		}
-		uri, err := url.ParseRequestURI(normalizedURI)
-		if err != nil {
-			merr = fmt.Errorf("llo: invalid value for ServerURL: %w", err)
-		} else if uri.Scheme != "wss" {
-			merr = fmt.Errorf(`llo: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, p.RawServerURL, uri.Scheme)
+		if len(p.ServerPubKey) != 32 {
+			merr = errors.Join(merr, errors.New("llo: ServerPubKey is required and must be a 32-byte hex string"))
 		}
 	}
 
@@ -76,15 +86,27 @@ func (p PluginConfig) Validate() (merr error) {
 		}
 	}
 
-	if len(p.ServerPubKey) != 32 {
-		merr = errors.Join(merr, errors.New("llo: ServerPubKey is required and must be a 32-byte hex string"))
-	}
-
 	merr = errors.Join(merr, validateKeyBundleIDs(p.KeyBundleIDs))
 
 	return merr
 }
 
+func validateServerURL(rawServerURL string) error {
+	var normalizedURI string
+	if schemeRegexp.MatchString(rawServerURL) {
+		normalizedURI = rawServerURL
+	} else {
+		normalizedURI = fmt.Sprintf("wss://%s", rawServerURL)
+	}
+	uri, err := url.ParseRequestURI(normalizedURI)
+	if err != nil {
+		return fmt.Errorf("llo: invalid value for ServerURL: %w", err)
+	} else if uri.Scheme != "wss" {
+		return fmt.Errorf(`llo: invalid scheme specified for MercuryServer, got: %q (scheme: %q) but expected a websocket url e.g. "192.0.2.2:4242" or "wss://192.0.2.2:4242"`, rawServerURL, uri.Scheme)
+	}
+	return nil
+}
+
 func validateKeyBundleIDs(keyBundleIDs map[string]string) error {
 	for k, v := range keyBundleIDs {
 		if k == "" {
@@ -110,3 +132,16 @@ var wssRegexp = regexp.MustCompile(`^wss://`)
 func (p PluginConfig) ServerURL() string {
 	return wssRegexp.ReplaceAllString(p.RawServerURL, "")
 }
+
+// GetServers returns the public keys of all servers to transmit reports to,
+// by URL without the wss:// scheme.
+func (p PluginConfig) GetServers() map[string][]byte {
+	if len(p.Servers) == 0 {
+		return map[string][]byte{p.ServerURL(): p.ServerPubKey}
+	}
+	servers := make(map[string][]byte, len(p.Servers))
+	for serverURL, serverPubKey := range p.Servers {
+		servers[wssRegexp.ReplaceAllString(serverURL, "")] = serverPubKey
+	}
+	return servers
+}
@@ -114,6 +114,36 @@ func Test_Config(t *testing.T) {
 			assert.EqualError(t, err, "llo: ChannelDefinitionsContractAddress is required if ChannelDefinitions is not specified")
 		})
 
+		t.Run("with multiple servers", func(t *testing.T) {
+			rawToml := `
+			ChannelDefinitionsContractAddress = "0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+			[servers]
+			"example.com:80" = "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93"
+			"wss://example.invalid:443" = "5ad4d7f7fcb2dc1f3c66e4a6d2d61e3f1c4a5b2e8a0f9e7d6c5b4a3928170605"`
+
+			var mc PluginConfig
+			err := toml.Unmarshal([]byte(rawToml), &mc)
+			require.NoError(t, err)
+			require.NoError(t, mc.Validate())
+
+			servers := mc.GetServers()
+			require.Len(t, servers, 2)
+			assert.Equal(t, "724ff6eae9e900270edfff233e16322a70ec06e1a6e62a81ef13921f398f6c93", fmt.Sprintf("%x", servers["example.com:80"]))
+			assert.Equal(t, "5ad4d7f7fcb2dc1f3c66e4a6d2d61e3f1c4a5b2e8a0f9e7d6c5b4a3928170605", fmt.Sprintf("%x", servers["example.invalid:443"]))
+
+			mc.RawServerURL = "example.com:80"
+			mc.Servers["http://example.com"] = []byte{1, 2}
+			err = mc.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "llo: Servers and RawServerURL/ServerPubKey may not be specified together")
+			assert.Contains(t, err.Error(), `invalid scheme specified for MercuryServer, got: "http://example.com"`)
+			assert.Contains(t, err.Error(), `llo: ServerPubKey for server "http://example.com" must be a 32-byte hex string`)
+		})
+		t.Run("with a single server", func(t *testing.T) {
+			mc := PluginConfig{RawServerURL: "wss://example.com:80", ServerPubKey: []byte{1}}
+			assert.Equal(t, map[string][]byte{"example.com:80": {1}}, mc.GetServers())
+		})
+
 		t.Run("with invalid values", func(t *testing.T) {
 			rawToml := `
 				ChannelDefinitionsContractFromBlock = "invalid"
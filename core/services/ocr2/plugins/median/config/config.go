@@ -12,11 +12,35 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
+// Observation source types of the Median plugin.
+const (
+	// ObservationSourcePipeline observes by running the job's pipeline.
+	ObservationSourcePipeline = "pipeline"
+	// ObservationSourceStream observes by running the pipeline of the stream
+	// with the configured StreamID.
+	ObservationSourceStream = "stream"
+)
+
+// MaxObservationCacheDuration is the longest time an observation may be shared
+// between jobs.
+const MaxObservationCacheDuration = time.Minute
+
 // The PluginConfig struct contains the custom arguments needed for the Median plugin.
 type PluginConfig struct {
 	JuelsPerFeeCoinPipeline      string          `json:"juelsPerFeeCoinSource"`
 	JuelsPerFeeCoinCacheDuration models.Interval `json:"juelsPerFeeCoinCacheDuration"`
 	JuelsPerFeeCoinCacheDisabled bool            `json:"juelsPerFeeCoinCacheDisabled"`
+	// ObservationSourceType selects how the median value is observed. It
+	// defaults to ObservationSourcePipeline.
+	ObservationSourceType string  `json:"observationSourceType"`
+	StreamID              *uint32 `json:"streamID"`
+	// ObservationCacheDuration, when set, shares observations with the other
+	// jobs on the node which have the same ObservationCacheKey for this long.
+	// The key defaults to one derived from the stream ID, or to one of the job
+	// for pipelines, which are then only shared between the data sources of
+	// the job.
+	ObservationCacheDuration models.Interval `json:"observationCacheDuration"`
+	ObservationCacheKey      string          `json:"observationCacheKey"`
 }
 
 // ValidatePluginConfig validates the arguments for the Median plugin.
@@ -34,5 +58,27 @@ func ValidatePluginConfig(config PluginConfig) error {
 		}
 	}
 
+	switch config.ObservationSourceType {
+	case "", ObservationSourcePipeline:
+		if config.StreamID != nil {
+			return errors.Errorf("streamID requires observationSourceType %q", ObservationSourceStream)
+		}
+	case ObservationSourceStream:
+		if config.StreamID == nil {
+			return errors.Errorf("observationSourceType %q requires a streamID", ObservationSourceStream)
+		}
+	default:
+		return errors.Errorf("invalid observationSourceType %q, must be one of: %q, %q", config.ObservationSourceType, ObservationSourcePipeline, ObservationSourceStream)
+	}
+
+	if config.ObservationCacheDuration.Duration() < 0 {
+		return errors.Errorf("observation cache duration: %s must not be negative", config.ObservationCacheDuration.Duration().String())
+	} else if config.ObservationCacheDuration.Duration() > MaxObservationCacheDuration {
+		return errors.Errorf("observation cache duration: %s is above 1 minute maximum", config.ObservationCacheDuration.Duration().String())
+	}
+	if config.ObservationCacheKey != "" && config.ObservationCacheDuration == 0 {
+		return errors.New("observationCacheKey requires an observationCacheDuration")
+	}
+
 	return nil
 }
@@ -41,6 +41,36 @@ func TestValidatePluginConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("observation source validation", func(t *testing.T) {
+		const pipeline = `ds1 [type=bridge name=voter_turnout];`
+		streamID := uint32(1)
+		for _, tc := range []struct {
+			name          string
+			config        PluginConfig
+			expectedError string
+		}{
+			{"pipeline", PluginConfig{ObservationSourceType: ObservationSourcePipeline}, ""},
+			{"stream", PluginConfig{ObservationSourceType: ObservationSourceStream, StreamID: &streamID}, ""},
+			{"cached stream", PluginConfig{ObservationSourceType: ObservationSourceStream, StreamID: &streamID, ObservationCacheDuration: models.Interval(time.Second), ObservationCacheKey: "eth-usd"}, ""},
+			{"unknown type", PluginConfig{ObservationSourceType: "cache"}, `invalid observationSourceType "cache", must be one of: "pipeline", "stream"`},
+			{"stream without ID", PluginConfig{ObservationSourceType: ObservationSourceStream}, `observationSourceType "stream" requires a streamID`},
+			{"stream ID without stream", PluginConfig{StreamID: &streamID}, `streamID requires observationSourceType "stream"`},
+			{"negative cache duration", PluginConfig{ObservationCacheDuration: models.Interval(-time.Second)}, "observation cache duration: -1s must not be negative"},
+			{"cache duration above maximum", PluginConfig{ObservationCacheDuration: models.Interval(time.Minute + time.Second)}, "observation cache duration: 1m1s is above 1 minute maximum"},
+			{"cache key without duration", PluginConfig{ObservationCacheKey: "eth-usd"}, "observationCacheKey requires an observationCacheDuration"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				tc.config.JuelsPerFeeCoinPipeline = pipeline
+				err := ValidatePluginConfig(tc.config)
+				if tc.expectedError == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.EqualError(t, err, tc.expectedError)
+				}
+			})
+		}
+	})
+
 	t.Run("valid values", func(t *testing.T) {
 		for _, s := range []testCase{
 			{"valid 0 cache duration and valid pipeline", `ds1 [type=bridge name=voter_turnout];`, 0, nil},
@@ -0,0 +1,75 @@
+package median
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+// streamDataSource observes by running the pipeline of a registered stream,
+// so that a value already defined as a stream is not defined again by the job.
+type streamDataSource struct {
+	streams  streams.Getter
+	streamID streams.StreamID
+}
+
+var _ median.DataSource = (*streamDataSource)(nil)
+
+func newStreamDataSource(getter streams.Getter, streamID streams.StreamID) *streamDataSource {
+	return &streamDataSource{streams: getter, streamID: streamID}
+}
+
+func (ds *streamDataSource) Observe(ctx context.Context, _ ocr2types.ReportTimestamp) (*big.Int, error) {
+	strm, exists := ds.streams.Get(ds.streamID)
+	if !exists {
+		return nil, fmt.Errorf("stream %d is not registered", ds.streamID)
+	}
+	_, trrs, err := strm.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return streams.ExtractBigInt(trrs)
+}
+
+// observationCacheKey returns the key under which observations of a job are
+// shared with other jobs, unless one is configured. Observations of a pipeline
+// are only shared by default between the data sources of the job, as the
+// results of the same pipeline may depend on the job, e.g. through
+// $(jobSpec) variables.
+func observationCacheKey(pluginConfig config.PluginConfig, jb job.Job) string {
+	if pluginConfig.ObservationCacheKey != "" {
+		return pluginConfig.ObservationCacheKey
+	}
+	if pluginConfig.ObservationSourceType == config.ObservationSourceStream {
+		return fmt.Sprintf("stream/%d", *pluginConfig.StreamID)
+	}
+	return fmt.Sprintf("job/%d/pipeline/%d", jb.ID, jb.PipelineSpecID)
+}
+
+// newObservationSource returns the data source of the median value configured
+// by pluginConfig, wrapping the job's pipeline data source unless another
+// source is selected.
+func newObservationSource(pluginConfig config.PluginConfig, pipelineSource median.DataSource, jb job.Job, streamRegistry streams.Getter, cache *ocrcommon.ObservationCache) (median.DataSource, error) {
+	ds := pipelineSource
+	if pluginConfig.ObservationSourceType == config.ObservationSourceStream {
+		if streamRegistry == nil {
+			return nil, fmt.Errorf("observationSourceType %q is not supported: no stream registry", config.ObservationSourceStream)
+		}
+		ds = newStreamDataSource(streamRegistry, *pluginConfig.StreamID)
+	}
+	if pluginConfig.ObservationCacheDuration == 0 {
+		return ds, nil
+	}
+	if cache == nil {
+		return nil, fmt.Errorf("observationCacheDuration is not supported: no observation cache")
+	}
+	return cache.NewDataSource(observationCacheKey(pluginConfig, jb), pluginConfig.ObservationCacheDuration.Duration(), ds), nil
+}
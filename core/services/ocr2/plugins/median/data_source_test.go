@@ -0,0 +1,107 @@
+package median
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+type fakeStream struct {
+	runs  int
+	value int64
+}
+
+func (s *fakeStream) Run(context.Context) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	s.runs++
+	return &pipeline.Run{}, pipeline.TaskRunResults{{
+		Task:   &pipeline.MemoTask{},
+		Result: pipeline.Result{Value: s.value},
+	}}, nil
+}
+
+type fakeStreams map[streams.StreamID]streams.Stream
+
+func (f fakeStreams) Get(streamID streams.StreamID) (streams.Stream, bool) {
+	strm, exists := f[streamID]
+	return strm, exists
+}
+
+type fixedDataSource int64
+
+func (ds fixedDataSource) Observe(context.Context, ocr2types.ReportTimestamp) (*big.Int, error) {
+	return big.NewInt(int64(ds)), nil
+}
+
+func TestNewObservationSource(t *testing.T) {
+	t.Parallel()
+
+	streamID := uint32(1)
+	unregisteredID := uint32(2)
+	strm := &fakeStream{value: 42}
+	registry := fakeStreams{streamID: strm}
+	const pipelineValue = fixedDataSource(7)
+
+	t.Run("pipeline", func(t *testing.T) {
+		ds, err := newObservationSource(config.PluginConfig{}, pipelineValue, job.Job{}, registry, nil)
+		require.NoError(t, err)
+		assert.Equal(t, pipelineValue, ds)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		ds, err := newObservationSource(config.PluginConfig{ObservationSourceType: config.ObservationSourceStream, StreamID: &streamID}, pipelineValue, job.Job{}, registry, nil)
+		require.NoError(t, err)
+		v, err := ds.Observe(testutils.Context(t), ocr2types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), v)
+
+		ds, err = newObservationSource(config.PluginConfig{ObservationSourceType: config.ObservationSourceStream, StreamID: &unregisteredID}, pipelineValue, job.Job{}, registry, nil)
+		require.NoError(t, err)
+		_, err = ds.Observe(testutils.Context(t), ocr2types.ReportTimestamp{})
+		assert.EqualError(t, err, "stream 2 is not registered")
+
+		_, err = newObservationSource(config.PluginConfig{ObservationSourceType: config.ObservationSourceStream, StreamID: &streamID}, pipelineValue, job.Job{}, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("cached stream", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		cfg := config.PluginConfig{ObservationSourceType: config.ObservationSourceStream, StreamID: &streamID, ObservationCacheDuration: models.Interval(time.Hour)}
+		runs := strm.runs
+		for i := 0; i < 3; i++ {
+			ds, err := newObservationSource(cfg, pipelineValue, job.Job{}, registry, cache)
+			require.NoError(t, err)
+			v, err := ds.Observe(testutils.Context(t), ocr2types.ReportTimestamp{})
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(42), v)
+		}
+		assert.Equal(t, runs+1, strm.runs, "jobs observing the same stream share one run")
+
+		_, err := newObservationSource(cfg, pipelineValue, job.Job{}, registry, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestObservationCacheKey(t *testing.T) {
+	t.Parallel()
+
+	streamID := uint32(3)
+	assert.Equal(t, "stream/3", observationCacheKey(config.PluginConfig{ObservationSourceType: config.ObservationSourceStream, StreamID: &streamID}, job.Job{}))
+	assert.Equal(t, "eth-usd", observationCacheKey(config.PluginConfig{ObservationCacheKey: "eth-usd"}, job.Job{}))
+
+	assert.Equal(t, "job/1/pipeline/2", observationCacheKey(config.PluginConfig{}, job.Job{ID: 1, PipelineSpecID: 2}))
+	assert.NotEqual(t, observationCacheKey(config.PluginConfig{}, job.Job{ID: 1, PipelineSpecID: 2}), observationCacheKey(config.PluginConfig{}, job.Job{ID: 3, PipelineSpecID: 4}),
+		"the same pipeline may observe different values in different jobs")
+}
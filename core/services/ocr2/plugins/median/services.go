@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -57,6 +58,8 @@ func NewMedianServices(ctx context.Context,
 	isNewlyCreatedJob bool,
 	relayer loop.Relayer,
 	pipelineRunner pipeline.Runner,
+	streamRegistry streams.Getter,
+	observationCache *ocrcommon.ObservationCache,
 	lggr logger.Logger,
 	argsNoPlugin libocr.OCR2OracleArgs,
 	cfg MedianConfig,
@@ -113,12 +116,16 @@ func NewMedianServices(ctx context.Context,
 		}
 	}
 
-	dataSource := ocrcommon.NewDataSourceV2(pipelineRunner,
+	dataSource, err := newObservationSource(pluginConfig, ocrcommon.NewDataSourceV2(pipelineRunner,
 		jb,
 		*jb.PipelineSpec,
 		lggr,
 		runSaver,
-		chEnhancedTelem)
+		chEnhancedTelem), jb, streamRegistry, observationCache)
+	if err != nil {
+		abort()
+		return
+	}
 
 	juelsPerFeeCoinSource := ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, pipeline.Spec{
 		ID:           jb.ID,
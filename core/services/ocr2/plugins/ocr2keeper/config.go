@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
 )
 
 type Duration time.Duration
@@ -60,6 +62,9 @@ type PluginConfig struct {
 	ContractVersion string `json:"contractVersion"`
 	// CaptureAutomationCustomTelemetry is a bool flag to toggle Custom Telemetry Service
 	CaptureAutomationCustomTelemetry *bool `json:"captureAutomationCustomTelemetry,omitempty"`
+	// LogLimits holds the log limits and priorities of specific log trigger
+	// upkeeps, by upkeep ID. The limits can only lower the global limits.
+	LogLimits logprovider.UpkeepLogLimits `json:"logLimits,omitempty"`
 }
 
 func ValidatePluginConfig(cfg PluginConfig) error {
@@ -83,5 +88,9 @@ func ValidatePluginConfig(cfg PluginConfig) error {
 		return fmt.Errorf("service queue length cannot be less than zero")
 	}
 
+	if err := cfg.LogLimits.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
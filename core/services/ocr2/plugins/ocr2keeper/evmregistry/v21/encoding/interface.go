@@ -1,6 +1,7 @@
 package encoding
 
 import (
+	"fmt"
 	"net/http"
 
 	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
@@ -42,6 +43,48 @@ const (
 	PrivilegeConfigUnmarshalError PipelineExecutionState = 6
 )
 
+var upkeepFailureReasonNames = map[UpkeepFailureReason]string{
+	UpkeepFailureReasonNone:                    "none",
+	UpkeepFailureReasonUpkeepCancelled:         "upkeepCancelled",
+	UpkeepFailureReasonUpkeepPaused:            "upkeepPaused",
+	UpkeepFailureReasonTargetCheckReverted:     "targetCheckReverted",
+	UpkeepFailureReasonUpkeepNotNeeded:         "upkeepNotNeeded",
+	UpkeepFailureReasonPerformDataExceedsLimit: "performDataExceedsLimit",
+	UpkeepFailureReasonInsufficientBalance:     "insufficientBalance",
+	UpkeepFailureReasonMercuryCallbackReverted: "mercuryCallbackReverted",
+	UpkeepFailureReasonRevertDataExceedsLimit:  "revertDataExceedsLimit",
+	UpkeepFailureReasonRegistryPaused:          "registryPaused",
+	UpkeepFailureReasonMercuryAccessNotAllowed: "mercuryAccessNotAllowed",
+	UpkeepFailureReasonTxHashNoLongerExists:    "txHashNoLongerExists",
+	UpkeepFailureReasonInvalidRevertDataInput:  "invalidRevertDataInput",
+	UpkeepFailureReasonSimulationFailed:        "simulationFailed",
+	UpkeepFailureReasonTxHashReorged:           "txHashReorged",
+}
+
+func (r UpkeepFailureReason) String() string {
+	if name, ok := upkeepFailureReasonNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(r))
+}
+
+var pipelineExecutionStateNames = map[PipelineExecutionState]string{
+	NoPipelineError:               "none",
+	CheckBlockTooOld:              "checkBlockTooOld",
+	CheckBlockInvalid:             "checkBlockInvalid",
+	RpcFlakyFailure:               "rpcFlakyFailure",
+	MercuryFlakyFailure:           "mercuryFlakyFailure",
+	PackUnpackDecodeFailed:        "packUnpackDecodeFailed",
+	PrivilegeConfigUnmarshalError: "privilegeConfigUnmarshalError",
+}
+
+func (s PipelineExecutionState) String() string {
+	if name, ok := pipelineExecutionStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(s))
+}
+
 // ErrCode is used for invoking an error handler with a specific error code.
 type ErrCode uint32
 
@@ -0,0 +1,102 @@
+package logprovider
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+var (
+	// ErrBackfillNotRecoverable is returned when the logs of a backfill request can't be re-delivered to the upkeep.
+	ErrBackfillNotRecoverable = errors.New("backfill is not recoverable")
+
+	errNoActiveFilter = errors.New("no active filter found for upkeep")
+)
+
+// Backfill is a request to re-deliver the logs of a block range to a log trigger upkeep.
+type Backfill struct {
+	ID          int64
+	UpkeepID    *ubig.Big
+	FromBlock   int64
+	ToBlock     int64
+	Logs        int64
+	Error       *string
+	CreatedAt   time.Time
+	ProcessedAt *time.Time
+}
+
+// BackfillORM stores the backfill requests of the log trigger upkeeps of a registry.
+type BackfillORM interface {
+	// InsertBackfill stores a new backfill request for the given upkeep and block range.
+	InsertBackfill(upkeepID *big.Int, fromBlock, toBlock int64, qopts ...pg.QOpt) (Backfill, error)
+	// SelectPendingBackfills returns up to limit of the oldest backfill requests which were not processed yet.
+	SelectPendingBackfills(limit int, qopts ...pg.QOpt) ([]Backfill, error)
+	// MarkBackfillProcessed marks a backfill request as processed, with the number of logs it re-delivered.
+	MarkBackfillProcessed(id int64, logs int, qopts ...pg.QOpt) error
+	// MarkBackfillFailed marks a backfill request which can't be processed as processed, with the reason it failed.
+	MarkBackfillFailed(id int64, reason string, qopts ...pg.QOpt) error
+}
+
+type backfillORM struct {
+	chainID *ubig.Big
+	address common.Address
+	q       pg.Q
+}
+
+var _ BackfillORM = &backfillORM{}
+
+// NewBackfillORM creates a BackfillORM scoped to the registry at address on chainID.
+func NewBackfillORM(chainID *big.Int, address common.Address, db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig) *backfillORM {
+	return &backfillORM{
+		chainID: ubig.New(chainID),
+		address: address,
+		q:       pg.NewQ(db, lggr.Named("BackfillORM"), cfg),
+	}
+}
+
+func (o *backfillORM) InsertBackfill(upkeepID *big.Int, fromBlock, toBlock int64, qopts ...pg.QOpt) (backfill Backfill, err error) {
+	q := o.q.WithOpts(qopts...)
+
+	err = q.Get(&backfill, `INSERT INTO evm.log_upkeep_backfills (evm_chain_id, address, upkeep_id, from_block, to_block)
+	  VALUES ($1, $2, $3, $4, $5)
+	  RETURNING id, upkeep_id, from_block, to_block, logs, error, created_at, processed_at`, o.chainID, o.address.Bytes(), ubig.New(upkeepID), fromBlock, toBlock)
+
+	return backfill, err
+}
+
+func (o *backfillORM) SelectPendingBackfills(limit int, qopts ...pg.QOpt) (backfills []Backfill, err error) {
+	q := o.q.WithOpts(qopts...)
+
+	err = q.Select(&backfills, `SELECT id, upkeep_id, from_block, to_block, logs, error, created_at, processed_at
+	  FROM evm.log_upkeep_backfills
+	  WHERE evm_chain_id = $1 AND address = $2 AND processed_at IS NULL
+	  ORDER BY id ASC
+	  LIMIT $3`, o.chainID, o.address.Bytes(), limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return backfills, err
+}
+
+func (o *backfillORM) MarkBackfillProcessed(id int64, logs int, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE evm.log_upkeep_backfills SET processed_at = NOW(), logs = $1 WHERE id = $2 AND evm_chain_id = $3 AND address = $4`, logs, id, o.chainID, o.address.Bytes())
+
+	return err
+}
+
+func (o *backfillORM) MarkBackfillFailed(id int64, reason string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	_, err := q.Exec(`UPDATE evm.log_upkeep_backfills SET processed_at = NOW(), error = $1 WHERE id = $2 AND evm_chain_id = $3 AND address = $4`, reason, id, o.chainID, o.address.Bytes())
+
+	return err
+}
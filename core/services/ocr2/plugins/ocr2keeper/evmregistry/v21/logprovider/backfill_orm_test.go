@@ -0,0 +1,60 @@
+package logprovider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestBackfillORM(t *testing.T) {
+	lggr, _ := logger.TestLoggerObserved(t, zapcore.ErrorLevel)
+	db := pgtest.NewSqlxDB(t)
+	registry := common.HexToAddress("0x1")
+	orm := NewBackfillORM(testutils.FixtureChainID, registry, db, lggr, pgtest.NewQConfig(true))
+	otherChainORM := NewBackfillORM(big.NewInt(1337), registry, db, lggr, pgtest.NewQConfig(true))
+	otherRegistryORM := NewBackfillORM(testutils.FixtureChainID, common.HexToAddress("0x2"), db, lggr, pgtest.NewQConfig(true))
+
+	first, err := orm.InsertBackfill(big.NewInt(1), 100, 200)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), first.FromBlock)
+	assert.Equal(t, int64(200), first.ToBlock)
+	assert.Nil(t, first.ProcessedAt)
+	second, err := orm.InsertBackfill(big.NewInt(2), 150, 150)
+	require.NoError(t, err)
+
+	pending, err := orm.SelectPendingBackfills(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, first.ID, pending[0].ID)
+	assert.Equal(t, "1", pending[0].UpkeepID.String())
+	assert.Equal(t, second.ID, pending[1].ID)
+
+	pending, err = otherChainORM.SelectPendingBackfills(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+
+	pending, err = otherRegistryORM.SelectPendingBackfills(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+
+	require.NoError(t, orm.MarkBackfillProcessed(first.ID, 3))
+
+	pending, err = orm.SelectPendingBackfills(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, second.ID, pending[0].ID)
+
+	require.NoError(t, orm.MarkBackfillFailed(second.ID, "not recoverable"))
+
+	pending, err = orm.SelectPendingBackfills(10)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+}
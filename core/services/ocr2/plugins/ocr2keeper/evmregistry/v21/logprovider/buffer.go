@@ -24,6 +24,15 @@ var (
 	defaultNumOfLogUpkeeps = 50
 )
 
+const (
+	// dropReasonUpkeepLimit is used when a log is dropped as the upkeep reached its logs limit in the block.
+	dropReasonUpkeepLimit = "upkeep_limit"
+	// dropReasonBlockLimit is used when a log is dropped as the block reached its logs limit.
+	dropReasonBlockLimit = "block_limit"
+	// dropReasonOldBlock is used when a log is skipped as its block is older than the block stored in the buffer.
+	dropReasonOldBlock = "old_block"
+)
+
 // fetchedLog holds the log and the ID of the upkeep
 type fetchedLog struct {
 	upkeepID *big.Int
@@ -55,11 +64,13 @@ type fetchedBlock struct {
 	visited []fetchedLog
 }
 
-func (b *fetchedBlock) Append(lggr logger.Logger, fl fetchedLog, maxBlockLogs, maxUpkeepLogs int) (fetchedLog, bool) {
+// Append adds the log to the block, returning the log that was dropped (if any) to stay within
+// the limits together with the reason it was dropped, and false if the log is already known.
+func (b *fetchedBlock) Append(lggr logger.Logger, fl fetchedLog, maxBlockLogs, maxUpkeepLogs int) (fetchedLog, string, bool) {
 	has, upkeepLogs := b.has(fl.upkeepID, fl.log)
 	if has {
 		// Skipping known logs
-		return fetchedLog{}, false
+		return fetchedLog{}, "", false
 	}
 	// lggr.Debugw("Adding log", "i", i, "blockBlock", currentBlock.blockNumber, "logBlock", log.BlockNumber, "id", id)
 	b.logs = append(b.logs, fl)
@@ -79,17 +90,17 @@ func (b *fetchedBlock) Append(lggr logger.Logger, fl fetchedLog, maxBlockLogs, m
 			currentLogs = append(currentLogs, l)
 		}
 		b.logs = currentLogs
-		return dropped, true
+		return dropped, dropReasonUpkeepLimit, true
 	} else if len(b.logs)+len(b.visited) > maxBlockLogs {
 		// in case we have logs overflow in the buffer level, we drop a log based on
 		// shared, random (per block) order of the logs in the block.
 		b.Sort()
 		dropped := b.logs[0]
 		b.logs = b.logs[1:]
-		return dropped, true
+		return dropped, dropReasonBlockLimit, true
 	}
 
-	return fetchedLog{}, true
+	return fetchedLog{}, "", true
 }
 
 // Has returns true if the block has the log,
@@ -151,6 +162,9 @@ type logEventBuffer struct {
 	blocks []fetchedBlock
 	// latestBlock is the latest block number seen
 	latestBlock int64
+	// upkeepLimits holds the limits of upkeeps that override the global limits,
+	// it is set on creation and not modified afterwards
+	upkeepLimits UpkeepLogLimits
 }
 
 func newLogEventBuffer(lggr logger.Logger, size, numOfLogUpkeeps, fastExecLogsHigh int) *logEventBuffer {
@@ -176,6 +190,17 @@ func (b *logEventBuffer) SetLimits(numOfLogUpkeeps, fastExecLogsHigh int) {
 	atomic.StoreUint32(&b.fastExecLogsHigh, uint32(fastExecLogsHigh))
 }
 
+// maxUpkeepLogsPerBlock returns the number of logs the upkeep can have in a single block,
+// which can't exceed the global limit.
+// NOTE: this function should be called with the lock held
+func (b *logEventBuffer) maxUpkeepLogsPerBlock(id string) int {
+	maxUpkeepLogs := int(atomic.LoadUint32(&b.fastExecLogsHigh))
+	if l := b.upkeepLimits[id].MaxLogsPerBlock; l > 0 && l < maxUpkeepLogs {
+		return l
+	}
+	return maxUpkeepLogs
+}
+
 // enqueue adds logs (if not exist) to the buffer, returning the number of logs added
 // minus the number of logs dropped.
 func (b *logEventBuffer) enqueue(id *big.Int, logs ...logpoller.Log) int {
@@ -185,7 +210,7 @@ func (b *logEventBuffer) enqueue(id *big.Int, logs ...logpoller.Log) int {
 	lggr := b.lggr.With("id", id.String())
 
 	maxBlockLogs := int(atomic.LoadUint32(&b.fastExecLogsHigh) * atomic.LoadUint32(&b.numOfLogUpkeeps))
-	maxUpkeepLogs := int(atomic.LoadUint32(&b.fastExecLogsHigh))
+	maxUpkeepLogs := b.maxUpkeepLogsPerBlock(id.String())
 
 	latestBlock := b.latestBlockSeen()
 	added, dropped := 0, 0
@@ -205,9 +230,10 @@ func (b *logEventBuffer) enqueue(id *big.Int, logs ...logpoller.Log) int {
 		} else if currentBlock.blockNumber > log.BlockNumber {
 			// not expected to happen
 			lggr.Debugw("Skipping log from old block", "currentBlock", currentBlock.blockNumber, "newBlock", log.BlockNumber)
+			prommetrics.AutomationLogBufferDroppedLogs.WithLabelValues(b.upkeepLimits.metricLabel(id.String()), dropReasonOldBlock).Inc()
 			continue
 		}
-		droppedLog, ok := currentBlock.Append(lggr, fetchedLog{upkeepID: id, log: log}, maxBlockLogs, maxUpkeepLogs)
+		droppedLog, reason, ok := currentBlock.Append(lggr, fetchedLog{upkeepID: id, log: log}, maxBlockLogs, maxUpkeepLogs)
 		if !ok {
 			// Skipping known logs
 			continue
@@ -216,7 +242,8 @@ func (b *logEventBuffer) enqueue(id *big.Int, logs ...logpoller.Log) int {
 			dropped++
 			lggr.Debugw("Reached log buffer limits, dropping log", "blockNumber", droppedLog.log.BlockNumber,
 				"blockHash", droppedLog.log.BlockHash, "txHash", droppedLog.log.TxHash, "logIndex", droppedLog.log.LogIndex,
-				"upkeepID", droppedLog.upkeepID.String())
+				"upkeepID", droppedLog.upkeepID.String(), "reason", reason)
+			prommetrics.AutomationLogBufferDroppedLogs.WithLabelValues(b.upkeepLimits.metricLabel(droppedLog.upkeepID.String()), reason).Inc()
 		}
 		added++
 		b.blocks[i] = currentBlock
@@ -276,6 +303,8 @@ func (b *logEventBuffer) peekRange(start, end int64) []fetchedLog {
 }
 
 // dequeueRange returns the logs between start and end inclusive.
+// Logs of upkeeps with higher priority are dequeued first, and within the same priority
+// the latest blocks are preferred, using a random order of logs that is shared across all nodes.
 func (b *logEventBuffer) dequeueRange(start, end int64, upkeepLimit, totalLimit int) []fetchedLog {
 	b.lock.Lock()
 	defer b.lock.Unlock()
@@ -283,6 +312,10 @@ func (b *logEventBuffer) dequeueRange(start, end int64, upkeepLimit, totalLimit
 	blocksInRange := b.getBlocksInRange(int(start), int(end))
 	fetchedBlocks := make([]fetchedBlock, 0, len(blocksInRange))
 	for _, block := range blocksInRange {
+		if block.blockNumber < start || block.blockNumber > end {
+			// double checking that we don't have any gaps in the range
+			continue
+		}
 		// Create clone of the blocks as they get processed and update underlying b.blocks
 		fetchedBlocks = append(fetchedBlocks, block.Clone())
 	}
@@ -293,48 +326,73 @@ func (b *logEventBuffer) dequeueRange(start, end int64, upkeepLimit, totalLimit
 		return fetchedBlocks[i].blockNumber > fetchedBlocks[j].blockNumber
 	})
 
+	// candidates holds the logs of all blocks, ordered by block and then by the
+	// random order of logs that is shared across all nodes.
+	// This ensures that nodes across the network will process the same logs.
+	type candidate struct {
+		block    int
+		log      fetchedLog
+		priority uint8
+	}
+	var candidates []candidate
+	for i := range fetchedBlocks {
+		fetchedBlocks[i].Sort()
+		for _, log := range fetchedBlocks[i].logs {
+			candidates = append(candidates, candidate{block: i, log: log, priority: b.upkeepLimits[log.upkeepID.String()].Priority})
+		}
+	}
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return candidates[order[i]].priority > candidates[order[j]].priority
+	})
+
 	logsCount := map[string]int{}
-	totalCount := 0
+	selected := make([]bool, len(candidates))
 	var results []fetchedLog
-	for _, block := range fetchedBlocks {
-		if block.blockNumber < start || block.blockNumber > end {
-			// double checking that we don't have any gaps in the range
-			continue
-		}
-		if totalCount >= totalLimit {
-			// reached total limit, no need to process more blocks
+	for _, i := range order {
+		if len(results) >= totalLimit {
 			break
 		}
-		// Sort the logs in random order that is shared across all nodes.
-		// This ensures that nodes across the network will process the same logs.
-		block.Sort()
-		var remainingLogs, blockResults []fetchedLog
-		for _, log := range block.logs {
-			if totalCount >= totalLimit {
-				remainingLogs = append(remainingLogs, log)
-				continue
-			}
-			if logsCount[log.upkeepID.String()] >= upkeepLimit {
-				remainingLogs = append(remainingLogs, log)
-				continue
-			}
-			blockResults = append(blockResults, log)
-			logsCount[log.upkeepID.String()]++
-			totalCount++
+		uid := candidates[i].log.upkeepID.String()
+		limit := upkeepLimit
+		if l := b.upkeepLimits[uid].MaxLogsPerRound; l > 0 && l < limit {
+			limit = l
 		}
-		if len(blockResults) == 0 {
+		if logsCount[uid] >= limit {
 			continue
 		}
-		results = append(results, blockResults...)
-		block.visited = append(block.visited, blockResults...)
-		block.logs = remainingLogs
-		b.blocks[b.blockNumberIndex(block.blockNumber)] = block
+		selected[i] = true
+		results = append(results, candidates[i].log)
+		logsCount[uid]++
+	}
+	if len(results) == 0 {
+		return nil
 	}
 
-	if len(results) > 0 {
-		b.lggr.Debugw("Dequeued logs", "results", len(results), "start", start, "end", end)
-		prommetrics.AutomationLogsInLogBuffer.Sub(float64(len(results)))
+	remainingLogs := make([][]fetchedLog, len(fetchedBlocks))
+	dequeued := make([]bool, len(fetchedBlocks))
+	for i, c := range candidates {
+		block := &fetchedBlocks[c.block]
+		if selected[i] {
+			block.visited = append(block.visited, c.log)
+			dequeued[c.block] = true
+			continue
+		}
+		remainingLogs[c.block] = append(remainingLogs[c.block], c.log)
 	}
+	for i, block := range fetchedBlocks {
+		if !dequeued[i] {
+			continue
+		}
+		block.logs = remainingLogs[i]
+		b.blocks[b.blockNumberIndex(block.blockNumber)] = block
+	}
+
+	b.lggr.Debugw("Dequeued logs", "results", len(results), "start", start, "end", end)
+	prommetrics.AutomationLogsInLogBuffer.Sub(float64(len(results)))
 
 	return results
 }
@@ -395,6 +395,96 @@ func TestLogEventBuffer_EnqueueDequeue(t *testing.T) {
 	})
 }
 
+func TestLogEventBuffer_UpkeepLimits(t *testing.T) {
+	t.Run("enqueue with upkeep block limit", func(t *testing.T) {
+		buf := newLogEventBuffer(logger.TestLogger(t), 3, 10, 10)
+		buf.upkeepLimits = UpkeepLogLimits{"1": {MaxLogsPerBlock: 2}}
+
+		require.Equal(t, 2, buf.enqueue(big.NewInt(1),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 1},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 2},
+		))
+		require.Equal(t, 3, buf.enqueue(big.NewInt(2),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 1},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 2},
+		))
+		buf.lock.Lock()
+		require.Equal(t, 5, len(buf.blocks[0].logs))
+		buf.lock.Unlock()
+	})
+
+	t.Run("upkeep block limit can't exceed the global limit", func(t *testing.T) {
+		buf := newLogEventBuffer(logger.TestLogger(t), 3, 10, 2)
+		buf.upkeepLimits = UpkeepLogLimits{"1": {MaxLogsPerBlock: 5}}
+
+		require.Equal(t, 2, buf.enqueue(big.NewInt(1),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 1},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 2},
+		))
+	})
+
+	t.Run("dequeue with upkeep round limit", func(t *testing.T) {
+		buf := newLogEventBuffer(logger.TestLogger(t), 3, 10, 10)
+		buf.upkeepLimits = UpkeepLogLimits{"1": {MaxLogsPerRound: 1}}
+
+		buf.enqueue(big.NewInt(1),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 2, TxHash: common.HexToHash("0x1"), LogIndex: 1},
+		)
+		buf.enqueue(big.NewInt(2),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 2, TxHash: common.HexToHash("0x2"), LogIndex: 1},
+		)
+
+		results := buf.dequeueRange(1, 2, 5, 10)
+		require.Equal(t, 3, len(results))
+		results = buf.dequeueRange(1, 2, 5, 10)
+		require.Equal(t, 1, len(results))
+		require.Equal(t, int64(1), results[0].upkeepID.Int64())
+	})
+
+	t.Run("upkeep round limit can't exceed the global limit", func(t *testing.T) {
+		buf := newLogEventBuffer(logger.TestLogger(t), 3, 10, 10)
+		buf.upkeepLimits = UpkeepLogLimits{"1": {MaxLogsPerRound: 5}}
+
+		buf.enqueue(big.NewInt(1),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x1"), LogIndex: 1},
+			logpoller.Log{BlockNumber: 2, TxHash: common.HexToHash("0x1"), LogIndex: 2},
+		)
+
+		results := buf.dequeueRange(1, 2, 2, 10)
+		require.Equal(t, 2, len(results))
+	})
+
+	t.Run("dequeue by priority", func(t *testing.T) {
+		buf := newLogEventBuffer(logger.TestLogger(t), 3, 10, 10)
+		buf.upkeepLimits = UpkeepLogLimits{"2": {Priority: 1}}
+
+		buf.enqueue(big.NewInt(1),
+			logpoller.Log{BlockNumber: 2, TxHash: common.HexToHash("0x1"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 2, TxHash: common.HexToHash("0x1"), LogIndex: 1},
+		)
+		buf.enqueue(big.NewInt(2),
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 0},
+			logpoller.Log{BlockNumber: 1, TxHash: common.HexToHash("0x2"), LogIndex: 1},
+		)
+
+		results := buf.dequeueRange(1, 2, 5, 3)
+		require.Equal(t, 3, len(results))
+		require.Equal(t, int64(2), results[0].upkeepID.Int64())
+		require.Equal(t, int64(2), results[1].upkeepID.Int64())
+		require.Equal(t, int64(1), results[2].upkeepID.Int64())
+
+		results = buf.dequeueRange(1, 2, 5, 3)
+		require.Equal(t, 1, len(results))
+		require.Equal(t, int64(1), results[0].upkeepID.Int64())
+	})
+}
+
 func TestLogEventBuffer_FetchedBlock_Append(t *testing.T) {
 	type appendArgs struct {
 		fl                          fetchedLog
@@ -659,7 +749,7 @@ func TestLogEventBuffer_FetchedBlock_Append(t *testing.T) {
 			copy(b.visited, tc.visited)
 
 			for _, args := range tc.toAdd {
-				dropped, added := b.Append(lggr, args.fl, args.maxBlockLogs, args.maxUpkeepLogs)
+				dropped, _, added := b.Append(lggr, args.fl, args.maxBlockLogs, args.maxUpkeepLogs)
 				require.Equal(t, args.added, added)
 				if args.dropped {
 					require.NotNil(t, dropped.upkeepID)
@@ -13,12 +13,13 @@ import (
 
 // New creates a new log event provider and recoverer.
 // using default values for the options.
-func New(lggr logger.Logger, poller logpoller.LogPoller, c client.Client, stateStore core.UpkeepStateReader, finalityDepth uint32) (LogEventProvider, LogRecoverer) {
+func New(lggr logger.Logger, poller logpoller.LogPoller, c client.Client, stateStore core.UpkeepStateReader, backfills BackfillORM, upkeepLimits UpkeepLogLimits, finalityDepth uint32) (LogEventProvider, LogRecoverer) {
 	filterStore := NewUpkeepFilterStore()
 	packer := NewLogEventsPacker()
 	opts := NewOptions(int64(finalityDepth))
+	opts.UpkeepLimits = upkeepLimits
 	provider := NewLogProvider(lggr, poller, packer, filterStore, opts)
-	recoverer := NewLogRecoverer(lggr, poller, c, stateStore, packer, filterStore, opts)
+	recoverer := NewLogRecoverer(lggr, poller, c, stateStore, packer, filterStore, backfills, opts)
 
 	return provider, recoverer
 }
@@ -37,6 +38,8 @@ type LogTriggersOptions struct {
 	BlockLimitBurst int
 	// Finality depth is the number of blocks to wait before considering a block final.
 	FinalityDepth int64
+	// UpkeepLimits holds the log limits of specific upkeeps, which can't exceed the global limits.
+	UpkeepLimits UpkeepLogLimits
 }
 
 func NewOptions(finalityDepth int64) LogTriggersOptions {
@@ -679,7 +679,7 @@ func setup(lggr logger.Logger, poller logpoller.LogPoller, c client.Client, stat
 		opts = &o
 	}
 	provider := logprovider.NewLogProvider(lggr, poller, packer, filterStore, *opts)
-	recoverer := logprovider.NewLogRecoverer(lggr, poller, c, stateStore, packer, filterStore, *opts)
+	recoverer := logprovider.NewLogRecoverer(lggr, poller, c, stateStore, packer, filterStore, nil, *opts)
 
 	return provider, recoverer
 }
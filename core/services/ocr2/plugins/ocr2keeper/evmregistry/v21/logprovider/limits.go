@@ -0,0 +1,70 @@
+package logprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// otherUpkeepsLabel is the upkeepID metric label of upkeeps without configured limits,
+// which keeps the cardinality of the per upkeep metrics bounded.
+const otherUpkeepsLabel = "other"
+
+// LogLimits are the limits applied to the logs of a single log trigger upkeep.
+// Zero values fall back to the global limits, and the limits can't exceed them.
+type LogLimits struct {
+	// MaxLogsPerRound is the maximum number of logs of the upkeep returned by a single call to GetLatestPayloads.
+	MaxLogsPerRound int `json:"maxLogsPerRound"`
+	// MaxLogsPerBlock is the maximum number of logs of the upkeep kept in the buffer for a single block.
+	MaxLogsPerBlock int `json:"maxLogsPerBlock"`
+	// Priority orders the logs of upkeeps when not all of them fit in a single call, higher goes first.
+	Priority uint8 `json:"priority"`
+}
+
+// UpkeepLogLimits holds the log limits of upkeeps, by upkeep ID in decimal.
+// They are set in the plugin config of the automation job, so the operators of
+// the DON are expected to apply the same limits on all the nodes.
+type UpkeepLogLimits map[string]LogLimits
+
+// Validate returns an error if an upkeep ID or its limits are invalid.
+func (l UpkeepLogLimits) Validate() error {
+	for id, limits := range l {
+		if _, ok := new(big.Int).SetString(id, 10); !ok {
+			return fmt.Errorf("invalid upkeep id %q in log limits", id)
+		}
+		if limits.MaxLogsPerRound < 0 || limits.MaxLogsPerBlock < 0 {
+			return fmt.Errorf("invalid log limits of upkeep %s: negative value in %+v", id, limits)
+		}
+	}
+	return nil
+}
+
+// metricLabel returns the upkeepID label of the per upkeep metrics, which is
+// the upkeep ID only for upkeeps with configured limits.
+func (l UpkeepLogLimits) metricLabel(id string) string {
+	if _, ok := l[id]; ok {
+		return id
+	}
+	return otherUpkeepsLabel
+}
+
+// pluginConfig is the part of the automation plugin config read by the log provider.
+type pluginConfig struct {
+	LogLimits UpkeepLogLimits `json:"logLimits"`
+}
+
+// ParseLogLimits parses the upkeep log limits from the plugin config of an automation job.
+// An empty config, or a config without log limits, results in no upkeep limits.
+func ParseLogLimits(rawPluginConfig []byte) (UpkeepLogLimits, error) {
+	if len(rawPluginConfig) == 0 {
+		return nil, nil
+	}
+	var cfg pluginConfig
+	if err := json.Unmarshal(rawPluginConfig, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse plugin config: %w", err)
+	}
+	if err := cfg.LogLimits.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg.LogLimits, nil
+}
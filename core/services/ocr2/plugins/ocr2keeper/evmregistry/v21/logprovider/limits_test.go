@@ -0,0 +1,52 @@
+package logprovider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLimits(t *testing.T) {
+	tests := []struct {
+		name         string
+		pluginConfig string
+		want         UpkeepLogLimits
+		wantErr      bool
+	}{
+		{"empty config", "", nil, false},
+		{"config without log limits", `{"maxServiceWorkers":100}`, nil, false},
+		{
+			"config with log limits",
+			`{"logLimits":{"1234":{"maxLogsPerRound":2,"maxLogsPerBlock":4,"priority":3},"5678":{"priority":1}}}`,
+			UpkeepLogLimits{
+				"1234": {MaxLogsPerRound: 2, MaxLogsPerBlock: 4, Priority: 3},
+				"5678": {Priority: 1},
+			},
+			false,
+		},
+		{"negative limit", `{"logLimits":{"1234":{"maxLogsPerRound":-1}}}`, nil, true},
+		{"invalid upkeep id", `{"logLimits":{"0x1234":{"priority":1}}}`, nil, true},
+		{"invalid config", "0x1234", nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limits, err := ParseLogLimits([]byte(tc.pluginConfig))
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, limits)
+		})
+	}
+}
+
+func TestUpkeepLogLimits_metricLabel(t *testing.T) {
+	limits := UpkeepLogLimits{"1234": {Priority: 1}}
+
+	assert.Equal(t, "1234", limits.metricLabel("1234"))
+	assert.Equal(t, otherUpkeepsLabel, limits.metricLabel("5678"))
+	assert.Equal(t, otherUpkeepsLabel, UpkeepLogLimits(nil).metricLabel("1234"))
+}
@@ -187,5 +187,9 @@ func (p *logEventProvider) validateLogTriggerConfig(cfg LogTriggerConfig) error
 }
 
 func (p *logEventProvider) filterName(upkeepID *big.Int) string {
+	return upkeepFilterName(upkeepID)
+}
+
+func upkeepFilterName(upkeepID *big.Int) string {
 	return logpoller.FilterName("KeepersRegistry LogUpkeep", upkeepID.String())
 }
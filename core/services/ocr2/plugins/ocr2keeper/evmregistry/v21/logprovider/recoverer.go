@@ -53,6 +53,8 @@ var (
 	// maxPendingPayloadsPerUpkeep is the number of logs we can have pending for a single upkeep
 	// at any given time
 	maxPendingPayloadsPerUpkeep = 500
+	// backfillBatchSize is the number of backfill requests processed in a single recovery round
+	backfillBatchSize = 10
 )
 
 type LogRecoverer interface {
@@ -84,18 +86,20 @@ type logRecoverer struct {
 	visited map[string]visitedRecord
 
 	filterStore       UpkeepFilterStore
+	backfills         BackfillORM
 	states            core.UpkeepStateReader
 	packer            LogDataPacker
 	poller            logpoller.LogPoller
 	client            client.Client
 	blockTimeResolver *blockTimeResolver
+	upkeepLimits      UpkeepLogLimits
 
 	finalityDepth int64
 }
 
 var _ LogRecoverer = &logRecoverer{}
 
-func NewLogRecoverer(lggr logger.Logger, poller logpoller.LogPoller, client client.Client, stateStore core.UpkeepStateReader, packer LogDataPacker, filterStore UpkeepFilterStore, opts LogTriggersOptions) *logRecoverer {
+func NewLogRecoverer(lggr logger.Logger, poller logpoller.LogPoller, client client.Client, stateStore core.UpkeepStateReader, packer LogDataPacker, filterStore UpkeepFilterStore, backfills BackfillORM, opts LogTriggersOptions) *logRecoverer {
 	rec := &logRecoverer{
 		lggr: lggr.Named(LogRecovererServiceName),
 
@@ -109,10 +113,12 @@ func NewLogRecoverer(lggr logger.Logger, poller logpoller.LogPoller, client clie
 		visited:           make(map[string]visitedRecord),
 		poller:            poller,
 		filterStore:       filterStore,
+		backfills:         backfills,
 		states:            stateStore,
 		packer:            packer,
 		client:            client,
 		blockTimeResolver: newBlockTimeResolver(poller),
+		upkeepLimits:      opts.UpkeepLimits,
 
 		finalityDepth: opts.FinalityDepth,
 	}
@@ -124,7 +130,7 @@ func NewLogRecoverer(lggr logger.Logger, poller logpoller.LogPoller, client clie
 }
 
 // Start starts the log recoverer, which runs 3 threads in the background:
-// 1. Recovery thread: scans for logs that were missed by the log poller
+// 1. Recovery thread: scans for logs that were missed by the log poller, and processes backfill requests
 // 2. Cleanup thread: cleans up the cache of logs that were already processed
 // 3. Block time thread: updates the block time of the chain
 func (r *logRecoverer) Start(ctx context.Context) error {
@@ -143,6 +149,9 @@ func (r *logRecoverer) Start(ctx context.Context) error {
 					if err := r.recover(ctx); err != nil {
 						r.lggr.Warnw("failed to recover logs", "err", err)
 					}
+					if err := r.backfill(ctx); err != nil {
+						r.lggr.Warnw("failed to backfill logs", "err", err)
+					}
 				case <-ctx.Done():
 					return
 				}
@@ -393,8 +402,32 @@ func (r *logRecoverer) recoverFilter(ctx context.Context, f upkeepFilter, startB
 	if err != nil {
 		return fmt.Errorf("could not read logs: %w", err)
 	}
-	logs = f.Select(logs...)
+	filteredLogs, err := r.selectUnfinalizedLogs(ctx, f, f.Select(logs...))
+	if err != nil {
+		return err
+	}
+
+	added, alreadyPending, ok := r.populatePending(f, filteredLogs)
+	if added > 0 {
+		r.lggr.Debugw("found missed logs", "added", added, "alreadyPending", alreadyPending, "upkeepID", f.upkeepID)
+		prommetrics.AutomationRecovererMissedLogs.Add(float64(added))
+		prommetrics.AutomationRecovererLateLogs.WithLabelValues(r.upkeepLimits.metricLabel(f.upkeepID.String())).Add(float64(added))
+	}
+	if !ok {
+		r.lggr.Debugw("failed to add all logs to pending", "upkeepID", f.upkeepID)
+		return nil
+	}
+	r.filterStore.UpdateFilters(func(uf1, uf2 upkeepFilter) upkeepFilter {
+		uf1.lastRePollBlock = end
+		r.lggr.Debugw("Updated lastRePollBlock", "lastRePollBlock", end, "upkeepID", uf1.upkeepID)
+		return uf1
+	}, f)
 
+	return nil
+}
+
+// selectUnfinalizedLogs returns the logs of the upkeep that were not performed or found ineligible yet.
+func (r *logRecoverer) selectUnfinalizedLogs(ctx context.Context, f upkeepFilter, logs []logpoller.Log) ([]logpoller.Log, error) {
 	workIDs := make([]string, 0)
 	for _, log := range logs {
 		trigger := logToTrigger(log)
@@ -409,31 +442,141 @@ func (r *logRecoverer) recoverFilter(ctx context.Context, f upkeepFilter, startB
 
 	states, err := r.states.SelectByWorkIDs(ctx, workIDs...)
 	if err != nil {
-		return fmt.Errorf("could not read states: %w", err)
+		return nil, fmt.Errorf("could not read states: %w", err)
 	}
 	if len(logs) != len(states) {
-		return fmt.Errorf("log and state count mismatch: %d != %d", len(logs), len(states))
+		return nil, fmt.Errorf("log and state count mismatch: %d != %d", len(logs), len(states))
 	}
-	filteredLogs := r.filterFinalizedStates(f, logs, states)
+	return r.filterFinalizedStates(f, logs, states), nil
+}
 
-	added, alreadyPending, ok := r.populatePending(f, filteredLogs)
-	if added > 0 {
-		r.lggr.Debugw("found missed logs", "added", added, "alreadyPending", alreadyPending, "upkeepID", f.upkeepID)
-		prommetrics.AutomationRecovererMissedLogs.Add(float64(added))
+// backfill processes pending backfill requests, re-delivering the logs of the requested
+// block ranges that were not performed yet. Requests are processed once their whole block
+// range is within the recovery window, as more recent logs are still handled by the provider.
+// Requests which can no longer be recovered are marked as failed.
+func (r *logRecoverer) backfill(ctx context.Context) error {
+	if r.backfills == nil {
+		return nil
 	}
-	if !ok {
-		r.lggr.Debugw("failed to add all logs to pending", "upkeepID", f.upkeepID)
+	backfills, err := r.backfills.SelectPendingBackfills(backfillBatchSize, pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get pending backfills: %w", err)
+	}
+	if len(backfills) == 0 {
 		return nil
 	}
-	r.filterStore.UpdateFilters(func(uf1, uf2 upkeepFilter) upkeepFilter {
-		uf1.lastRePollBlock = end
-		r.lggr.Debugw("Updated lastRePollBlock", "lastRePollBlock", end, "upkeepID", uf1.upkeepID)
-		return uf1
-	}, f)
+	latest, err := r.poller.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrHeadNotAvailable, err)
+	}
+	start, offsetBlock := r.getRecoveryWindow(latest.BlockNumber)
+
+	for _, b := range backfills {
+		if b.ToBlock >= offsetBlock {
+			r.lggr.Debugw("backfill range is not recoverable yet", "id", b.ID, "upkeepID", b.UpkeepID, "toBlock", b.ToBlock, "offsetBlock", offsetBlock)
+			continue
+		}
+		if b.ToBlock <= start {
+			if err := r.failBackfill(ctx, b, ErrBackfillNotRecoverable); err != nil {
+				return err
+			}
+			continue
+		}
+		added, err := r.backfillUpkeep(ctx, b, start)
+		if errors.Is(err, ErrBackfillNotRecoverable) {
+			if err := r.failBackfill(ctx, b, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			// the request stays pending and is retried in the next round,
+			// until its block range is no longer recoverable
+			r.lggr.Warnw("failed to backfill upkeep", "id", b.ID, "upkeepID", b.UpkeepID, "err", err)
+			continue
+		}
+		r.lggr.Infow("backfilled upkeep logs", "id", b.ID, "upkeepID", b.UpkeepID, "fromBlock", b.FromBlock, "toBlock", b.ToBlock, "added", added)
+		if err := r.backfills.MarkBackfillProcessed(b.ID, added, pg.WithParentCtx(ctx)); err != nil {
+			return fmt.Errorf("failed to mark backfill %d as processed: %w", b.ID, err)
+		}
+	}
 
 	return nil
 }
 
+func (r *logRecoverer) failBackfill(ctx context.Context, b Backfill, reason error) error {
+	r.lggr.Warnw("backfill failed", "id", b.ID, "upkeepID", b.UpkeepID, "fromBlock", b.FromBlock, "toBlock", b.ToBlock, "reason", reason)
+	if err := r.backfills.MarkBackfillFailed(b.ID, reason.Error(), pg.WithParentCtx(ctx)); err != nil {
+		return fmt.Errorf("failed to mark backfill %d as failed: %w", b.ID, err)
+	}
+	return nil
+}
+
+// backfillUpkeep adds the unfinalized logs of the backfill block range to the pending payloads,
+// regardless of whether they were visited before. Returns the number of logs added.
+func (r *logRecoverer) backfillUpkeep(ctx context.Context, b Backfill, startBlock int64) (int, error) {
+	f := r.filterStore.Get(b.UpkeepID.ToInt())
+	if f == nil || len(f.addr) == 0 {
+		// the filter might not be registered yet, e.g. right after the node started
+		return 0, errNoActiveFilter
+	}
+	from, to := b.FromBlock, b.ToBlock
+	// ensure we don't backfill logs from before the filter was created or which are too old to recover
+	if configUpdateBlock := int64(f.configUpdateBlock); from < configUpdateBlock {
+		from = configUpdateBlock
+	}
+	if from <= startBlock {
+		from = startBlock + 1
+	}
+	if from > to {
+		return 0, fmt.Errorf("%w: block range ends before the upkeep trigger config was set at block %d", ErrBackfillNotRecoverable, f.configUpdateBlock)
+	}
+	logs, err := r.poller.LogsWithSigs(from, to, f.topics, common.BytesToAddress(f.addr), pg.WithParentCtx(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("could not read logs: %w", err)
+	}
+	filteredLogs, err := r.selectUnfinalizedLogs(ctx, *f, f.Select(logs...))
+	if err != nil {
+		return 0, err
+	}
+
+	upkeepId := &ocr2keepers.UpkeepIdentifier{}
+	if !upkeepId.FromBigInt(f.upkeepID) {
+		return 0, fmt.Errorf("failed to convert upkeepID %s to UpkeepIdentifier", f.upkeepID)
+	}
+	r.lock.Lock()
+	for _, log := range filteredLogs {
+		delete(r.visited, core.UpkeepWorkID(*upkeepId, logToTrigger(log)))
+	}
+	r.lock.Unlock()
+
+	added, _, _ := r.populatePending(*f, filteredLogs)
+	return added, nil
+}
+
+// ValidateBackfill checks that the logs of the block range can be re-delivered to the upkeep
+// by the recoverer of a registry on the chain of poller, i.e. that the upkeep has a log filter
+// and the block range is not older than the recovery window.
+func ValidateBackfill(ctx context.Context, poller logpoller.LogPoller, finalityDepth uint32, upkeepID *big.Int, fromBlock, toBlock int64) error {
+	if !poller.HasFilter(upkeepFilterName(upkeepID)) {
+		return fmt.Errorf("%w: upkeep has no log filter", ErrBackfillNotRecoverable)
+	}
+	latest, err := poller.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrHeadNotAvailable, err)
+	}
+	blockTime, err := newBlockTimeResolver(poller).BlockTime(ctx, defaultSampleSize)
+	if err != nil || blockTime <= 0 {
+		blockTime = defaultBlockTime
+	}
+	opts := NewOptions(int64(finalityDepth))
+	start, _ := recoveryWindow(latest.BlockNumber, int64(blockTime), opts.LookbackBlocks, opts.FinalityDepth)
+	if toBlock <= start {
+		return fmt.Errorf("%w: block range ends before the recovery window starts at block %d", ErrBackfillNotRecoverable, start+1)
+	}
+	return nil
+}
+
 // populatePending adds the logs to the pending list if they are not already pending.
 // returns the number of logs added, the number of logs that were already pending,
 // and a flag that indicates whether some errors happened while we are trying to add to pending q.
@@ -500,14 +643,16 @@ func (r *logRecoverer) filterFinalizedStates(_ upkeepFilter, logs []logpoller.Lo
 
 // getRecoveryWindow returns the block range of which the recoverer will try work on
 func (r *logRecoverer) getRecoveryWindow(latest int64) (int64, int64) {
-	lookbackBlocks := r.lookbackBlocks.Load()
-	blockTime := r.blockTime.Load()
+	return recoveryWindow(latest, r.blockTime.Load(), r.lookbackBlocks.Load(), r.finalityDepth)
+}
+
+func recoveryWindow(latest, blockTime, lookbackBlocks, finalityDepth int64) (int64, int64) {
 	blocksInDay := int64(24*time.Hour) / blockTime
 	start := latest - blocksInDay
 	// Exploratory: Instead of subtracting finality depth to account for finalized performs
 	// keep two pointers of lastRePollBlock for soft and hard finalization, i.e. manage
 	// unfinalized perform logs better
-	end := latest - lookbackBlocks - r.finalityDepth
+	end := latest - lookbackBlocks - finalityDepth
 	if start > end {
 		// In this case, allow starting from more than a day behind
 		start = end
@@ -35,7 +35,7 @@ func TestLogRecoverer_GetRecoverables(t *testing.T) {
 	ctx := testutils.Context(t)
 	lp := &lpmocks.LogPoller{}
 	lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
-	r := NewLogRecoverer(logger.TestLogger(t), lp, nil, nil, nil, nil, NewOptions(200))
+	r := NewLogRecoverer(logger.TestLogger(t), lp, nil, nil, nil, nil, nil, NewOptions(200))
 
 	tests := []struct {
 		name    string
@@ -457,6 +457,133 @@ func TestLogRecoverer_Recover(t *testing.T) {
 	}
 }
 
+func TestLogRecoverer_Backfill(t *testing.T) {
+	ctx := testutils.Context(t)
+	upkeepID := core.GenUpkeepID(types2.LogTrigger, "1")
+	filter := upkeepFilter{
+		upkeepID: upkeepID.BigInt(),
+		addr:     common.HexToAddress("0x1").Bytes(),
+		topics: []common.Hash{
+			common.HexToHash("0x1"),
+		},
+	}
+	logs := []logpoller.Log{
+		{BlockNumber: 550, TxHash: common.HexToHash("0x111"), LogIndex: 1, BlockHash: common.HexToHash("0x550")},
+		{BlockNumber: 560, TxHash: common.HexToHash("0x222"), LogIndex: 1, BlockHash: common.HexToHash("0x560")},
+	}
+	visitedWorkID := core.UpkeepWorkID(upkeepID, logToTrigger(logs[0]))
+
+	t.Run("re-delivers unfinalized logs of the block range", func(t *testing.T) {
+		recoverer, filterStore, lp, statesReader := setupTestRecoverer(t, time.Millisecond*50, int64(100))
+		backfills := &mockBackfillORM{pending: []Backfill{{ID: 1, UpkeepID: ubig.New(upkeepID.BigInt()), FromBlock: 500, ToBlock: 600}}}
+		recoverer.backfills = backfills
+		recoverer.visited[visitedWorkID] = visitedRecord{visitedAt: time.Now()}
+
+		filterStore.AddActiveUpkeeps(filter)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+		lp.On("LogsWithSigs", int64(500), int64(600), mock.Anything, mock.Anything, mock.Anything).Return(logs, nil)
+		statesReader.On("SelectByWorkIDs", mock.Anything, mock.Anything, mock.Anything).Return([]ocr2keepers.UpkeepState{ocr2keepers.UnknownState, ocr2keepers.Performed}, nil)
+
+		require.NoError(t, recoverer.backfill(ctx))
+		require.Equal(t, map[int64]int{1: 1}, backfills.processed)
+
+		proposals, err := recoverer.GetRecoveryProposals(ctx)
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+		require.Equal(t, visitedWorkID, proposals[0].WorkID)
+	})
+
+	t.Run("waits for the block range to be recoverable", func(t *testing.T) {
+		recoverer, filterStore, lp, _ := setupTestRecoverer(t, time.Millisecond*50, int64(100))
+		backfills := &mockBackfillORM{pending: []Backfill{{ID: 1, UpkeepID: ubig.New(upkeepID.BigInt()), FromBlock: 500, ToBlock: 900}}}
+		recoverer.backfills = backfills
+
+		filterStore.AddActiveUpkeeps(filter)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+
+		require.NoError(t, recoverer.backfill(ctx))
+		require.Empty(t, backfills.processed)
+	})
+
+	t.Run("upkeep without filter stays pending", func(t *testing.T) {
+		recoverer, _, lp, _ := setupTestRecoverer(t, time.Millisecond*50, int64(100))
+		backfills := &mockBackfillORM{pending: []Backfill{{ID: 1, UpkeepID: ubig.New(upkeepID.BigInt()), FromBlock: 500, ToBlock: 600}}}
+		recoverer.backfills = backfills
+
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+
+		require.NoError(t, recoverer.backfill(ctx))
+		require.Empty(t, backfills.processed)
+		require.Empty(t, backfills.failed)
+	})
+
+	t.Run("fails block range older than the recovery window", func(t *testing.T) {
+		recoverer, filterStore, lp, _ := setupTestRecoverer(t, time.Millisecond*50, int64(100))
+		backfills := &mockBackfillORM{pending: []Backfill{{ID: 1, UpkeepID: ubig.New(upkeepID.BigInt()), FromBlock: 500, ToBlock: 600}}}
+		recoverer.backfills = backfills
+		// the recovery window starts at block 800
+		recoverer.blockTime.Store(int64(time.Hour))
+
+		filterStore.AddActiveUpkeeps(filter)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+
+		require.NoError(t, recoverer.backfill(ctx))
+		require.Empty(t, backfills.processed)
+		require.Contains(t, backfills.failed, int64(1))
+	})
+
+	t.Run("fails block range before the upkeep trigger config", func(t *testing.T) {
+		recoverer, filterStore, lp, _ := setupTestRecoverer(t, time.Millisecond*50, int64(100))
+		backfills := &mockBackfillORM{pending: []Backfill{{ID: 1, UpkeepID: ubig.New(upkeepID.BigInt()), FromBlock: 500, ToBlock: 600}}}
+		recoverer.backfills = backfills
+
+		f := filter
+		f.configUpdateBlock = 700
+		filterStore.AddActiveUpkeeps(f)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+
+		require.NoError(t, recoverer.backfill(ctx))
+		require.Empty(t, backfills.processed)
+		require.Contains(t, backfills.failed, int64(1))
+	})
+}
+
+func TestValidateBackfill(t *testing.T) {
+	ctx := testutils.Context(t)
+	upkeepID := core.GenUpkeepID(types2.LogTrigger, "1").BigInt()
+
+	t.Run("upkeep without filter", func(t *testing.T) {
+		lp := new(lpmocks.LogPoller)
+		lp.On("HasFilter", upkeepFilterName(upkeepID)).Return(false)
+
+		err := ValidateBackfill(ctx, lp, 100, upkeepID, 500, 600)
+		require.ErrorIs(t, err, ErrBackfillNotRecoverable)
+	})
+
+	t.Run("block range within the recovery window", func(t *testing.T) {
+		lp := new(lpmocks.LogPoller)
+		lp.On("HasFilter", upkeepFilterName(upkeepID)).Return(true)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 1000}, nil)
+
+		require.NoError(t, ValidateBackfill(ctx, lp, 100, upkeepID, 500, 600))
+	})
+
+	t.Run("block range older than the recovery window", func(t *testing.T) {
+		lp := new(lpmocks.LogPoller)
+		lp.On("HasFilter", upkeepFilterName(upkeepID)).Return(true)
+		lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100_000}, nil)
+		// 12s block time, the recovery window starts at block 92_800
+		now := time.Now()
+		lp.On("GetBlocksRange", mock.Anything, []uint64{90_000, 100_000}).Return([]logpoller.LogPollerBlock{
+			{BlockNumber: 90_000, BlockTimestamp: now.Add(-12 * 10_000 * time.Second)},
+			{BlockNumber: 100_000, BlockTimestamp: now},
+		}, nil)
+
+		err := ValidateBackfill(ctx, lp, 100, upkeepID, 500, 600)
+		require.ErrorIs(t, err, ErrBackfillNotRecoverable)
+	})
+}
+
 func TestLogRecoverer_SelectFilterBatch(t *testing.T) {
 	n := recoveryBatchSize*2 + 2
 	filters := []upkeepFilter{}
@@ -1153,7 +1280,7 @@ func TestLogRecoverer_pending(t *testing.T) {
 				maxPendingPayloadsPerUpkeep = origMaxPendingPayloadsPerUpkeep
 			}()
 
-			r := NewLogRecoverer(logger.TestLogger(t), nil, nil, nil, nil, nil, NewOptions(200))
+			r := NewLogRecoverer(logger.TestLogger(t), nil, nil, nil, nil, nil, nil, NewOptions(200))
 			r.lock.Lock()
 			r.pending = tc.exist
 			for i, p := range tc.new {
@@ -1230,6 +1357,41 @@ func (r *mockStateReader) SelectByWorkIDs(ctx context.Context, workIDs ...string
 	return r.SelectByWorkIDsFn(ctx, workIDs...)
 }
 
+type mockBackfillORM struct {
+	BackfillORM
+	pending   []Backfill
+	processed map[int64]int
+	failed    map[int64]string
+}
+
+func (o *mockBackfillORM) SelectPendingBackfills(limit int, qopts ...pg.QOpt) ([]Backfill, error) {
+	var pending []Backfill
+	for _, b := range o.pending {
+		_, processed := o.processed[b.ID]
+		_, failed := o.failed[b.ID]
+		if !processed && !failed {
+			pending = append(pending, b)
+		}
+	}
+	return pending, nil
+}
+
+func (o *mockBackfillORM) MarkBackfillFailed(id int64, reason string, qopts ...pg.QOpt) error {
+	if o.failed == nil {
+		o.failed = map[int64]string{}
+	}
+	o.failed[id] = reason
+	return nil
+}
+
+func (o *mockBackfillORM) MarkBackfillProcessed(id int64, logs int, qopts ...pg.QOpt) error {
+	if o.processed == nil {
+		o.processed = map[int64]int{}
+	}
+	o.processed[id] = logs
+	return nil
+}
+
 func setupTestRecoverer(t *testing.T, interval time.Duration, lookbackBlocks int64) (*logRecoverer, UpkeepFilterStore, *lpmocks.LogPoller, *mocks.UpkeepStateReader) {
 	lp := new(lpmocks.LogPoller)
 	statesReader := new(mocks.UpkeepStateReader)
@@ -1237,6 +1399,6 @@ func setupTestRecoverer(t *testing.T, interval time.Duration, lookbackBlocks int
 	opts := NewOptions(lookbackBlocks)
 	opts.ReadInterval = interval / 5
 	opts.LookbackBlocks = lookbackBlocks
-	recoverer := NewLogRecoverer(logger.TestLogger(t), lp, nil, statesReader, &mockedPacker{}, filterStore, opts)
+	recoverer := NewLogRecoverer(logger.TestLogger(t), lp, nil, statesReader, &mockedPacker{}, filterStore, nil, opts)
 	return recoverer, filterStore, lp, statesReader
 }
@@ -15,11 +15,21 @@ var (
 		Name:      "num_logs_in_log_buffer",
 		Help:      "The total number of logs currently being stored in the log buffer",
 	})
+	AutomationLogBufferDroppedLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: AutomationLogTriggerNamespace,
+		Name:      "num_dropped_logs",
+		Help:      "How many logs of an upkeep were dropped by the log buffer, by the reason they were dropped",
+	}, []string{"upkeepID", "reason"})
 	AutomationRecovererMissedLogs = promauto.NewCounter(prometheus.CounterOpts{
 		Namespace: AutomationLogTriggerNamespace,
 		Name:      "num_recoverer_missed_logs",
 		Help:      "How many valid log triggers were identified as being missed by the recoverer",
 	})
+	AutomationRecovererLateLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: AutomationLogTriggerNamespace,
+		Name:      "num_recoverer_late_logs",
+		Help:      "How many valid log triggers of an upkeep were missed by the log provider and found late by the recoverer",
+	}, []string{"upkeepID"})
 	AutomationRecovererPendingPayloads = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: AutomationLogTriggerNamespace,
 		Name:      "num_recoverer_pending_payloads",
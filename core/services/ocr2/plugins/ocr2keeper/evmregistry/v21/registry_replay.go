@@ -0,0 +1,169 @@
+package evm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	iregistry21 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// PerformedUpkeep is a perform of an upkeep on the registry. WorkID identifies
+// the check which found the upkeep eligible.
+type PerformedUpkeep struct {
+	WorkID      string
+	TxHash      common.Hash
+	BlockNumber int64
+	CheckBlock  uint64
+	Success     bool
+}
+
+// ReplayLog identifies the log which triggered a log trigger upkeep.
+type ReplayLog struct {
+	TxHash   common.Hash
+	LogIndex int64
+}
+
+// NewReplayRegistry creates a registry which is only used to inspect upkeeps
+// and replay their checks. It is never started, so block hashes are verified
+// against the log poller and the RPC instead of the block subscriber.
+func NewReplayRegistry(lggr logger.Logger, addr common.Address, chain legacyevm.Chain, mc *commontypes.MercuryCredentials) (*EvmRegistry, error) {
+	registry, err := iregistry21.NewIKeeperRegistryMaster(addr, chain.Client())
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create caller for address and backend", ErrInitializationFailure)
+	}
+	finalityDepth := chain.Config().EVM().FinalityDepth()
+	bs := NewBlockSubscriber(chain.HeadBroadcaster(), chain.LogPoller(), finalityDepth, lggr)
+	return NewEvmRegistry(lggr.Named("Replay"), addr, chain, registry, mc, NewActiveUpkeepList(), nil,
+		encoding.NewAbiPacker(), bs, finalityDepth), nil
+}
+
+// ReplayCheck runs the check pipeline for an upkeep at the given check block,
+// reproducing the decision taken for it at that block. Log trigger upkeeps are
+// checked with the given log, which must be stored by the log poller.
+func (r *EvmRegistry) ReplayCheck(ctx context.Context, upkeepID *big.Int, checkBlock int64, l *ReplayLog) (ocr2keepers.CheckResult, error) {
+	blockHash, err := r.getBlockHash(big.NewInt(checkBlock))
+	if err != nil {
+		return ocr2keepers.CheckResult{}, fmt.Errorf("failed to get hash of check block %d: %w", checkBlock, err)
+	}
+	trigger := ocr2keepers.NewTrigger(ocr2keepers.BlockNumber(checkBlock), blockHash)
+
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	if !uid.FromBigInt(upkeepID) {
+		return ocr2keepers.CheckResult{}, core.ErrInvalidUpkeepID
+	}
+	var checkData []byte
+	if core.GetUpkeepType(*uid) == types.LogTrigger {
+		if l == nil {
+			return ocr2keepers.CheckResult{}, fmt.Errorf("log trigger upkeep %s requires the log to check", upkeepID)
+		}
+		log, err := r.findTriggerLog(ctx, upkeepID, *l)
+		if err != nil {
+			return ocr2keepers.CheckResult{}, err
+		}
+		trigger.LogTriggerExtension = &ocr2keepers.LogTriggerExtension{
+			TxHash:      log.TxHash,
+			Index:       uint32(log.LogIndex),
+			BlockHash:   log.BlockHash,
+			BlockNumber: ocr2keepers.BlockNumber(log.BlockNumber),
+		}
+		checkData, err = logprovider.NewLogEventsPacker().PackLogData(log)
+		if err != nil {
+			return ocr2keepers.CheckResult{}, fmt.Errorf("failed to pack log data: %w", err)
+		}
+	}
+
+	payload, err := core.NewUpkeepPayload(upkeepID, trigger, checkData)
+	if err != nil {
+		return ocr2keepers.CheckResult{}, err
+	}
+	results, err := r.CheckUpkeeps(ctx, payload)
+	if err != nil {
+		return ocr2keepers.CheckResult{}, err
+	}
+	if len(results) != 1 {
+		return ocr2keepers.CheckResult{}, fmt.Errorf("expected 1 check result, got %d", len(results))
+	}
+	return results[0], nil
+}
+
+// findTriggerLog finds the log matching the trigger config of a log trigger
+// upkeep in the log poller.
+func (r *EvmRegistry) findTriggerLog(ctx context.Context, upkeepID *big.Int, l ReplayLog) (logpoller.Log, error) {
+	raw, err := r.fetchTriggerConfig(upkeepID)
+	if err != nil {
+		return logpoller.Log{}, fmt.Errorf("failed to fetch log upkeep config: %w", err)
+	}
+	cfg, err := r.packer.UnpackLogTriggerConfig(raw)
+	if err != nil {
+		return logpoller.Log{}, fmt.Errorf("failed to unpack log upkeep config: %w", err)
+	}
+	logs, err := r.poller.IndexedLogsByTxHash(cfg.Topic0, cfg.ContractAddress, l.TxHash, pg.WithParentCtx(ctx))
+	if err != nil {
+		return logpoller.Log{}, fmt.Errorf("failed to get logs of tx %s: %w", l.TxHash, err)
+	}
+	for _, log := range logs {
+		if log.LogIndex == l.LogIndex {
+			return log, nil
+		}
+	}
+	return logpoller.Log{}, fmt.Errorf("log %d of tx %s not found for upkeep %s", l.LogIndex, l.TxHash, upkeepID)
+}
+
+// LastPerformed returns the most recent perform of an upkeep stored by the log
+// poller, and false if the upkeep has not been performed.
+func (r *EvmRegistry) LastPerformed(ctx context.Context, upkeepID *big.Int) (PerformedUpkeep, bool, error) {
+	last, err := r.poller.LatestIndexedLog(iregistry21.IKeeperRegistryMasterUpkeepPerformed{}.Topic(), r.addr, 1, []common.Hash{common.BigToHash(upkeepID)}, logpoller.Unconfirmed, pg.WithParentCtx(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return PerformedUpkeep{}, false, nil
+	}
+	if err != nil {
+		return PerformedUpkeep{}, false, fmt.Errorf("failed to get last perform log of upkeep %s: %w", upkeepID, err)
+	}
+	parsed, err := r.registry.ParseLog(last.ToGethLog())
+	if err != nil {
+		return PerformedUpkeep{}, false, fmt.Errorf("failed to parse perform log: %w", err)
+	}
+	performed, ok := parsed.(*iregistry21.IKeeperRegistryMasterUpkeepPerformed)
+	if !ok {
+		return PerformedUpkeep{}, false, fmt.Errorf("unexpected perform log type %T", parsed)
+	}
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	if !uid.FromBigInt(upkeepID) {
+		return PerformedUpkeep{}, false, core.ErrInvalidUpkeepID
+	}
+	triggerW, err := core.UnpackTrigger(upkeepID, performed.Trigger)
+	if err != nil {
+		return PerformedUpkeep{}, false, fmt.Errorf("failed to unpack trigger of perform log: %w", err)
+	}
+	trigger := ocr2keepers.NewTrigger(ocr2keepers.BlockNumber(triggerW.BlockNum), triggerW.BlockHash)
+	if core.GetUpkeepType(*uid) == types.LogTrigger {
+		trigger.LogTriggerExtension = &ocr2keepers.LogTriggerExtension{
+			TxHash:    triggerW.TxHash,
+			Index:     triggerW.LogIndex,
+			BlockHash: triggerW.LogBlockHash,
+		}
+	}
+	return PerformedUpkeep{
+		WorkID:      core.UpkeepWorkID(*uid, trigger),
+		TxHash:      last.TxHash,
+		BlockNumber: last.BlockNumber,
+		CheckBlock:  uint64(triggerW.BlockNum),
+		Success:     performed.Success,
+	}, true, nil
+}
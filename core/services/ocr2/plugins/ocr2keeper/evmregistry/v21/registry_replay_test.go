@@ -0,0 +1,125 @@
+package evm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	types3 "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	evmClientMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/automation_utils_2_1"
+	iregistry21 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/i_keeper_registry_master_wrapper_2_1"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/mercury/streams"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestRegistry_ReplayCheck(t *testing.T) {
+	conditionalID := core.GenUpkeepID(types3.ConditionTrigger, "c0")
+	logTriggerID := core.GenUpkeepID(types3.LogTrigger, "l0")
+	blockHash := common.HexToHash("0x9840e5b709bfccf6a1b44f34c884bc39403f57923f3f5ead6243cc090546b857")
+
+	setup := func(t *testing.T, blocks []logpoller.LogPollerBlock) (*EvmRegistry, *evmClientMocks.Client) {
+		e := setupEVMRegistry(t)
+		e.threadCtrl = utils.NewThreadControl()
+		e.bs = NewBlockSubscriber(nil, nil, 0, e.lggr)
+		e.poller = &mockLogPoller{
+			GetBlocksRangeFn: func(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]logpoller.LogPollerBlock, error) {
+				return blocks, nil
+			},
+		}
+		client := evmClientMocks.NewClient(t)
+		e.client = client
+		e.streams = streams.NewStreamsLookup(e.mercury, e.bs, client, nil, e.lggr)
+		return e, client
+	}
+
+	t.Run("conditional upkeep", func(t *testing.T) {
+		e, client := setup(t, []logpoller.LogPollerBlock{{BlockNumber: 100, BlockHash: blockHash}})
+		client.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && b[0].Method == "eth_call" && b[0].Args[1] == "0x64"
+		})).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).([]rpc.BatchElem)[0].Error = fmt.Errorf("error")
+		}).Once()
+
+		result, err := e.ReplayCheck(testutils.Context(t), conditionalID.BigInt(), 100, nil)
+		require.NoError(t, err)
+		assert.Equal(t, conditionalID, result.UpkeepID)
+		assert.Equal(t, ocr2keepers.NewTrigger(100, blockHash), result.Trigger)
+		assert.Equal(t, core.UpkeepWorkID(conditionalID, result.Trigger), result.WorkID)
+		assert.False(t, result.Eligible)
+		assert.True(t, result.Retryable)
+		assert.Equal(t, uint8(encoding.RpcFlakyFailure), result.PipelineExecutionState)
+	})
+
+	t.Run("check block not found", func(t *testing.T) {
+		e, _ := setup(t, nil)
+
+		_, err := e.ReplayCheck(testutils.Context(t), conditionalID.BigInt(), 100, nil)
+		assert.ErrorContains(t, err, "failed to get hash of check block 100")
+	})
+
+	t.Run("log trigger upkeep without log", func(t *testing.T) {
+		e, _ := setup(t, []logpoller.LogPollerBlock{{BlockNumber: 100, BlockHash: blockHash}})
+
+		_, err := e.ReplayCheck(testutils.Context(t), logTriggerID.BigInt(), 100, nil)
+		assert.ErrorContains(t, err, "requires the log to check")
+	})
+}
+
+func TestRegistry_LastPerformed(t *testing.T) {
+	upkeepID := core.GenUpkeepID(types3.ConditionTrigger, "c0")
+	topic := iregistry21.IKeeperRegistryMasterUpkeepPerformed{}.Topic()
+
+	t.Run("not performed", func(t *testing.T) {
+		e := setupEVMRegistry(t)
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("LatestIndexedLog", topic, e.addr, 1, []common.Hash{common.BigToHash(upkeepID.BigInt())}, logpoller.Unconfirmed, mock.Anything).Return(nil, sql.ErrNoRows).Once()
+		e.poller = lp
+
+		_, ok, err := e.LastPerformed(testutils.Context(t), upkeepID.BigInt())
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("performed", func(t *testing.T) {
+		e := setupEVMRegistry(t)
+		txHash := common.HexToHash("0x1")
+		log := logpoller.Log{BlockNumber: 110, LogIndex: 2, TxHash: txHash}
+		lp := lpmocks.NewLogPoller(t)
+		lp.On("LatestIndexedLog", topic, e.addr, 1, []common.Hash{common.BigToHash(upkeepID.BigInt())}, logpoller.Unconfirmed, mock.Anything).Return(&log, nil).Once()
+		e.poller = lp
+		trigger, err := core.PackTrigger(upkeepID.BigInt(), automation_utils_2_1.KeeperRegistryBase21LogTrigger{BlockNum: 100})
+		require.NoError(t, err)
+		e.registry.(*mocks.Registry).On("ParseLog", log.ToGethLog()).Return(&iregistry21.IKeeperRegistryMasterUpkeepPerformed{
+			Success: true,
+			Trigger: trigger,
+		}, nil).Once()
+
+		performed, ok, err := e.LastPerformed(testutils.Context(t), upkeepID.BigInt())
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, PerformedUpkeep{
+			WorkID:      core.UpkeepWorkID(upkeepID, ocr2keepers.NewTrigger(100, common.Hash{})),
+			TxHash:      txHash,
+			BlockNumber: 110,
+			CheckBlock:  100,
+			Success:     true,
+		}, performed)
+	})
+}
@@ -86,6 +86,24 @@ func (o *orm) SelectStatesByWorkIDs(workIDs []string, qopts ...pg.QOpt) (states
 	return states, err
 }
 
+// SelectStatesByUpkeepID returns up to limit of the most recent stored states
+// of the provided upkeep id and configured chain id, newest first
+func (o *orm) SelectStatesByUpkeepID(upkeepID *big.Int, limit int, qopts ...pg.QOpt) (states []persistedStateRecord, err error) {
+	q := o.q.WithOpts(qopts...)
+
+	err = q.Select(&states, `SELECT upkeep_id, work_id, completion_state, block_number, ineligibility_reason, inserted_at
+	  FROM evm.upkeep_states
+	  WHERE upkeep_id = $1 AND evm_chain_id = $2::NUMERIC
+	  ORDER BY block_number DESC, inserted_at DESC
+	  LIMIT $3`, ubig.New(upkeepID), o.chainID, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return states, err
+}
+
 // DeleteExpired prunes stored states older than to the provided time
 func (o *orm) DeleteExpired(expired time.Time, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
@@ -50,3 +50,31 @@ func TestInsertSelectDelete(t *testing.T) {
 	require.NoError(t, err, "no error expected from select")
 	require.Len(t, states, 0, "records return should be empty since records were deleted")
 }
+
+func TestSelectStatesByUpkeepID(t *testing.T) {
+	lggr, _ := logger.TestLoggerObserved(t, zapcore.ErrorLevel)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr, pgtest.NewQConfig(true))
+
+	now := time.Now()
+	err := orm.BatchInsertRecords([]persistedStateRecord{
+		{UpkeepID: ubig.New(big.NewInt(1)), WorkID: "0x1", CompletionState: 2, BlockNumber: 10, IneligibilityReason: 4, InsertedAt: now},
+		{UpkeepID: ubig.New(big.NewInt(1)), WorkID: "0x2", CompletionState: 2, BlockNumber: 12, IneligibilityReason: 6, InsertedAt: now},
+		{UpkeepID: ubig.New(big.NewInt(1)), WorkID: "0x3", CompletionState: 2, BlockNumber: 11, IneligibilityReason: 4, InsertedAt: now},
+		{UpkeepID: ubig.New(big.NewInt(2)), WorkID: "0x4", CompletionState: 2, BlockNumber: 13, IneligibilityReason: 4, InsertedAt: now},
+	})
+	require.NoError(t, err)
+
+	states, err := orm.SelectStatesByUpkeepID(big.NewInt(1), 2)
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.Equal(t, "0x2", states[0].WorkID)
+	assert.Equal(t, int64(12), states[0].BlockNumber)
+	assert.Equal(t, uint8(6), states[0].IneligibilityReason)
+	assert.Equal(t, "0x3", states[1].WorkID)
+
+	states, err = orm.SelectStatesByUpkeepID(big.NewInt(3), 10)
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
@@ -229,6 +229,7 @@ func (u *upkeepStateStore) upsertStateRecord(ctx context.Context, workID string,
 		UpkeepID:            ubig.New(upkeepID),
 		WorkID:              record.workID,
 		CompletionState:     uint8(record.state),
+		BlockNumber:         int64(b),
 		IneligibilityReason: reason,
 		InsertedAt:          record.addedAt,
 	})
@@ -4,13 +4,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	decryptionPlugin "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+var (
+	promPendingRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "threshold_decryption_queue_pending_requests",
+		Help: "Number of ciphertexts in the decryption queue awaiting decryption by the DON",
+	}, []string{"jobID"})
+	promOldestPendingRequestAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "threshold_decryption_queue_oldest_pending_request_age_seconds",
+		Help: "Age of the oldest ciphertext in the decryption queue awaiting decryption by the DON",
+	}, []string{"jobID"})
+	promCompletionLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "threshold_decryption_queue_completion_latency_seconds",
+		Help:    "Time from queueing a ciphertext to receiving its decryption result from the DON",
+		Buckets: []float64{0.5, 1, 2, 5, 10, 20, 30, 60, 120, 300},
+	}, []string{"jobID"})
 )
 
 //go:generate mockery --quiet --name Decryptor --output ./mocks/ --case=underscore
@@ -19,8 +40,11 @@ type Decryptor interface {
 }
 
 type pendingRequest struct {
+	// chPlaintext is nil for requests restored from the ORM or whose caller
+	// was cancelled, until Decrypt is called again for them.
 	chPlaintext chan<- []byte
 	ciphertext  []byte
+	createdAt   time.Time
 }
 
 type completedRequest struct {
@@ -29,13 +53,16 @@ type completedRequest struct {
 }
 
 type decryptionQueue struct {
+	jobID                         string
 	maxQueueLength                int
 	maxCiphertextBytes            int
 	maxCiphertextIdLen            int
 	completedRequestsCacheTimeout time.Duration
+	pendingRequestTimeout         time.Duration
 	pendingRequestQueue           []decryptionPlugin.CiphertextId
 	pendingRequests               map[string]pendingRequest
 	completedRequests             map[string]completedRequest
+	orm                           ORM
 	mu                            sync.RWMutex
 	lggr                          logger.Logger
 }
@@ -46,17 +73,22 @@ var (
 	_ job.ServiceCtx                            = &decryptionQueue{}
 )
 
-func NewDecryptionQueue(maxQueueLength int, maxCiphertextBytes int, maxCiphertextIdLen int, completedRequestsCacheTimeout time.Duration, lggr logger.Logger) *decryptionQueue {
+// NewDecryptionQueue returns a queue of ciphertexts awaiting decryption by the
+// DON of job jobID. If orm is not nil, pending ciphertexts are persisted and
+// restored on Start, unless they are older than pendingRequestTimeout.
+func NewDecryptionQueue(jobID int32, maxQueueLength int, maxCiphertextBytes int, maxCiphertextIdLen int, completedRequestsCacheTimeout time.Duration, pendingRequestTimeout time.Duration, orm ORM, lggr logger.Logger) *decryptionQueue {
 	dq := decryptionQueue{
-		maxQueueLength,
-		maxCiphertextBytes,
-		maxCiphertextIdLen,
-		completedRequestsCacheTimeout,
-		[]decryptionPlugin.CiphertextId{},
-		make(map[string]pendingRequest),
-		make(map[string]completedRequest),
-		sync.RWMutex{},
-		lggr.Named("DecryptionQueue"),
+		jobID:                         strconv.Itoa(int(jobID)),
+		maxQueueLength:                maxQueueLength,
+		maxCiphertextBytes:            maxCiphertextBytes,
+		maxCiphertextIdLen:            maxCiphertextIdLen,
+		completedRequestsCacheTimeout: completedRequestsCacheTimeout,
+		pendingRequestTimeout:         pendingRequestTimeout,
+		pendingRequestQueue:           []decryptionPlugin.CiphertextId{},
+		pendingRequests:               make(map[string]pendingRequest),
+		completedRequests:             make(map[string]completedRequest),
+		orm:                           orm,
+		lggr:                          lggr.Named("DecryptionQueue"),
 	}
 	return &dq
 }
@@ -78,10 +110,13 @@ func (dq *decryptionQueue) Decrypt(ctx context.Context, ciphertextId decryptionP
 		return nil, errors.New("ciphertext is empty")
 	}
 
-	chPlaintext, err := dq.getResult(ciphertextId, ciphertext)
+	chPlaintext, added, err := dq.getResult(ciphertextId, ciphertext)
 	if err != nil {
 		return nil, err
 	}
+	if added != nil {
+		dq.persist(ciphertextId, ciphertext, *added)
+	}
 
 	select {
 	case pt, ok := <-chPlaintext:
@@ -92,12 +127,23 @@ func (dq *decryptionQueue) Decrypt(ctx context.Context, ciphertextId decryptionP
 	case <-ctx.Done():
 		dq.mu.Lock()
 		defer dq.mu.Unlock()
-		delete(dq.pendingRequests, string(ciphertextId))
+		if req, ok := dq.pendingRequests[string(ciphertextId)]; ok {
+			if dq.orm != nil {
+				// Callers are also cancelled when the node shuts down, so persisted
+				// requests are kept until the DON decrypts them or they expire.
+				req.chPlaintext = nil
+				dq.pendingRequests[string(ciphertextId)] = req
+			} else {
+				delete(dq.pendingRequests, string(ciphertextId))
+			}
+		}
 		return nil, errors.New("context provided by caller was cancelled")
 	}
 }
 
-func (dq *decryptionQueue) getResult(ciphertextId decryptionPlugin.CiphertextId, ciphertext []byte) (<-chan []byte, error) {
+// getResult returns the channel the plaintext of a ciphertext is sent to, and
+// the creation time of the pending request if it was added to the queue.
+func (dq *decryptionQueue) getResult(ciphertextId decryptionPlugin.CiphertextId, ciphertext []byte) (<-chan []byte, *time.Time, error) {
 	dq.mu.Lock()
 	defer dq.mu.Unlock()
 
@@ -109,32 +155,70 @@ func (dq *decryptionQueue) getResult(ciphertextId decryptionPlugin.CiphertextId,
 		chPlaintext <- req.plaintext
 		req.timer.Stop()
 		delete(dq.completedRequests, string(ciphertextId))
-		return chPlaintext, nil
+		return chPlaintext, nil, nil
 	}
 
-	_, isDuplicateId := dq.pendingRequests[string(ciphertextId)]
+	pending, isDuplicateId := dq.pendingRequests[string(ciphertextId)]
 	if isDuplicateId {
-		return nil, errors.New("ciphertextId must be unique")
+		if pending.chPlaintext != nil {
+			return nil, nil, errors.New("ciphertextId must be unique")
+		}
+		dq.lggr.Debugf("ciphertextId %s is still pending", ciphertextId)
+		pending.chPlaintext = chPlaintext
+		dq.pendingRequests[string(ciphertextId)] = pending
+		return chPlaintext, nil, nil
 	}
 
 	if len(dq.pendingRequestQueue) >= dq.maxQueueLength {
-		return nil, errors.New("queue is full")
+		return nil, nil, errors.New("queue is full")
 	}
 	dq.pendingRequestQueue = append(dq.pendingRequestQueue, ciphertextId)
 
+	createdAt := time.Now()
 	dq.pendingRequests[string(ciphertextId)] = pendingRequest{
-		chPlaintext,
-		ciphertext,
+		chPlaintext: chPlaintext,
+		ciphertext:  ciphertext,
+		createdAt:   createdAt,
 	}
 	dq.lggr.Debugf("ciphertextId %s added to pendingRequestQueue", ciphertextId)
 
-	return chPlaintext, nil
+	return chPlaintext, &createdAt, nil
+}
+
+// persist inserts a pending request added to the queue, outside of the lock of
+// the queue. If the request was completed meanwhile, its deletion may have
+// preceded the insert, so the inserted request is deleted again.
+func (dq *decryptionQueue) persist(ciphertextId decryptionPlugin.CiphertextId, ciphertext []byte, createdAt time.Time) {
+	if dq.orm == nil {
+		return
+	}
+	if err := dq.orm.InsertPendingRequest(ciphertextId, ciphertext, createdAt); err != nil {
+		dq.lggr.Errorw("failed to persist pending decryption request", "ciphertextId", ciphertextId.String(), "err", err)
+		return
+	}
+	dq.mu.RLock()
+	_, pending := dq.pendingRequests[string(ciphertextId)]
+	dq.mu.RUnlock()
+	if !pending {
+		dq.deletePersisted(ciphertextId)
+	}
 }
 
 func (dq *decryptionQueue) GetRequests(requestCountLimit int, totalBytesLimit int) []decryptionPlugin.DecryptionRequest {
+	requests, expired := dq.getRequests(requestCountLimit, totalBytesLimit)
+	dq.deletePersisted(expired...)
+	return requests
+}
+
+// getRequests returns the requests awaiting decryption, and the IDs of the
+// restored requests which expired.
+func (dq *decryptionQueue) getRequests(requestCountLimit int, totalBytesLimit int) ([]decryptionPlugin.DecryptionRequest, [][]byte) {
 	dq.mu.Lock()
 	defer dq.mu.Unlock()
 
+	expired := dq.expireRestoredRequests()
+	dq.setPendingRequestsMetrics()
+
 	requests := make([]decryptionPlugin.DecryptionRequest, 0, requestCountLimit)
 	totalBytes := 0
 	indicesToRemove := make(map[int]struct{})
@@ -177,7 +261,7 @@ func (dq *decryptionQueue) GetRequests(requestCountLimit int, totalBytesLimit in
 		dq.lggr.Debug("no requests awaiting decryption")
 	}
 
-	return requests
+	return requests, expired
 }
 
 func removeMultipleIndices[T any](data []T, indicesToRemove map[int]struct{}) []T {
@@ -205,16 +289,28 @@ func (dq *decryptionQueue) GetCiphertext(ciphertextId decryptionPlugin.Ciphertex
 }
 
 func (dq *decryptionQueue) SetResult(ciphertextId decryptionPlugin.CiphertextId, plaintext []byte, err error) {
+	if dq.setResult(ciphertextId, plaintext, err) {
+		dq.deletePersisted(ciphertextId)
+	}
+}
+
+// setResult completes the pending request of a ciphertext, and returns true if
+// there was one.
+func (dq *decryptionQueue) setResult(ciphertextId decryptionPlugin.CiphertextId, plaintext []byte, err error) bool {
 	dq.mu.Lock()
 	defer dq.mu.Unlock()
 
 	if err == nil && plaintext == nil {
 		dq.lggr.Errorf("received nil error and nil plaintext for ciphertextId %s", ciphertextId)
-		return
+		return false
 	}
 
 	req, ok := dq.pendingRequests[string(ciphertextId)]
 	if ok {
+		promCompletionLatency.WithLabelValues(dq.jobID).Observe(time.Since(req.createdAt).Seconds())
+		delete(dq.pendingRequests, string(ciphertextId))
+	}
+	if ok && req.chPlaintext != nil {
 		if err != nil {
 			dq.lggr.Debugf("decryption error for ciphertextId %s", ciphertextId)
 		} else {
@@ -222,12 +318,11 @@ func (dq *decryptionQueue) SetResult(ciphertextId decryptionPlugin.CiphertextId,
 			req.chPlaintext <- plaintext
 		}
 		close(req.chPlaintext)
-		delete(dq.pendingRequests, string(ciphertextId))
 	} else {
 		if err != nil {
 			// This is currently possible only for ErrAggregation, encountered during Report() phase.
 			dq.lggr.Debugf("received decryption error for ciphertextId %s which doesn't exist locally", ciphertextId)
-			return
+			return false
 		}
 
 		// Cache plaintext result in completedRequests map for cacheTimeoutMs to account for delayed Decrypt() calls
@@ -244,15 +339,98 @@ func (dq *decryptionQueue) SetResult(ciphertextId decryptionPlugin.CiphertextId,
 			timer,
 		}
 	}
+	return ok
 }
 
+// Start restores the pending requests persisted before the node was
+// restarted, so that the node keeps taking part in their decryption.
 func (dq *decryptionQueue) Start(ctx context.Context) error {
+	if dq.orm == nil {
+		return nil
+	}
+
+	if dq.pendingRequestTimeout > 0 {
+		expired, err := dq.orm.DeletePendingRequestsBefore(time.Now().Add(-dq.pendingRequestTimeout), pg.WithParentCtx(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to delete expired pending decryption requests: %w", err)
+		}
+		if expired > 0 {
+			dq.lggr.Debugf("deleted %d expired pending decryption requests", expired)
+		}
+	}
+	persisted, err := dq.orm.FindPendingRequests(pg.WithParentCtx(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to load pending decryption requests: %w", err)
+	}
+
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	for _, req := range persisted {
+		if len(dq.pendingRequestQueue) >= dq.maxQueueLength {
+			dq.lggr.Warnf("queue is full, %d pending decryption requests were not restored", len(persisted)-len(dq.pendingRequestQueue))
+			break
+		}
+		dq.pendingRequestQueue = append(dq.pendingRequestQueue, req.CiphertextID)
+		dq.pendingRequests[string(req.CiphertextID)] = pendingRequest{
+			ciphertext: req.Ciphertext,
+			createdAt:  req.CreatedAt,
+		}
+	}
+	dq.lggr.Debugf("restored %d pending decryption requests", len(dq.pendingRequestQueue))
+	dq.setPendingRequestsMetrics()
 	return nil
 }
 
 func (dq *decryptionQueue) Close() error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
 	for _, completedRequest := range dq.completedRequests {
 		completedRequest.timer.Stop()
 	}
+	promPendingRequests.DeleteLabelValues(dq.jobID)
+	promOldestPendingRequestAge.DeleteLabelValues(dq.jobID)
 	return nil
 }
+
+// expireRestoredRequests removes restored requests which nobody on this node
+// is waiting for, once they are older than pendingRequestTimeout, and returns
+// their IDs.
+func (dq *decryptionQueue) expireRestoredRequests() (expired [][]byte) {
+	if dq.pendingRequestTimeout <= 0 {
+		return nil
+	}
+	for id, req := range dq.pendingRequests {
+		if req.chPlaintext == nil && time.Since(req.createdAt) > dq.pendingRequestTimeout {
+			dq.lggr.Debugf("decryption request for ciphertextId %s expired without a caller", decryptionPlugin.CiphertextId(id))
+			delete(dq.pendingRequests, id)
+			expired = append(expired, []byte(id))
+		}
+	}
+	return expired
+}
+
+func (dq *decryptionQueue) setPendingRequestsMetrics() {
+	var oldest time.Time
+	for _, req := range dq.pendingRequests {
+		if oldest.IsZero() || req.createdAt.Before(oldest) {
+			oldest = req.createdAt
+		}
+	}
+	promPendingRequests.WithLabelValues(dq.jobID).Set(float64(len(dq.pendingRequests)))
+	if oldest.IsZero() {
+		promOldestPendingRequestAge.WithLabelValues(dq.jobID).Set(0)
+	} else {
+		promOldestPendingRequestAge.WithLabelValues(dq.jobID).Set(time.Since(oldest).Seconds())
+	}
+}
+
+// deletePersisted deletes persisted pending requests. It must not be called
+// with the lock of the queue held.
+func (dq *decryptionQueue) deletePersisted(ciphertextIds ...[]byte) {
+	if dq.orm == nil || len(ciphertextIds) == 0 {
+		return
+	}
+	if err := dq.orm.DeletePendingRequests(ciphertextIds); err != nil {
+		dq.lggr.Errorw("failed to delete persisted pending decryption requests", "count", len(ciphertextIds), "err", err)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,13 +17,14 @@ import (
 	decryptionPlugin "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 )
 
 func Test_decryptionQueue_NewThresholdDecryptor(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(5, 1001, 64, 1002, lggr)
+	dq := NewDecryptionQueue(1, 5, 1001, 64, 1002, 0, nil, lggr)
 
 	assert.Equal(t, 5, dq.maxQueueLength)
 	assert.Equal(t, 1001, dq.maxCiphertextBytes)
@@ -29,7 +33,7 @@ func Test_decryptionQueue_NewThresholdDecryptor(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_ReturnResultAfterCallingDecrypt(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(5, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 5, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	go func() {
 		waitForPendingRequestToBeAdded(t, dq, []byte("1"))
@@ -48,7 +52,7 @@ func Test_decryptionQueue_Decrypt_ReturnResultAfterCallingDecrypt(t *testing.T)
 
 func Test_decryptionQueue_Decrypt_CiphertextIdTooLarge(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 16, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 16, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -59,7 +63,7 @@ func Test_decryptionQueue_Decrypt_CiphertextIdTooLarge(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_EmptyCiphertextId(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -70,7 +74,7 @@ func Test_decryptionQueue_Decrypt_EmptyCiphertextId(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_CiphertextTooLarge(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 10, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 10, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -81,7 +85,7 @@ func Test_decryptionQueue_Decrypt_CiphertextTooLarge(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_EmptyCiphertext(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -92,7 +96,7 @@ func Test_decryptionQueue_Decrypt_EmptyCiphertext(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_DuplicateCiphertextId(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -110,7 +114,7 @@ func Test_decryptionQueue_Decrypt_DuplicateCiphertextId(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_ContextCancelled(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 64, 100, lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 64, 100, 0, nil, lggr)
 
 	ctx, cancel := context.WithTimeout(testutils.Context(t), time.Duration(100)*time.Millisecond)
 	defer cancel()
@@ -121,7 +125,7 @@ func Test_decryptionQueue_Decrypt_ContextCancelled(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_QueueFull(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(1, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 1, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx1, cancel1 := context.WithCancel(testutils.Context(t))
 	defer cancel1()
@@ -142,7 +146,7 @@ func Test_decryptionQueue_Decrypt_QueueFull(t *testing.T) {
 
 func Test_decryptionQueue_GetRequests(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(3, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 3, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx1, cancel1 := context.WithCancel(testutils.Context(t))
 	defer cancel1()
@@ -177,7 +181,7 @@ func Test_decryptionQueue_GetRequests(t *testing.T) {
 
 func Test_decryptionQueue_GetCiphertext(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(3, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 3, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -198,7 +202,7 @@ func Test_decryptionQueue_GetCiphertext(t *testing.T) {
 
 func Test_decryptionQueue_GetCiphertext_CiphertextNotFound(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(3, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 3, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	_, err := dq.GetCiphertext([]byte{0xa5})
 	assert.True(t, errors.Is(err, decryptionPlugin.ErrNotFound))
@@ -206,7 +210,7 @@ func Test_decryptionQueue_GetCiphertext_CiphertextNotFound(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_DecryptCalledAfterReadyResult(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(2, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 2, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	dq.SetResult([]byte("9"), []byte("decrypted"), nil)
 
@@ -222,7 +226,7 @@ func Test_decryptionQueue_Decrypt_DecryptCalledAfterReadyResult(t *testing.T) {
 
 func Test_decryptionQueue_ReadyResult_ExpireRequest(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(2, 1000, 64, 100, lggr)
+	dq := NewDecryptionQueue(1, 2, 1000, 64, 100, 0, nil, lggr)
 
 	dq.SetResult([]byte("9"), []byte("decrypted"), nil)
 
@@ -237,7 +241,7 @@ func Test_decryptionQueue_ReadyResult_ExpireRequest(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_CleanupSuccessfulRequest(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(2, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 2, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	dq.SetResult([]byte("10"), []byte("decrypted"), nil)
 
@@ -256,7 +260,7 @@ func Test_decryptionQueue_Decrypt_CleanupSuccessfulRequest(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_UserErrorDuringDecryption(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(5, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 5, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 	ciphertextId := []byte{0x12, 0x0f}
 
 	go func() {
@@ -273,7 +277,7 @@ func Test_decryptionQueue_Decrypt_UserErrorDuringDecryption(t *testing.T) {
 
 func Test_decryptionQueue_Decrypt_HandleClosedChannelWithoutPlaintextResponse(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(5, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 5, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 	ciphertextId := []byte{0x00, 0xff}
 
 	go func() {
@@ -290,7 +294,7 @@ func Test_decryptionQueue_Decrypt_HandleClosedChannelWithoutPlaintextResponse(t
 
 func Test_decryptionQueue_GetRequests_RequestsCountLimit(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx1, cancel1 := context.WithCancel(testutils.Context(t))
 	defer cancel1()
@@ -334,7 +338,7 @@ func Test_decryptionQueue_GetRequests_RequestsCountLimit(t *testing.T) {
 
 func Test_decryptionQueue_GetRequests_TotalBytesLimit(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 10, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 10, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx1, cancel1 := context.WithCancel(testutils.Context(t))
 	defer cancel1()
@@ -378,7 +382,7 @@ func Test_decryptionQueue_GetRequests_TotalBytesLimit(t *testing.T) {
 
 func Test_decryptionQueue_GetRequests_PendingRequestQueueShorterThanRequestCountLimit(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -401,7 +405,7 @@ func Test_decryptionQueue_GetRequests_PendingRequestQueueShorterThanRequestCount
 
 func Test_decryptionQueue_GetRequests_ExpiredRequest(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 
@@ -423,7 +427,7 @@ func Test_decryptionQueue_GetRequests_ExpiredRequest(t *testing.T) {
 
 func Test_decryptionQueue_Start(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	ctx, cancel := context.WithCancel(testutils.Context(t))
 	defer cancel()
@@ -435,7 +439,7 @@ func Test_decryptionQueue_Start(t *testing.T) {
 
 func Test_decryptionQueue_Close(t *testing.T) {
 	lggr := logger.TestLogger(t)
-	dq := NewDecryptionQueue(4, 1000, 64, testutils.WaitTimeout(t), lggr)
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
 
 	dq.SetResult([]byte("14"), []byte("decrypted"), nil)
 
@@ -444,6 +448,197 @@ func Test_decryptionQueue_Close(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_decryptionQueue_PersistsPendingRequests(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	orm := newFakeORM()
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), time.Hour, orm, lggr)
+
+	go func() {
+		waitForPendingRequestToBeAdded(t, dq, []byte("1"))
+		waitForPendingRequestToBePersisted(t, orm, []byte("1"))
+		dq.SetResult([]byte("1"), []byte("decrypted"), nil)
+	}()
+
+	pt, err := dq.Decrypt(testutils.Context(t), []byte("1"), []byte("encrypted"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("decrypted"), pt)
+	assert.Nil(t, orm.ciphertext([]byte("1")), "completed requests are deleted")
+
+	ctx, cancel := context.WithCancel(testutils.Context(t))
+	go func() {
+		waitForPendingRequestToBeAdded(t, dq, []byte("2"))
+		waitForPendingRequestToBePersisted(t, orm, []byte("2"))
+		cancel()
+	}()
+	_, err = dq.Decrypt(ctx, []byte("2"), []byte("encrypted"))
+	require.Error(t, err)
+	assert.Equal(t, []byte("encrypted"), orm.ciphertext([]byte("2")), "cancelled requests are kept, e.g. on shutdown")
+	requests := dq.GetRequests(4, 1000)
+	require.Len(t, requests, 1)
+	assert.Equal(t, decryptionPlugin.CiphertextId("2"), requests[0].CiphertextId)
+
+	dq.SetResult([]byte("2"), []byte("decrypted"), nil)
+	assert.Nil(t, orm.ciphertext([]byte("2")), "completed requests are deleted")
+	pt, err = dq.Decrypt(testutils.Context(t), []byte("2"), []byte("encrypted"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("decrypted"), pt)
+}
+
+func Test_decryptionQueue_Start_RestoresPendingRequests(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	orm := newFakeORM()
+	require.NoError(t, orm.InsertPendingRequest([]byte("expired"), []byte("encrypted0"), time.Now().Add(-2*time.Hour)))
+	require.NoError(t, orm.InsertPendingRequest([]byte("1"), []byte("encrypted1"), time.Now().Add(-time.Minute)))
+	require.NoError(t, orm.InsertPendingRequest([]byte("2"), []byte("encrypted2"), time.Now()))
+
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), time.Hour, orm, lggr)
+	require.NoError(t, dq.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, dq.Close()) })
+
+	requests := dq.GetRequests(4, 1000)
+	require.Len(t, requests, 2)
+	assert.Equal(t, decryptionPlugin.CiphertextId("1"), requests[0].CiphertextId)
+	assert.Equal(t, []byte("encrypted2"), requests[1].Ciphertext)
+	assert.Nil(t, orm.ciphertext([]byte("expired")))
+
+	// A restored request is answered if it is decrypted again.
+	go func() {
+		waitForPendingRequestToBeAdded(t, dq, []byte("1"))
+		dq.SetResult([]byte("1"), []byte("decrypted1"), nil)
+	}()
+	pt, err := dq.Decrypt(testutils.Context(t), []byte("1"), []byte("encrypted1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("decrypted1"), pt)
+
+	// A restored request decrypted before being decrypted again is cached.
+	dq.SetResult([]byte("2"), []byte("decrypted2"), nil)
+	assert.Nil(t, orm.ciphertext([]byte("2")))
+	pt, err = dq.Decrypt(testutils.Context(t), []byte("2"), []byte("encrypted2"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("decrypted2"), pt)
+}
+
+func Test_decryptionQueue_GetRequests_ExpiresRestoredRequests(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	orm := newFakeORM()
+	require.NoError(t, orm.InsertPendingRequest([]byte("1"), []byte("encrypted1"), time.Now()))
+
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 50*time.Millisecond, orm, lggr)
+	require.NoError(t, dq.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, dq.Close()) })
+	require.Len(t, dq.GetRequests(4, 1000), 1)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, dq.GetRequests(4, 1000))
+	assert.Nil(t, orm.ciphertext([]byte("1")))
+}
+
+func Test_decryptionQueue_WritesOutsideOfLock(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	orm := newFakeORM()
+	require.NoError(t, orm.InsertPendingRequest([]byte("expired"), []byte("encrypted0"), time.Now()))
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 50*time.Millisecond, orm, lggr)
+	require.NoError(t, dq.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, dq.Close()) })
+
+	var writes atomic.Int32
+	orm.onWrite = func() {
+		writes.Add(1)
+		// Locking the queue blocks until the write returns if the write is made
+		// with the lock held.
+		locked := make(chan struct{})
+		go func() {
+			dq.mu.Lock()
+			dq.mu.Unlock()
+			close(locked)
+		}()
+		select {
+		case <-locked:
+		case <-time.After(time.Second):
+			assert.Fail(t, "the queue must not be locked while writing to the ORM")
+		}
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, dq.GetRequests(4, 1000))
+	go func() {
+		waitForPendingRequestToBeAdded(t, dq, []byte("1"))
+		waitForPendingRequestToBePersisted(t, orm, []byte("1"))
+		dq.SetResult([]byte("1"), []byte("decrypted"), nil)
+	}()
+	pt, err := dq.Decrypt(testutils.Context(t), []byte("1"), []byte("encrypted1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("decrypted"), pt)
+	assert.GreaterOrEqual(t, writes.Load(), int32(3), "expired, inserted and completed requests are written")
+}
+
+type fakeORM struct {
+	mu       sync.Mutex
+	requests map[string]PendingRequest
+	// onWrite is called before each write, if set.
+	onWrite func()
+}
+
+func (o *fakeORM) write() {
+	if o.onWrite != nil {
+		o.onWrite()
+	}
+}
+
+var _ ORM = (*fakeORM)(nil)
+
+func newFakeORM() *fakeORM {
+	return &fakeORM{requests: make(map[string]PendingRequest)}
+}
+
+func (o *fakeORM) ciphertext(ciphertextId []byte) []byte {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.requests[string(ciphertextId)].Ciphertext
+}
+
+func (o *fakeORM) InsertPendingRequest(ciphertextId []byte, ciphertext []byte, createdAt time.Time, _ ...pg.QOpt) error {
+	o.write()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.requests[string(ciphertextId)] = PendingRequest{CiphertextID: ciphertextId, Ciphertext: ciphertext, CreatedAt: createdAt}
+	return nil
+}
+
+func (o *fakeORM) DeletePendingRequests(ciphertextIds [][]byte, _ ...pg.QOpt) error {
+	o.write()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, id := range ciphertextIds {
+		delete(o.requests, string(id))
+	}
+	return nil
+}
+
+func (o *fakeORM) DeletePendingRequestsBefore(cutoff time.Time, _ ...pg.QOpt) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var deleted int64
+	for id, req := range o.requests {
+		if req.CreatedAt.Before(cutoff) {
+			delete(o.requests, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (o *fakeORM) FindPendingRequests(_ ...pg.QOpt) ([]PendingRequest, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	requests := make([]PendingRequest, 0, len(o.requests))
+	for _, req := range o.requests {
+		requests = append(requests, req)
+	}
+	sort.Slice(requests, func(i, j int) bool { return requests[i].CreatedAt.Before(requests[j].CreatedAt) })
+	return requests, nil
+}
+
 func waitForPendingRequestToBeAdded(t *testing.T, dq *decryptionQueue, ciphertextId decryptionPlugin.CiphertextId) {
 	gomega.NewGomegaWithT(t).Eventually(func() bool {
 		dq.mu.RLock()
@@ -453,6 +648,12 @@ func waitForPendingRequestToBeAdded(t *testing.T, dq *decryptionQueue, ciphertex
 	}, testutils.WaitTimeout(t), "10ms").Should(gomega.BeTrue(), "pending request should be added")
 }
 
+func waitForPendingRequestToBePersisted(t *testing.T, orm *fakeORM, ciphertextId decryptionPlugin.CiphertextId) {
+	gomega.NewGomegaWithT(t).Eventually(func() []byte {
+		return orm.ciphertext(ciphertextId)
+	}, testutils.WaitTimeout(t), "10ms").ShouldNot(gomega.BeNil(), "pending request should be persisted")
+}
+
 func waitForPendingRequestToBeRemoved(t *testing.T, dq *decryptionQueue, ciphertextId decryptionPlugin.CiphertextId) {
 	gomega.NewGomegaWithT(t).Eventually(func() bool {
 		dq.mu.RLock()
@@ -0,0 +1,81 @@
+package threshold
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// DecryptionQueues holds the decryption queues of the running jobs by job ID,
+// so that their pending requests can be listed whether they are persisted or
+// only kept in memory.
+type DecryptionQueues struct {
+	mu     sync.RWMutex
+	queues map[int32]*decryptionQueue
+}
+
+func NewDecryptionQueues() *DecryptionQueues {
+	return &DecryptionQueues{queues: make(map[int32]*decryptionQueue)}
+}
+
+// Service returns the service of the decryption queue of a job, which holds
+// the queue in qs while it runs. qs may be nil.
+func (qs *DecryptionQueues) Service(jobID int32, dq *decryptionQueue) job.ServiceCtx {
+	if qs == nil {
+		return dq
+	}
+	return &heldDecryptionQueue{decryptionQueue: dq, jobID: jobID, queues: qs}
+}
+
+// PendingRequests returns the pending requests of the decryption queue of a
+// job, oldest first, and false if the job has no running decryption queue.
+func (qs *DecryptionQueues) PendingRequests(jobID int32) ([]PendingRequest, bool) {
+	qs.mu.RLock()
+	dq, ok := qs.queues[jobID]
+	qs.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return dq.pendingRequestsByAge(), true
+}
+
+type heldDecryptionQueue struct {
+	*decryptionQueue
+	jobID  int32
+	queues *DecryptionQueues
+}
+
+func (h *heldDecryptionQueue) Start(ctx context.Context) error {
+	if err := h.decryptionQueue.Start(ctx); err != nil {
+		return err
+	}
+	h.queues.mu.Lock()
+	defer h.queues.mu.Unlock()
+	h.queues.queues[h.jobID] = h.decryptionQueue
+	return nil
+}
+
+func (h *heldDecryptionQueue) Close() error {
+	h.queues.mu.Lock()
+	delete(h.queues.queues, h.jobID)
+	h.queues.mu.Unlock()
+	return h.decryptionQueue.Close()
+}
+
+// pendingRequestsByAge returns the requests awaiting decryption, oldest first.
+func (dq *decryptionQueue) pendingRequestsByAge() []PendingRequest {
+	dq.mu.RLock()
+	defer dq.mu.RUnlock()
+	requests := make([]PendingRequest, 0, len(dq.pendingRequests))
+	for id, req := range dq.pendingRequests {
+		requests = append(requests, PendingRequest{
+			CiphertextID: []byte(id),
+			Ciphertext:   req.ciphertext,
+			CreatedAt:    req.createdAt,
+		})
+	}
+	sort.Slice(requests, func(i, j int) bool { return requests[i].CreatedAt.Before(requests[j].CreatedAt) })
+	return requests
+}
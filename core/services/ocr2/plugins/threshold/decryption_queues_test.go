@@ -0,0 +1,60 @@
+package threshold
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	decryptionPlugin "github.com/smartcontractkit/tdh2/go/ocr2/decryptionplugin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestDecryptionQueues_PendingRequests(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	queues := NewDecryptionQueues()
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, lggr)
+	service := queues.Service(1, dq)
+
+	_, ok := queues.PendingRequests(1)
+	assert.False(t, ok, "queues are held while they run")
+
+	require.NoError(t, service.Start(testutils.Context(t)))
+	requests, ok := queues.PendingRequests(1)
+	require.True(t, ok)
+	assert.Empty(t, requests)
+
+	for _, id := range []string{"1", "2"} {
+		id := id
+		go func() {
+			_, _ = dq.Decrypt(testutils.Context(t), []byte(id), []byte("encrypted"+id))
+		}()
+		waitForPendingRequestToBeAdded(t, dq, []byte(id))
+		time.Sleep(time.Millisecond)
+	}
+	requests, ok = queues.PendingRequests(1)
+	require.True(t, ok)
+	require.Len(t, requests, 2)
+	assert.Equal(t, []byte("1"), requests[0].CiphertextID)
+	assert.Equal(t, []byte("encrypted1"), requests[0].Ciphertext)
+	assert.Equal(t, []byte("2"), requests[1].CiphertextID)
+
+	dq.SetResult([]byte("1"), []byte("decrypted"), nil)
+	requests, ok = queues.PendingRequests(1)
+	require.True(t, ok)
+	require.Len(t, requests, 1)
+	assert.Equal(t, decryptionPlugin.CiphertextId("2"), decryptionPlugin.CiphertextId(requests[0].CiphertextID))
+
+	require.NoError(t, service.Close())
+	_, ok = queues.PendingRequests(1)
+	assert.False(t, ok)
+}
+
+func TestDecryptionQueues_Nil(t *testing.T) {
+	var queues *DecryptionQueues
+	dq := NewDecryptionQueue(1, 4, 1000, 64, testutils.WaitTimeout(t), 0, nil, logger.TestLogger(t))
+	assert.Equal(t, dq, queues.Service(1, dq))
+}
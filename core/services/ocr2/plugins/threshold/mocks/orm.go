@@ -0,0 +1,151 @@
+// Code generated by mockery v2.38.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	threshold "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ORM is an autogenerated mock type for the ORM type
+type ORM struct {
+	mock.Mock
+}
+
+// DeletePendingRequests provides a mock function with given fields: ciphertextIds, qopts
+func (_m *ORM) DeletePendingRequests(ciphertextIds [][]byte, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ciphertextIds)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingRequests")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([][]byte, ...pg.QOpt) error); ok {
+		r0 = rf(ciphertextIds, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePendingRequestsBefore provides a mock function with given fields: cutoff, qopts
+func (_m *ORM) DeletePendingRequestsBefore(cutoff time.Time, qopts ...pg.QOpt) (int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, cutoff)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingRequestsBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, ...pg.QOpt) (int64, error)); ok {
+		return rf(cutoff, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, ...pg.QOpt) int64); ok {
+		r0 = rf(cutoff, qopts...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, ...pg.QOpt) error); ok {
+		r1 = rf(cutoff, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPendingRequests provides a mock function with given fields: qopts
+func (_m *ORM) FindPendingRequests(qopts ...pg.QOpt) ([]threshold.PendingRequest, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingRequests")
+	}
+
+	var r0 []threshold.PendingRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) ([]threshold.PendingRequest, error)); ok {
+		return rf(qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []threshold.PendingRequest); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]threshold.PendingRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertPendingRequest provides a mock function with given fields: ciphertextId, ciphertext, createdAt, qopts
+func (_m *ORM) InsertPendingRequest(ciphertextId []byte, ciphertext []byte, createdAt time.Time, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ciphertextId, ciphertext, createdAt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPendingRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, []byte, time.Time, ...pg.QOpt) error); ok {
+		r0 = rf(ciphertextId, ciphertext, createdAt, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewORM(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ORM {
+	mock := &ORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
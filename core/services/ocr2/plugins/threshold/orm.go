@@ -0,0 +1,79 @@
+package threshold
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+//go:generate mockery --quiet --name ORM --output ./mocks/ --case=underscore
+
+// ORM persists the pending requests of the decryption queue of a job, so that
+// they survive a restart of the node. Plaintexts are never persisted.
+type ORM interface {
+	InsertPendingRequest(ciphertextId []byte, ciphertext []byte, createdAt time.Time, qopts ...pg.QOpt) error
+	DeletePendingRequests(ciphertextIds [][]byte, qopts ...pg.QOpt) error
+	DeletePendingRequestsBefore(cutoff time.Time, qopts ...pg.QOpt) (int64, error)
+	FindPendingRequests(qopts ...pg.QOpt) ([]PendingRequest, error)
+}
+
+// PendingRequest is a persisted ciphertext awaiting decryption by the DON.
+type PendingRequest struct {
+	CiphertextID []byte    `db:"ciphertext_id"`
+	Ciphertext   []byte    `db:"ciphertext"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+type orm struct {
+	q     pg.Q
+	jobID int32
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.QConfig, jobID int32) ORM {
+	return &orm{
+		q:     pg.NewQ(db, lggr, cfg),
+		jobID: jobID,
+	}
+}
+
+func (o *orm) InsertPendingRequest(ciphertextId []byte, ciphertext []byte, createdAt time.Time, qopts ...pg.QOpt) error {
+	stmt := `
+		INSERT INTO threshold_pending_decryption_requests (job_id, ciphertext_id, ciphertext, created_at)
+		VALUES ($1,$2,$3,$4) ON CONFLICT (job_id, ciphertext_id) DO NOTHING;
+	`
+	_, err := o.q.WithOpts(qopts...).Exec(stmt, o.jobID, ciphertextId, ciphertext, createdAt)
+	return err
+}
+
+func (o *orm) DeletePendingRequests(ciphertextIds [][]byte, qopts ...pg.QOpt) error {
+	if len(ciphertextIds) == 0 {
+		return nil
+	}
+	stmt := `DELETE FROM threshold_pending_decryption_requests WHERE job_id = $1 AND ciphertext_id = ANY($2);`
+	_, err := o.q.WithOpts(qopts...).Exec(stmt, o.jobID, pq.Array(ciphertextIds))
+	return err
+}
+
+func (o *orm) DeletePendingRequestsBefore(cutoff time.Time, qopts ...pg.QOpt) (int64, error) {
+	stmt := `DELETE FROM threshold_pending_decryption_requests WHERE job_id = $1 AND created_at < $2;`
+	result, err := o.q.WithOpts(qopts...).Exec(stmt, o.jobID, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (o *orm) FindPendingRequests(qopts ...pg.QOpt) ([]PendingRequest, error) {
+	var requests []PendingRequest
+	stmt := `SELECT ciphertext_id, ciphertext, created_at FROM threshold_pending_decryption_requests WHERE job_id = $1 ORDER BY created_at, ciphertext_id;`
+	if err := o.q.WithOpts(qopts...).Select(&requests, stmt, o.jobID); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
@@ -0,0 +1,53 @@
+package threshold_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+)
+
+func TestORM_PendingRequests(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS threshold_pending_decryption_requests_job_id_fkey DEFERRED`)
+	jobID := rand.Int31() // foreign key constraints disabled so value doesn't matter
+	orm := threshold.NewORM(db, lggr, pgtest.NewQConfig(true), jobID)
+	otherORM := threshold.NewORM(db, lggr, pgtest.NewQConfig(true), jobID+1)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, orm.InsertPendingRequest([]byte("1"), []byte("ciphertext1"), now.Add(-time.Hour)))
+	require.NoError(t, orm.InsertPendingRequest([]byte("2"), []byte("ciphertext2"), now))
+	require.NoError(t, orm.InsertPendingRequest([]byte("2"), []byte("duplicate"), now), "duplicates are ignored")
+	require.NoError(t, otherORM.InsertPendingRequest([]byte("1"), []byte("other"), now))
+
+	requests, err := orm.FindPendingRequests()
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Equal(t, []byte("1"), requests[0].CiphertextID)
+	assert.Equal(t, []byte("ciphertext1"), requests[0].Ciphertext)
+	assert.True(t, now.Add(-time.Hour).Equal(requests[0].CreatedAt))
+	assert.Equal(t, []byte("ciphertext2"), requests[1].Ciphertext)
+
+	deleted, err := orm.DeletePendingRequestsBefore(now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	require.NoError(t, orm.DeletePendingRequests([][]byte{[]byte("2"), []byte("3")}))
+	requests, err = orm.FindPendingRequests()
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+
+	requests, err = otherORM.FindPendingRequests()
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, []byte("other"), requests[0].Ciphertext)
+}
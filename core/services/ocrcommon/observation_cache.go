@@ -0,0 +1,120 @@
+package ocrcommon
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	promObservationCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr2_observation_cache_hits_total",
+		Help: "Observations answered by a shared observation, scoped by cache key",
+	},
+		[]string{"key"})
+	promObservationCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr2_observation_cache_misses_total",
+		Help: "Observations made because no shared observation was recent enough, scoped by cache key",
+	},
+		[]string{"key"})
+)
+
+// observationCacheTimeout bounds shared observations, which run detached from
+// the contexts of the callers waiting for them.
+const observationCacheTimeout = 30 * time.Second
+
+// ObservationCache shares observations between the data sources of jobs on
+// the node which observe the same value, so that the value is computed once
+// per cache duration rather than once per job.
+type ObservationCache struct {
+	group singleflight.Group
+
+	mu      sync.RWMutex
+	entries map[string]observationCacheEntry
+}
+
+type observationCacheEntry struct {
+	value      *big.Int
+	observedAt time.Time
+}
+
+func NewObservationCache() *ObservationCache {
+	return &ObservationCache{entries: make(map[string]observationCacheEntry)}
+}
+
+// NewDataSource returns a data source which returns the latest observation
+// made for key by any data source of the cache, if it is younger than maxAge.
+// Otherwise it observes ds, at most once at a time per key.
+func (c *ObservationCache) NewDataSource(key string, maxAge time.Duration, ds median.DataSource) median.DataSource {
+	return &observationCacheDataSource{cache: c, key: key, maxAge: maxAge, ds: ds}
+}
+
+// latest returns the latest observation made for key and when it was made.
+func (c *ObservationCache) latest(key string) (value *big.Int, observedAt time.Time, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return new(big.Int).Set(entry.value), entry.observedAt, true
+}
+
+// observe returns the latest observation made for key if it is younger than
+// maxAge. Otherwise it calls observe, at most once at a time per key. The
+// observation runs on a context detached from the one of ctx, so that callers
+// giving up do not fail it for the others waiting for it.
+func (c *ObservationCache) observe(ctx context.Context, key string, maxAge time.Duration, observe func(context.Context) (*big.Int, error)) (*big.Int, error) {
+	if value, observedAt, ok := c.latest(key); ok && time.Since(observedAt) < maxAge {
+		promObservationCacheHits.WithLabelValues(key).Inc()
+		return value, nil
+	}
+
+	promObservationCacheMisses.WithLabelValues(key).Inc()
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), observationCacheTimeout)
+		defer cancel()
+		value, err := observe(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.entries[key] = observationCacheEntry{value: new(big.Int).Set(value), observedAt: time.Now()}
+		c.mu.Unlock()
+		return value, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return new(big.Int).Set(res.Val.(*big.Int)), nil
+	}
+}
+
+type observationCacheDataSource struct {
+	cache  *ObservationCache
+	key    string
+	maxAge time.Duration
+	ds     median.DataSource
+}
+
+var _ median.DataSource = (*observationCacheDataSource)(nil)
+
+// Observe returns a shared observation, or observes the underlying data
+// source. Concurrent callers wait for the same observation, each until its own
+// context is done.
+func (ds *observationCacheDataSource) Observe(ctx context.Context, timestamp ocr2types.ReportTimestamp) (*big.Int, error) {
+	return ds.cache.observe(ctx, ds.key, ds.maxAge, func(ctx context.Context) (*big.Int, error) {
+		return ds.ds.Observe(ctx, timestamp)
+	})
+}
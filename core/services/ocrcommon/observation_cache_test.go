@@ -0,0 +1,149 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+type countingDataSource struct {
+	calls   atomic.Int64
+	err     error
+	release chan struct{}
+}
+
+func (ds *countingDataSource) Observe(ctx context.Context, _ types.ReportTimestamp) (*big.Int, error) {
+	n := ds.calls.Add(1)
+	if ds.release != nil {
+		<-ds.release
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if ds.err != nil {
+		return nil, ds.err
+	}
+	return big.NewInt(n), nil
+}
+
+func Test_ObservationCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shares observations between data sources with the same key", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		ds1, ds2, other := &countingDataSource{}, &countingDataSource{}, &countingDataSource{}
+		a := cache.NewDataSource("eth-usd", time.Hour, ds1)
+		b := cache.NewDataSource("eth-usd", time.Hour, ds2)
+		c := cache.NewDataSource("btc-usd", time.Hour, other)
+
+		for _, ds := range []interface {
+			Observe(context.Context, types.ReportTimestamp) (*big.Int, error)
+		}{a, b, a} {
+			v, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(1), v)
+		}
+		assert.Equal(t, int64(1), ds1.calls.Load())
+		assert.Zero(t, ds2.calls.Load())
+
+		v, err := c.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), v)
+		assert.Equal(t, int64(1), other.calls.Load())
+	})
+
+	t.Run("observes again after the max age", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		ds := &countingDataSource{}
+		cached := cache.NewDataSource("eth-usd", time.Millisecond, ds)
+
+		_, err := cached.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		v, err := cached.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2), v)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		failing := &countingDataSource{err: errors.New("no answer")}
+		_, err := cache.NewDataSource("eth-usd", time.Hour, failing).Observe(testutils.Context(t), types.ReportTimestamp{})
+		assert.EqualError(t, err, "no answer")
+
+		ds := &countingDataSource{}
+		v, err := cache.NewDataSource("eth-usd", time.Hour, ds).Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), v)
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		cached := cache.NewDataSource("eth-usd", time.Hour, &countingDataSource{})
+		v, err := cached.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		v.SetInt64(100)
+		v, err = cached.Observe(testutils.Context(t), types.ReportTimestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), v)
+	})
+
+	t.Run("observes concurrently requested values once", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		ds := &countingDataSource{release: make(chan struct{})}
+
+		const n = 10
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := cache.NewDataSource("eth-usd", time.Hour, ds).Observe(testutils.Context(t), types.ReportTimestamp{})
+				assert.NoError(t, err)
+				assert.Equal(t, big.NewInt(1), v)
+			}()
+		}
+		require.Eventually(t, func() bool { return ds.calls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(ds.release)
+		wg.Wait()
+		assert.Equal(t, int64(1), ds.calls.Load())
+	})
+
+	t.Run("observes after the caller which started the observation gives up", func(t *testing.T) {
+		cache := ocrcommon.NewObservationCache()
+		ds := &countingDataSource{release: make(chan struct{})}
+
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		firstErr := make(chan error)
+		go func() {
+			_, err := cache.NewDataSource("eth-usd", time.Hour, ds).Observe(ctx, types.ReportTimestamp{})
+			firstErr <- err
+		}()
+		require.Eventually(t, func() bool { return ds.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+		second := make(chan *big.Int)
+		go func() {
+			v, err := cache.NewDataSource("eth-usd", time.Hour, ds).Observe(testutils.Context(t), types.ReportTimestamp{})
+			assert.NoError(t, err)
+			second <- v
+		}()
+		cancel()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(ds.release)
+		assert.Equal(t, big.NewInt(1), <-second)
+		assert.Equal(t, int64(1), ds.calls.Load())
+	})
+}
@@ -103,6 +103,26 @@ func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Argumen
 	return name, args, indexedArgs, err
 }
 
+// decodeETHLog unpacks the data and the indexed topics of a log into a map
+// keyed by argument name. topics includes the event signature.
+func decodeETHLog(args, indexedArgs abi.Arguments, data []byte, topics []common.Hash) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := args.UnpackIntoMap(out, data); err != nil {
+			return nil, errors.Wrap(ErrBadInput, err.Error())
+		}
+	}
+	if len(indexedArgs) > 0 {
+		if len(topics) != len(indexedArgs)+1 {
+			return nil, errors.Wrap(ErrBadInput, "topic/field count mismatch")
+		}
+		if err := abi.ParseTopicsIntoMap(out, indexedArgs, topics[1:]); err != nil {
+			return nil, errors.Wrap(ErrBadInput, err.Error())
+		}
+	}
+	return out, nil
+}
+
 func convertToETHABIType(val interface{}, abiType abi.Type) (interface{}, error) {
 	srcVal := reflect.ValueOf(val)
 
@@ -218,7 +218,42 @@ func Parse(text string) (*Pipeline, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newPipeline(text, g)
+}
 
+// ParseWithoutTasks parses a pipeline, leaving out the tasks of the given type
+// and all tasks that depend on them.
+func ParseWithoutTasks(text string, taskType TaskType) (*Pipeline, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("empty pipeline")
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(text)); err != nil {
+		return nil, err
+	}
+
+	var removed []graph.Node
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if TaskType(strings.ToLower(node.attrs["type"])) == taskType {
+			removed = append(removed, node)
+		}
+	}
+	for len(removed) > 0 {
+		node := removed[0]
+		removed = removed[1:]
+		if g.Node(node.ID()) == nil {
+			continue
+		}
+		for outputs := g.From(node.ID()); outputs.Next(); {
+			removed = append(removed, outputs.Node())
+		}
+		g.RemoveNode(node.ID())
+	}
+	return newPipeline(text, g)
+}
+
+func newPipeline(text string, g *Graph) (*Pipeline, error) {
 	p := &Pipeline{
 		tree:   g,
 		Tasks:  make([]Task, 0, g.Nodes().Len()),
@@ -250,6 +285,11 @@ func Parse(text string) (*Pipeline, error) {
 		if err != nil {
 			return nil, err
 		}
+		if v, ok := task.(validatedTask); ok {
+			if err = v.validate(); err != nil {
+				return nil, errors.Wrapf(err, "invalid task %q", node.dotID)
+			}
+		}
 
 		// re-link the edges
 		for inputs := g.To(node.ID()); inputs.Next(); {
@@ -259,6 +259,9 @@ func TestParse(t *testing.T) {
 		{"empty", ""},
 		{"blank", " "},
 		{"foo", "foo"},
+		{"invalid jq expression", `jq [type=jq expression=".data | select("]`},
+		{"empty jq expression", `jq [type=jq]`},
+		{"invalid ethgetlogs abi", `logs [type=ethgetlogs abi="Transfer(address indexed)" lookbackBlocks=10]`},
 	} {
 		t.Run(s.name, func(t *testing.T) {
 			_, err := pipeline.Parse(s.pipeline)
@@ -267,3 +270,21 @@ func TestParse(t *testing.T) {
 	}
 
 }
+
+func TestParseWithoutTasks(t *testing.T) {
+	p, err := pipeline.ParseWithoutTasks(`
+decode_log   [type=ethabidecodelog abi="Foo(uint256 bar)" data="$(jobRun.logData)" topics="$(jobRun.logTopics)"]
+estimate_gas [type=estimategaslimit to="0x0000000000000000000000000000000000000001" data="$(decode_log.bar)"]
+simulate     [type=ethcall contract="0x0000000000000000000000000000000000000001" gas="$(estimate_gas)" data="$(decode_log.bar)"]
+parse        [type=jsonparse data="$(simulate)"]
+decode_log->estimate_gas->simulate->parse
+`, pipeline.TaskTypeETHCall)
+	require.NoError(t, err)
+
+	var dotIDs []string
+	for _, task := range p.Tasks {
+		dotIDs = append(dotIDs, task.DotID())
+	}
+	assert.Equal(t, []string{"decode_log", "estimate_gas"}, dotIDs)
+	require.Len(t, p.Tasks[1].Outputs(), 0)
+}
@@ -40,10 +40,15 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.bridgeConfig = bridgeConfig
 	t.orm = orm
 	t.uuid = id
-	t.httpClient = httpClient
+	t.httpClients = bridges.NewHTTPClients(httpClient)
+	t.responseCache = bridges.NewResponseCache()
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetResponseCache(c *bridges.ResponseCache) {
+	t.responseCache = c
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -57,6 +62,10 @@ func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCo
 	t.jobType = jobType
 }
 
+func (t *ETHGetLogsTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
 func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.keyStore = keyStore
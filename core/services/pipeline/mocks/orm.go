@@ -8,6 +8,8 @@ import (
 	models "github.com/smartcontractkit/chainlink/v2/core/store/models"
 	mock "github.com/stretchr/testify/mock"
 
+	null "gopkg.in/guregu/null.v4"
+
 	pg "github.com/smartcontractkit/chainlink/v2/core/services/pg"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -325,6 +327,41 @@ func (_m *ORM) InsertRun(run *pipeline.Run, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// LatestRunCreatedAt provides a mock function with given fields: pipelineSpecID, qopts
+func (_m *ORM) LatestRunCreatedAt(pipelineSpecID int32, qopts ...pg.QOpt) (null.Time, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, pipelineSpecID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestRunCreatedAt")
+	}
+
+	var r0 null.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) (null.Time, error)); ok {
+		return rf(pipelineSpecID, qopts...)
+	}
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) null.Time); ok {
+		r0 = rf(pipelineSpecID, qopts...)
+	} else {
+		r0 = ret.Get(0).(null.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, ...pg.QOpt) error); ok {
+		r1 = rf(pipelineSpecID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Name provides a mock function with given fields:
 func (_m *ORM) Name() string {
 	ret := _m.Called()
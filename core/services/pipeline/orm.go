@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
 
@@ -89,6 +90,9 @@ type ORM interface {
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(id int64) (Run, error)
+	// LatestRunCreatedAt returns the creation time of the most recent run of
+	// the pipeline spec, which is null if there is none.
+	LatestRunCreatedAt(pipelineSpecID int32, qopts ...pg.QOpt) (null.Time, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 	GetQ() pg.Q
@@ -525,6 +529,12 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+func (o *orm) LatestRunCreatedAt(pipelineSpecID int32, qopts ...pg.QOpt) (latest null.Time, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&latest, `SELECT MAX(created_at) FROM pipeline_runs WHERE pipeline_spec_id = $1`, pipelineSpecID)
+	return latest, errors.Wrap(err, "LatestRunCreatedAt failed")
+}
+
 func (o *orm) GetAllRuns() (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.q.Transaction(func(tx pg.Queryer) error {
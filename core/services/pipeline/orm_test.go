@@ -91,6 +91,29 @@ func Test_PipelineORM_FindRun(t *testing.T) {
 	require.Equal(t, expected.ID, run.ID)
 }
 
+func Test_PipelineORM_LatestRunCreatedAt(t *testing.T) {
+	db, orm := setupLiteORM(t)
+
+	_, err := db.Exec(`SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+	require.NoError(t, err)
+
+	latest, err := orm.LatestRunCreatedAt(0)
+	require.NoError(t, err)
+	assert.False(t, latest.Valid)
+
+	mustInsertPipelineRun(t, orm)
+	expected := mustInsertPipelineRun(t, orm)
+	_, err = db.Exec(`UPDATE pipeline_runs SET created_at = created_at - interval '1 hour' WHERE id != $1`, expected.ID)
+	require.NoError(t, err)
+
+	latest, err = orm.LatestRunCreatedAt(0)
+	require.NoError(t, err)
+	require.True(t, latest.Valid)
+	run, err := orm.FindRun(expected.ID)
+	require.NoError(t, err)
+	assert.True(t, run.CreatedAt.Equal(latest.Time))
+}
+
 func mustInsertPipelineRun(t *testing.T, orm pipeline.ORM) pipeline.Run {
 	t.Helper()
 
@@ -67,6 +67,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	bridgeHTTPClients      *bridges.HTTPClients
+	bridgeResponseCache    *bridges.ResponseCache
 
 	// test helper
 	runFinished func(*Run)
@@ -105,7 +107,7 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, legacyChains legacyevm.LegacyChainContainer, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
+func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, legacyChains legacyevm.LegacyChainContainer, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client, bridgeHTTPClients *bridges.HTTPClients) *runner {
 	r := &runner{
 		orm:                    orm,
 		btORM:                  btORM,
@@ -120,6 +122,8 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		bridgeHTTPClients:      bridgeHTTPClients,
+		bridgeResponseCache:    bridges.NewResponseCache(),
 	}
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -289,12 +293,15 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
-			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).httpClients = r.bridgeHTTPClients
+			task.(*BridgeTask).responseCache = r.bridgeResponseCache
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
 			task.(*ETHCallTask).specGasLimit = spec.GasLimit
 			task.(*ETHCallTask).jobType = spec.JobType
+		case TaskTypeETHGetLogs:
+			task.(*ETHGetLogsTask).legacyChains = r.legacyEVMChains
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
 		case TaskTypeVRFV2:
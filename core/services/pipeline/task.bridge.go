@@ -2,7 +2,9 @@ package pipeline
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -64,11 +66,12 @@ type BridgeTask struct {
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
 
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	specId        int32
+	orm           bridges.ORM
+	config        Config
+	bridgeConfig  BridgeConfig
+	httpClients   *bridges.HTTPClients
+	responseCache *bridges.ResponseCache
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -109,7 +112,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeFromName(name)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	url := URLParam(bt.URL)
+	httpClient, err := t.httpClients.Client(bt)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
@@ -151,6 +159,18 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	useResponseCache := t.responseCache != nil && bt.ResponseCacheTTL.Duration() > 0 && t.Async != "true"
+	var responseCacheKey string
+	if useResponseCache {
+		responseCacheKey, err = bridgeResponseCacheKey(requestData, reqHeaders)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	// makeHTTPRequest encodes requestData to the same bytes as requestDataJSON,
+	// since encoding/json sorts map keys.
+	reqHeaders = append(reqHeaders, bt.SignatureHeaders(requestDataJSON, time.Now())...)
 	lggr.Tracew("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
@@ -166,14 +186,39 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	sendRequest := func(ctx context.Context) (bridges.Response, error) {
+		responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, "POST", url, reqHeaders, requestData, httpClient, t.config.DefaultHTTPLimit())
 
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		return bridges.Response{Body: responseBytes, StatusCode: statusCode, Header: headers, Elapsed: elapsed}, err
 	}
 
+	var cachedResponse bool
+	var response bridges.Response
+	if useResponseCache {
+		var cacheStatus bridges.CacheStatus
+		// The request is shared with other runs, so it is not bound to this
+		// run's context but to the task timeout, or the default one.
+		timeout := t.config.DefaultHTTPTimeout().Duration()
+		if taskTimeout, isSet := t.TaskTimeout(); isSet {
+			timeout = taskTimeout
+		}
+		response, cacheStatus, err = t.responseCache.Fetch(ctx, bt, responseCacheKey, timeout, sendRequest)
+		if cacheStatus != bridges.CacheMiss {
+			lggr.Debugw("Bridge task: response served from response cache",
+				"cacheStatus", cacheStatus,
+				"url", url.String(),
+			)
+			cachedResponse = true
+		}
+	} else {
+		response, err = sendRequest(requestCtx)
+	}
+	responseBytes, statusCode, headers, elapsed := response.Body, response.StatusCode, response.Header, response.Elapsed
+
 	if err != nil || statusCode != http.StatusOK {
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
@@ -198,7 +243,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 			"url", url.String(),
 		)
 		cachedResponse = true
-	} else {
+	} else if !cachedResponse {
 		promBridgeLatency.WithLabelValues(t.Name).Set(elapsed.Seconds())
 	}
 
@@ -229,7 +274,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	// value instead.
 	result = Result{Value: string(responseBytes)}
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	if !cachedResponse {
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	}
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
 	lggr.Tracew("Bridge task: fetched answer",
@@ -241,12 +288,33 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// bridgeResponseCacheKey identifies a bridge request in the response cache by
+// its data and headers. The meta field is left out, as it differs between the
+// jobs making otherwise identical requests.
+func bridgeResponseCacheKey(requestData MapParam, reqHeaders []string) (string, error) {
+	data := make(map[string]interface{}, len(requestData))
+	for k, v := range requestData {
+		if k != "meta" {
+			data[k] = v
+		}
+	}
+	b, err := json.Marshal(struct {
+		Data    map[string]interface{} `json:"data"`
+		Headers []string               `json:"headers"`
+	}{data, reqHeaders})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode response cache key")
 	}
-	return URLParam(bt.URL), nil
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
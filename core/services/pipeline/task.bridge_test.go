@@ -236,6 +236,83 @@ func TestBridgeTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestBridgeTask_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	var failing atomic.Bool
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"data":{"result":%d}}`, n)
+		require.NoError(t, err)
+	}))
+	defer s1.Close()
+
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg.Database())
+	newBridge := func(ttl, maxStale time.Duration) *bridges.BridgeType {
+		_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: s1.URL})
+		bt.ResponseCacheTTL = models.Interval(ttl)
+		bt.ResponseCacheMaxStale = models.Interval(maxStale)
+		require.NoError(t, orm.CreateBridgeType(bt))
+		return bt
+	}
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute), pg.WithParentCtx(testutils.Context(t)))
+	require.NoError(t, err)
+
+	cache := bridges.NewResponseCache()
+	run := func(bt *bridges.BridgeType, meta map[string]interface{}) pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bt.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetResponseCache(cache)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"meta": meta},
+		}), nil)
+		return result
+	}
+
+	t.Run("identical requests from different jobs are sent once", func(t *testing.T) {
+		bt := newBridge(time.Hour, 0)
+		calls.Store(0)
+
+		first := run(bt, map[string]interface{}{"latestAnswer": 1})
+		require.NoError(t, first.Error)
+		second := run(bt, map[string]interface{}{"latestAnswer": 2})
+		require.NoError(t, second.Error)
+
+		assert.Equal(t, first.Value, second.Value)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("serves a stale response when the bridge fails", func(t *testing.T) {
+		bt := newBridge(time.Millisecond, time.Hour)
+		calls.Store(0)
+
+		first := run(bt, nil)
+		require.NoError(t, first.Error)
+		time.Sleep(5 * time.Millisecond)
+
+		failing.Store(true)
+		defer failing.Store(false)
+		second := run(bt, nil)
+		require.NoError(t, second.Error)
+
+		assert.Equal(t, first.Value, second.Value)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
 func TestBridgeTask_HandlesIntermittentFailure(t *testing.T) {
 	t.Parallel()
 
@@ -1005,6 +1082,45 @@ func TestBridgeTask_Headers(t *testing.T) {
 		assert.Equal(t, append(standardHeaders, "X-Header-1", "foo", "X-Header-2", "bar"), allHeaders(headers))
 	})
 
+	t.Run("signs the request body", func(t *testing.T) {
+		const secret = "0123456789abcdef0123456789abcdef"
+		var body []byte
+		var signedHeaders http.Header
+		signedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			body, err = io.ReadAll(r.Body)
+			require.NoError(t, err)
+			signedHeaders = r.Header
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err = w.Write([]byte(`{"fooresponse": 1}`))
+			require.NoError(t, err)
+		}))
+		defer signedServer.Close()
+		_, signedBridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: signedServer.URL, SigningSecret: secret}, cfg.Database())
+
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        signedBridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.Database(), cfg.JobPipeline().MaxSuccessfulRuns())
+		specID, err := trORM.CreateSpec(pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute), pg.WithParentCtx(testutils.Context(t)))
+		require.NoError(t, err)
+		signedORM := bridges.NewORMWithSecrets(db, logger.TestLogger(t), cfg.Database(), cltest.NewKeyStore(t, db, cfg.Database()))
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), signedORM, specID, uuid.UUID{}, c)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsPending)
+		require.NoError(t, result.Error)
+
+		timestamp := signedHeaders.Get(bridges.TimestampHeader)
+		require.NotEmpty(t, timestamp)
+		assert.Equal(t, bridges.Signature(secret, timestamp, body), signedHeaders.Get(bridges.SignatureHeader))
+	})
+
 	t.Run("errors with odd number of headers", func(t *testing.T) {
 		task := pipeline.BridgeTask{
 			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
@@ -0,0 +1,234 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/exp/slices"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+)
+
+// ETHGetLogsTask fetches the logs of a single event emitted by a contract over
+// the last lookbackBlocks blocks, ending confirmations blocks behind the current
+// head. topic1, topic2 and topic3 optionally restrict the values of the indexed
+// arguments, in declaration order.
+//
+// Logs are read from the LogPoller when one of its filters retains all logs of
+// the query over the whole range, i.e. the filter was registered and its
+// retention starts before the first block, and the LogPoller has caught up with
+// the range. They are read with eth_getLogs otherwise. lookbackBlocks is at most
+// maxETHGetLogsLookbackBlocks.
+//
+// Return types:
+//
+//	[]interface{} of map[string]interface{} with the keys address, blockNumber,
+//	blockHash, transactionHash, logIndex and args, the decoded event
+type ETHGetLogsTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Address        string `json:"address"`
+	ABI            string `json:"abi"`
+	Topic1         string `json:"topic1"`
+	Topic2         string `json:"topic2"`
+	Topic3         string `json:"topic3"`
+	LookbackBlocks string `json:"lookbackBlocks"`
+	Confirmations  string `json:"confirmations"`
+	EVMChainID     string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*ETHGetLogsTask)(nil)
+
+// maxETHGetLogsLookbackBlocks bounds the block range of the ethgetlogs task, as
+// RPC nodes commonly limit the range of eth_getLogs queries to 10k blocks.
+const maxETHGetLogsLookbackBlocks = 10_000
+
+func (t *ETHGetLogsTask) Type() TaskType {
+	return TaskTypeETHGetLogs
+}
+
+func (t *ETHGetLogsTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *ETHGetLogsTask) validate() error {
+	if t.ABI == "" {
+		return errors.Wrap(ErrParameterEmpty, "abi")
+	}
+	_, _, _, err := parseETHABIString([]byte(t.ABI), true)
+	return err
+}
+
+func (t *ETHGetLogsTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		address        AddressParam
+		theABI         BytesParam
+		topic1         HashSliceParam
+		topic2         HashSliceParam
+		topic3         HashSliceParam
+		lookbackBlocks Uint64Param
+		confirmations  Uint64Param
+		chainID        StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&theABI, From(NonemptyString(t.ABI))), "abi"),
+		errors.Wrap(ResolveParam(&topic1, From(VarExpr(t.Topic1, vars), JSONWithVarExprs(t.Topic1, vars, true), nil)), "topic1"),
+		errors.Wrap(ResolveParam(&topic2, From(VarExpr(t.Topic2, vars), JSONWithVarExprs(t.Topic2, vars, true), nil)), "topic2"),
+		errors.Wrap(ResolveParam(&topic3, From(VarExpr(t.Topic3, vars), JSONWithVarExprs(t.Topic3, vars, true), nil)), "topic3"),
+		errors.Wrap(ResolveParam(&lookbackBlocks, From(VarExpr(t.LookbackBlocks, vars), NonemptyString(t.LookbackBlocks))), "lookbackBlocks"),
+		errors.Wrap(ResolveParam(&confirmations, From(VarExpr(t.Confirmations, vars), NonemptyString(t.Confirmations), 0)), "confirmations"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if lookbackBlocks == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "lookbackBlocks must be greater than zero")}, runInfo
+	} else if lookbackBlocks > maxETHGetLogsLookbackBlocks {
+		return Result{Error: errors.Wrapf(ErrBadInput, "lookbackBlocks must be at most %d", maxETHGetLogsLookbackBlocks)}, runInfo
+	}
+
+	name, args, indexedArgs, err := parseETHABIString([]byte(theABI), true)
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+	topicValues := [][]common.Hash{topic1, topic2, topic3}
+	for i := len(indexedArgs); i < len(topicValues); i++ {
+		if len(topicValues[i]) > 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "topic%d is set but the event has only %d indexed arguments", i+1, len(indexedArgs))}, runInfo
+		}
+	}
+	eventSig := abi.NewEvent(name, name, false, args).ID
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	head, err := chain.Client().HeadByNumber(ctx, nil)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to fetch latest head")}, retryableRunInfo()
+	} else if head == nil {
+		return Result{Error: errors.New("failed to fetch latest head: no head returned")}, retryableRunInfo()
+	}
+	toBlock := head.Number - int64(confirmations)
+	if toBlock < 0 {
+		return Result{Value: []interface{}{}}, runInfo
+	}
+	fromBlock := toBlock - int64(lookbackBlocks) + 1
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+
+	logs, err := t.fetchLogs(ctx, lggr, chain, common.Address(address), eventSig, topicValues, fromBlock, toBlock)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	out := make([]interface{}, 0, len(logs))
+	for _, log := range logs {
+		decoded, err := decodeETHLog(args, indexedArgs, log.Data, log.Topics)
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "failed to decode log %s:%d", log.TxHash, log.Index)}, runInfo
+		}
+		out = append(out, map[string]interface{}{
+			"address":         log.Address,
+			"blockNumber":     log.BlockNumber,
+			"blockHash":       log.BlockHash,
+			"transactionHash": log.TxHash,
+			"logIndex":        uint64(log.Index),
+			"args":            decoded,
+		})
+	}
+	return Result{Value: out}, runInfo
+}
+
+func (t *ETHGetLogsTask) fetchLogs(ctx context.Context, lggr logger.Logger, chain legacyevm.Chain, address common.Address, eventSig common.Hash, topicValues [][]common.Hash, fromBlock, toBlock int64) ([]types.Log, error) {
+	if lp := chain.LogPoller(); lp != nil {
+		logs, ok, err := t.fetchLogPollerLogs(ctx, lggr, lp, address, eventSig, topicValues, fromBlock, toBlock)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			return logs, nil
+		}
+	}
+
+	logs, err := chain.Client().FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(fromBlock),
+		ToBlock:   big.NewInt(toBlock),
+		Addresses: []common.Address{address},
+		Topics:    append([][]common.Hash{{eventSig}}, topicValues...),
+	})
+	return logs, errors.Wrap(err, "failed to fetch logs with eth_getLogs")
+}
+
+// fetchLogPollerLogs returns the logs from the LogPoller, or false if it may
+// not hold all of them: no filter retains them from the first block of the
+// range, or the LogPoller has not reached its last block yet.
+func (t *ETHGetLogsTask) fetchLogPollerLogs(ctx context.Context, lggr logger.Logger, lp logpoller.LogPoller, address common.Address, eventSig common.Hash, topicValues [][]common.Hash, fromBlock, toBlock int64) ([]types.Log, bool, error) {
+	since, ok := lp.LogsRetainedSince(address, eventSig, topicValues)
+	if !ok {
+		return nil, false, nil
+	}
+	latest, err := lp.LatestBlock(pg.WithParentCtx(ctx))
+	if err != nil || latest.BlockNumber < toBlock {
+		lggr.Debugw("LogPoller is behind the requested block range, falling back to eth_getLogs", "toBlock", toBlock, "err", err)
+		return nil, false, nil
+	}
+	blocks, err := lp.GetBlocksRange(ctx, []uint64{uint64(fromBlock)}, pg.WithParentCtx(ctx))
+	if err != nil || len(blocks) == 0 {
+		lggr.Debugw("Failed to fetch the first block of the requested range, falling back to eth_getLogs", "fromBlock", fromBlock, "err", err)
+		return nil, false, nil
+	}
+	if !blocks[0].BlockTimestamp.After(since) {
+		lggr.Debugw("LogPoller does not retain logs of the whole requested block range, falling back to eth_getLogs", "fromBlock", fromBlock, "blockTimestamp", blocks[0].BlockTimestamp, "retainedSince", since)
+		return nil, false, nil
+	}
+
+	lpLogs, err := lp.Logs(fromBlock, toBlock, eventSig, address, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to fetch logs from LogPoller")
+	}
+	var logs []types.Log
+	for _, lpLog := range lpLogs {
+		log := lpLog.ToGethLog()
+		if matchesTopicValues(log.Topics, topicValues) {
+			logs = append(logs, log)
+		}
+	}
+	return logs, true, nil
+}
+
+// matchesTopicValues reports whether each indexed topic of a log is one of the
+// requested values, if any are given for its position.
+func matchesTopicValues(topics []common.Hash, topicValues [][]common.Hash) bool {
+	for i, values := range topicValues {
+		if len(values) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(values, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
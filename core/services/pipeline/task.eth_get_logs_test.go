@@ -0,0 +1,241 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	legacymocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestETHGetLogsTask(t *testing.T) {
+	t.Parallel()
+
+	const transferABI = "Transfer(address indexed from, address indexed to, uint256 value)"
+	var (
+		contract     = common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+		transferSig  = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		fromTopic    = common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001")
+		toTopic      = common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002")
+		otherTopic   = common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003")
+		value        = common.LeftPadBytes(big.NewInt(42).Bytes(), 32)
+		txHash       = common.HexToHash("0x1234")
+		blockHash    = common.HexToHash("0x5678")
+		registeredAt = time.Now().Add(-time.Hour)
+		transferLog  = types.Log{Address: contract, Topics: []common.Hash{transferSig, fromTopic, toTopic}, Data: value, BlockNumber: 95, BlockHash: blockHash, TxHash: txHash, Index: 3}
+		expectedLogs = []interface{}{
+			map[string]interface{}{
+				"address":         contract,
+				"blockNumber":     uint64(95),
+				"blockHash":       blockHash,
+				"transactionHash": txHash,
+				"logIndex":        uint64(3),
+				"args": map[string]interface{}{
+					"from":  common.HexToAddress("0x01"),
+					"to":    common.HexToAddress("0x02"),
+					"value": big.NewInt(42),
+				},
+			},
+		}
+	)
+	lpLog := func(topics ...common.Hash) logpoller.Log {
+		var bs pq.ByteaArray
+		for _, topic := range topics {
+			bs = append(bs, topic.Bytes())
+		}
+		return logpoller.Log{Address: contract, Topics: bs, EventSig: transferSig, Data: value, BlockNumber: 95, BlockHash: blockHash, TxHash: txHash, LogIndex: 3}
+	}
+
+	tests := []struct {
+		name                  string
+		topic1                string
+		topic2                string
+		lookbackBlocks        string
+		confirmations         string
+		setupMocks            func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller)
+		expected              interface{}
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRetryable     bool
+	}{
+		{
+			"eth_getLogs without a LogPoller filter",
+			`["0x0000000000000000000000000000000000000000000000000000000000000001"]`,
+			"",
+			"10",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("LogsRetainedSince", contract, transferSig, [][]common.Hash{{fromTopic}, nil, nil}).Return(time.Time{}, false)
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(91),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{contract},
+					Topics:    [][]common.Hash{{transferSig}, {fromTopic}, nil, nil},
+				}).Return([]types.Log{transferLog}, nil)
+			},
+			expectedLogs, nil, "", false,
+		},
+		{
+			"LogPoller with a matching filter",
+			"",
+			`["0x0000000000000000000000000000000000000000000000000000000000000002"]`,
+			"10",
+			"5",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("LogsRetainedSince", contract, transferSig, [][]common.Hash{nil, {toTopic}, nil}).Return(registeredAt, true)
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 99}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{86}, mock.Anything).
+					Return([]logpoller.LogPollerBlock{{BlockNumber: 86, BlockTimestamp: registeredAt.Add(time.Minute)}}, nil)
+				lp.On("Logs", int64(86), int64(95), transferSig, contract, mock.Anything).
+					Return([]logpoller.Log{lpLog(transferSig, fromTopic, toTopic), lpLog(transferSig, fromTopic, otherTopic)}, nil)
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+			},
+			expectedLogs, nil, "", false,
+		},
+		{
+			"LogPoller behind the requested range",
+			"",
+			"",
+			"10",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("LogsRetainedSince", contract, transferSig, [][]common.Hash{nil, nil, nil}).Return(registeredAt, true)
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 99}, nil)
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+				ethClient.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{}, nil)
+			},
+			[]interface{}{}, nil, "", false,
+		},
+		{
+			"LogPoller filter registered after the start of the requested range",
+			"",
+			"",
+			"10",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("LogsRetainedSince", contract, transferSig, [][]common.Hash{nil, nil, nil}).Return(registeredAt, true)
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{91}, mock.Anything).
+					Return([]logpoller.LogPollerBlock{{BlockNumber: 91, BlockTimestamp: registeredAt.Add(-time.Minute)}}, nil)
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(91),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{contract},
+					Topics:    [][]common.Hash{{transferSig}, nil, nil, nil},
+				}).Return([]types.Log{transferLog}, nil)
+			},
+			expectedLogs, nil, "", false,
+		},
+		{
+			"eth_getLogs error",
+			"",
+			"",
+			"10",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("LogsRetainedSince", contract, transferSig, [][]common.Hash{nil, nil, nil}).Return(time.Time{}, false)
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 100}, nil)
+				ethClient.On("FilterLogs", mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+			},
+			nil, nil, "boom", true,
+		},
+		{
+			"missing lookbackBlocks",
+			"",
+			"",
+			"",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil, pipeline.ErrParameterEmpty, "lookbackBlocks", false,
+		},
+		{
+			"zero lookbackBlocks",
+			"",
+			"",
+			"0",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil, pipeline.ErrBadInput, "lookbackBlocks", false,
+		},
+		{
+			"lookbackBlocks too large",
+			"",
+			"",
+			"10001",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil, pipeline.ErrBadInput, "lookbackBlocks must be at most 10000", false,
+		},
+		{
+			"bad topic",
+			`["foo"]`,
+			"",
+			"10",
+			"",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil, pipeline.ErrBadInput, "topic1", false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHGetLogsTask{
+				BaseTask:       pipeline.NewBaseTask(0, "ethgetlogs", nil, nil, 0),
+				Address:        contract.Hex(),
+				ABI:            transferABI,
+				Topic1:         test.topic1,
+				Topic2:         test.topic2,
+				LookbackBlocks: test.lookbackBlocks,
+				Confirmations:  test.confirmations,
+				EVMChainID:     "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			lp := lpmocks.NewLogPoller(t)
+			test.setupMocks(ethClient, lp)
+
+			chain := legacymocks.NewChain(t)
+			chain.On("Client").Return(ethClient).Maybe()
+			chain.On("LogPoller").Return(lp).Maybe()
+			legacyChains := legacymocks.NewLegacyChainContainer(t)
+			legacyChains.On("Get", "0").Return(chain, nil).Maybe()
+			task.HelperSetDependencies(legacyChains)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.Equal(t, test.expectedRetryable, runInfo.IsRetryable)
+
+			if test.expectedErrorCause != nil || test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
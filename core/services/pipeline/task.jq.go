@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// defaultJQTimeout bounds the evaluation of an expression when neither the
+// task nor the job sets a timeout.
+const defaultJQTimeout = time.Second
+
+// JQTask applies a jq expression to its input, e.g. to select, filter or
+// reshape an API response. The expression must yield at most one value; wrap
+// it in [] to collect several values into an array.
+//
+// Expressions run sandboxed: there is no access to environment variables,
+// modules or further inputs, and evaluation stops when the task times out.
+//
+// Return types:
+//
+//	int
+//	*big.Int
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JQTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Data       string `json:"data"`
+}
+
+var _ Task = (*JQTask)(nil)
+
+func (t *JQTask) Type() TaskType {
+	return TaskTypeJQ
+}
+
+func (t *JQTask) validate() error {
+	if strings.TrimSpace(t.Expression) == "" {
+		return errors.Wrap(ErrParameterEmpty, "expression")
+	}
+	_, err := compileJQ(t.Expression)
+	return err
+}
+
+func (t *JQTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression StringParam
+		data       jqInputParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	code, err := compileJQ(string(expression))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultJQTimeout)
+		defer cancel()
+	}
+
+	var values []interface{}
+	iter := code.RunWithContext(ctx, data.value)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, isErr := v.(error); isErr {
+			return Result{Error: errors.Wrap(err, "failed to evaluate jq expression")}, runInfo
+		}
+		if len(values) == 1 {
+			return Result{Error: errors.Wrap(ErrBadInput, "jq expression yielded more than one value, wrap it in [] to collect them")}, runInfo
+		}
+		values = append(values, v)
+	}
+
+	if len(values) == 0 {
+		return Result{Value: nil}, runInfo
+	}
+	return Result{Value: values[0]}, runInfo
+}
+
+func compileJQ(expression string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid jq expression")
+	}
+	// Without compiler options, the environment is empty and modules can not
+	// be loaded.
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid jq expression")
+	}
+	return code, nil
+}
+
+// jqInputParam decodes strings and bytes as JSON, and converts any other value
+// to the types supported by jq through its JSON representation.
+type jqInputParam struct {
+	value interface{}
+}
+
+func (p *jqInputParam) UnmarshalPipelineParam(val interface{}) error {
+	var raw []byte
+	switch v := val.(type) {
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(ErrBadInput, "failed to encode input: %v", err)
+		}
+		raw = b
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&p.value); err != nil {
+		return errors.Wrapf(ErrBadInput, "input is not valid JSON: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJQTask(t *testing.T) {
+	t.Parallel()
+
+	const response = `{
+		"data": [
+			{"symbol": "ETH", "price": 3000.5, "volume": 10},
+			{"symbol": "BTC", "price": 60000, "volume": 0},
+			{"symbol": "LINK", "price": 15.25, "volume": 7}
+		],
+		"total": 123456789012345678901234567890
+	}`
+
+	tests := []struct {
+		name       string
+		expression string
+		data       string
+		vars       map[string]interface{}
+		inputs     []pipeline.Result
+		want       interface{}
+		wantErr    string
+	}{
+		{
+			name:       "path with array indexing",
+			expression: ".data[1].symbol",
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       "BTC",
+		},
+		{
+			name:       "negative array index",
+			expression: ".data[-1].price",
+			inputs:     []pipeline.Result{{Value: []byte(response)}},
+			want:       15.25,
+		},
+		{
+			name:       "select and map",
+			expression: `[.data[] | select(.volume > 0) | .symbol]`,
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       []interface{}{"ETH", "LINK"},
+		},
+		{
+			name:       "arithmetic",
+			expression: `.data | map(.price * .volume) | add`,
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       30111.75,
+		},
+		{
+			name:       "reshape into object",
+			expression: `{prices: (.data | map({(.symbol): .price}) | add)}`,
+			inputs:     []pipeline.Result{{Value: response}},
+			want: map[string]interface{}{
+				"prices": map[string]interface{}{"ETH": 3000.5, "BTC": 60000, "LINK": 15.25},
+			},
+		},
+		{
+			name:       "big integers keep their precision",
+			expression: ".total",
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       mustBigInt("123456789012345678901234567890"),
+		},
+		{
+			name:       "structured input",
+			expression: ".foo.bar",
+			inputs:     []pipeline.Result{{Value: map[string]interface{}{"foo": map[string]interface{}{"bar": 42}}}},
+			want:       42,
+		},
+		{
+			name:       "data from vars",
+			expression: ".[0]",
+			data:       "$(foo)",
+			vars:       map[string]interface{}{"foo": []interface{}{"a", "b"}},
+			want:       "a",
+		},
+		{
+			name:       "no output",
+			expression: "empty",
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       nil,
+		},
+		{
+			name:       "multiple outputs",
+			expression: ".data[].symbol",
+			inputs:     []pipeline.Result{{Value: response}},
+			wantErr:    "jq expression yielded more than one value",
+		},
+		{
+			name:       "runtime error",
+			expression: ".data + 1",
+			inputs:     []pipeline.Result{{Value: response}},
+			wantErr:    "failed to evaluate jq expression",
+		},
+		{
+			name:       "invalid expression",
+			expression: ".data[",
+			inputs:     []pipeline.Result{{Value: response}},
+			wantErr:    "invalid jq expression",
+		},
+		{
+			name:       "environment is not accessible",
+			expression: "$ENV | length",
+			inputs:     []pipeline.Result{{Value: response}},
+			want:       0,
+		},
+		{
+			name:       "invalid JSON input",
+			expression: ".",
+			inputs:     []pipeline.Result{{Value: "{not json"}},
+			wantErr:    "input is not valid JSON",
+		},
+		{
+			name:       "input error",
+			expression: ".",
+			inputs:     []pipeline.Result{{Error: assert.AnError}},
+			wantErr:    "task inputs: too many errors",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			vars := pipeline.NewVarsFrom(test.vars)
+			task := pipeline.JQTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+				Data:       test.data,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErr != "" {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.want, result.Value)
+		})
+	}
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 50*time.Millisecond)
+		defer cancel()
+
+		task := pipeline.JQTask{
+			BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Expression: "[limit(1; repeat(1) | select(. > 1))]",
+		}
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "1"}})
+		require.ErrorIs(t, result.Error, context.DeadlineExceeded)
+	})
+
+	t.Run("parses with the pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := pipeline.Parse(`jq [type=jq expression=<[.data[] | .price]>]`)
+		require.NoError(t, err)
+		require.Len(t, p.Tasks, 1)
+		assert.Equal(t, "[.data[] | .price]", p.Tasks[0].(*pipeline.JQTask).Expression)
+	})
+}
+
+func mustBigInt(s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(s)
+	}
+	return i
+}
@@ -288,12 +288,19 @@ func (r *Relayer) NewLLOProvider(rargs commontypes.RelayArgs, pargs commontypes.
 		r.lggr.Info("Benchmark mode enabled, using dummy transmitter. NOTE: THIS WILL NOT TRANSMIT ANYTHING")
 		transmitter = bm.NewTransmitter(r.lggr, privKey.PublicKey)
 	} else {
-		var client wsrpc.Client
-		client, err = r.mercuryPool.Checkout(context.Background(), privKey, lloCfg.ServerPubKey, lloCfg.ServerURL())
-		if err != nil {
-			return nil, err
+		clients := make(map[string]wsrpc.Client)
+		for serverURL, serverPubKey := range lloCfg.GetServers() {
+			var client wsrpc.Client
+			client, err = r.mercuryPool.Checkout(context.Background(), privKey, serverPubKey, serverURL)
+			if err != nil {
+				for _, c := range clients {
+					err = errors.Join(err, c.Close())
+				}
+				return nil, err
+			}
+			clients[serverURL] = client
 		}
-		transmitter = llo.NewTransmitter(r.lggr, client, privKey.PublicKey)
+		transmitter = llo.NewTransmitter(r.lggr, clients, privKey.PublicKey, llo.NewTransmitterORM(r.db, rargs.JobID))
 	}
 
 	cdc, err := r.cdcFactory.NewCache(lloCfg)
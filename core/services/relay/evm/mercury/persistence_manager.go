@@ -19,9 +19,60 @@ var (
 	pruneFrequency        = time.Hour
 )
 
+// TransmissionStore persists the transmissions managed by a Persistence.
+type TransmissionStore[T any] interface {
+	// Delete deletes the transmissions of reqs, if they exist.
+	Delete(ctx context.Context, reqs []*pb.TransmitRequest) error
+	// Load returns the persisted transmissions.
+	Load(ctx context.Context) ([]T, error)
+	// Prune keeps at most maxSize transmissions, deleting the oldest ones.
+	Prune(ctx context.Context, maxSize int) error
+}
+
+// PersistenceManager persists the transmissions of a mercury job.
 type PersistenceManager struct {
-	lggr logger.Logger
-	orm  ORM
+	*Persistence[*Transmission]
+
+	orm   ORM
+	jobID int32
+}
+
+func NewPersistenceManager(lggr logger.Logger, orm ORM, jobID int32, maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *PersistenceManager {
+	store := &jobTransmissionStore{orm: orm, jobID: jobID}
+	return &PersistenceManager{
+		Persistence: NewPersistence[*Transmission](lggr.Named("MercuryPersistenceManager"), store, maxTransmitQueueSize, flushDeletesFrequency, pruneFrequency),
+		orm:         orm,
+		jobID:       jobID,
+	}
+}
+
+func (pm *PersistenceManager) Insert(ctx context.Context, req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) error {
+	return pm.orm.InsertTransmitRequest(req, pm.jobID, reportCtx, pg.WithParentCtx(ctx))
+}
+
+// jobTransmissionStore is the TransmissionStore of the transmissions of a job.
+type jobTransmissionStore struct {
+	orm   ORM
+	jobID int32
+}
+
+func (s *jobTransmissionStore) Delete(ctx context.Context, reqs []*pb.TransmitRequest) error {
+	return s.orm.DeleteTransmitRequests(reqs, pg.WithParentCtx(ctx))
+}
+
+func (s *jobTransmissionStore) Load(ctx context.Context) ([]*Transmission, error) {
+	return s.orm.GetTransmitRequests(s.jobID, pg.WithParentCtx(ctx))
+}
+
+func (s *jobTransmissionStore) Prune(ctx context.Context, maxSize int) error {
+	return s.orm.PruneTransmitRequests(s.jobID, maxSize, pg.WithParentCtx(ctx), pg.WithLongQueryTimeout())
+}
+
+// Persistence deletes, in batches, and prunes the transmissions held by a
+// TransmissionStore, see PersistenceManager.
+type Persistence[T any] struct {
+	lggr  logger.Logger
+	store TransmissionStore[T]
 
 	once   services.StateMachine
 	stopCh services.StopChan
@@ -30,27 +81,24 @@ type PersistenceManager struct {
 	deleteMu    sync.Mutex
 	deleteQueue []*pb.TransmitRequest
 
-	jobID int32
-
 	maxTransmitQueueSize  int
 	flushDeletesFrequency time.Duration
 	pruneFrequency        time.Duration
 }
 
-func NewPersistenceManager(lggr logger.Logger, orm ORM, jobID int32, maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *PersistenceManager {
-	return &PersistenceManager{
-		lggr:                  lggr.Named("MercuryPersistenceManager"),
-		orm:                   orm,
+func NewPersistence[T any](lggr logger.Logger, store TransmissionStore[T], maxTransmitQueueSize int, flushDeletesFrequency, pruneFrequency time.Duration) *Persistence[T] {
+	return &Persistence[T]{
+		lggr:                  lggr,
+		store:                 store,
 		stopCh:                make(services.StopChan),
-		jobID:                 jobID,
 		maxTransmitQueueSize:  maxTransmitQueueSize,
 		flushDeletesFrequency: flushDeletesFrequency,
 		pruneFrequency:        pruneFrequency,
 	}
 }
 
-func (pm *PersistenceManager) Start(ctx context.Context) error {
-	return pm.once.StartOnce("MercuryPersistenceManager", func() error {
+func (pm *Persistence[T]) Start(ctx context.Context) error {
+	return pm.once.StartOnce(pm.lggr.Name(), func() error {
 		pm.wg.Add(2)
 		go pm.runFlushDeletesLoop()
 		go pm.runPruneLoop()
@@ -58,31 +106,27 @@ func (pm *PersistenceManager) Start(ctx context.Context) error {
 	})
 }
 
-func (pm *PersistenceManager) Close() error {
-	return pm.once.StopOnce("MercuryPersistenceManager", func() error {
+func (pm *Persistence[T]) Close() error {
+	return pm.once.StopOnce(pm.lggr.Name(), func() error {
 		close(pm.stopCh)
 		pm.wg.Wait()
 		return nil
 	})
 }
 
-func (pm *PersistenceManager) Insert(ctx context.Context, req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) error {
-	return pm.orm.InsertTransmitRequest(req, pm.jobID, reportCtx, pg.WithParentCtx(ctx))
-}
-
-func (pm *PersistenceManager) Delete(ctx context.Context, req *pb.TransmitRequest) error {
-	return pm.orm.DeleteTransmitRequests([]*pb.TransmitRequest{req}, pg.WithParentCtx(ctx))
+func (pm *Persistence[T]) Delete(ctx context.Context, req *pb.TransmitRequest) error {
+	return pm.store.Delete(ctx, []*pb.TransmitRequest{req})
 }
 
-func (pm *PersistenceManager) AsyncDelete(req *pb.TransmitRequest) {
+func (pm *Persistence[T]) AsyncDelete(req *pb.TransmitRequest) {
 	pm.addToDeleteQueue(req)
 }
 
-func (pm *PersistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
-	return pm.orm.GetTransmitRequests(pm.jobID, pg.WithParentCtx(ctx))
+func (pm *Persistence[T]) Load(ctx context.Context) ([]T, error) {
+	return pm.store.Load(ctx)
 }
 
-func (pm *PersistenceManager) runFlushDeletesLoop() {
+func (pm *Persistence[T]) runFlushDeletesLoop() {
 	defer pm.wg.Done()
 
 	ctx, cancel := pm.stopCh.Ctx(context.Background())
@@ -96,17 +140,20 @@ func (pm *PersistenceManager) runFlushDeletesLoop() {
 			return
 		case <-ticker.C:
 			queuedReqs := pm.resetDeleteQueue()
-			if err := pm.orm.DeleteTransmitRequests(queuedReqs, pg.WithParentCtx(ctx)); err != nil {
+			if len(queuedReqs) == 0 {
+				continue
+			}
+			if err := pm.store.Delete(ctx, queuedReqs); err != nil {
 				pm.lggr.Errorw("Failed to delete queued transmit requests", "err", err)
 				pm.addToDeleteQueue(queuedReqs...)
 			} else {
-				pm.lggr.Debugw("Deleted queued transmit requests")
+				pm.lggr.Debugw("Deleted queued transmit requests", "count", len(queuedReqs))
 			}
 		}
 	}
 }
 
-func (pm *PersistenceManager) runPruneLoop() {
+func (pm *Persistence[T]) runPruneLoop() {
 	defer pm.wg.Done()
 
 	ctx, cancel := pm.stopCh.Ctx(context.Background())
@@ -119,7 +166,7 @@ func (pm *PersistenceManager) runPruneLoop() {
 			ticker.Stop()
 			return
 		case <-ticker.C:
-			if err := pm.orm.PruneTransmitRequests(pm.jobID, pm.maxTransmitQueueSize, pg.WithParentCtx(ctx), pg.WithLongQueryTimeout()); err != nil {
+			if err := pm.store.Prune(ctx, pm.maxTransmitQueueSize); err != nil {
 				pm.lggr.Errorw("Failed to prune transmit requests table", "err", err)
 			} else {
 				pm.lggr.Debugw("Pruned transmit requests table")
@@ -128,13 +175,13 @@ func (pm *PersistenceManager) runPruneLoop() {
 	}
 }
 
-func (pm *PersistenceManager) addToDeleteQueue(reqs ...*pb.TransmitRequest) {
+func (pm *Persistence[T]) addToDeleteQueue(reqs ...*pb.TransmitRequest) {
 	pm.deleteMu.Lock()
 	defer pm.deleteMu.Unlock()
 	pm.deleteQueue = append(pm.deleteQueue, reqs...)
 }
 
-func (pm *PersistenceManager) resetDeleteQueue() []*pb.TransmitRequest {
+func (pm *Persistence[T]) resetDeleteQueue() []*pb.TransmitRequest {
 	pm.deleteMu.Lock()
 	defer pm.deleteMu.Unlock()
 	queue := pm.deleteQueue
@@ -38,9 +38,47 @@ var transmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
 // aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 const promInterval = 6500 * time.Millisecond
 
+// QueueItem is a pending transmission stored in a Queue.
+type QueueItem[T any] interface {
+	// TransmitRequest returns the payload to transmit.
+	TransmitRequest() *pb.TransmitRequest
+	// Less reports whether the item has a higher priority than other.
+	Less(other T) bool
+}
+
 // TransmitQueue is the high-level package that everything outside of this file should be using
 // It stores pending transmissions, yielding the latest (highest priority) first to the caller
 type TransmitQueue struct {
+	*Queue[*Transmission]
+}
+
+type Transmission struct {
+	Req       *pb.TransmitRequest    // the payload to transmit
+	ReportCtx ocrtypes.ReportContext // contains priority information (latest epoch/round wins)
+}
+
+func (t *Transmission) TransmitRequest() *pb.TransmitRequest { return t.Req }
+
+func (t *Transmission) Less(other *Transmission) bool {
+	// We want Pop to give us the latest round, so we use greater than here
+	// i.e. a later epoch/round is "less" than an earlier one
+	return t.ReportCtx.ReportTimestamp.Epoch > other.ReportCtx.ReportTimestamp.Epoch &&
+		t.ReportCtx.ReportTimestamp.Round > other.ReportCtx.ReportTimestamp.Round
+}
+
+// maxlen controls how many items will be stored in the queue
+// 0 means unlimited - be careful, this can cause memory leaks
+func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int, transmissions []*Transmission, asyncDeleter asyncDeleter) *TransmitQueue {
+	return &TransmitQueue{NewQueue(lggr, transmitQueueLoad.WithLabelValues(feedID, fmt.Sprintf("%d", maxlen)), maxlen, transmissions, asyncDeleter)}
+}
+
+func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) (ok bool) {
+	return tq.Queue.Push(&Transmission{req, reportCtx})
+}
+
+// Queue stores pending transmissions of any kind, yielding the highest
+// priority first to the caller, see TransmitQueue.
+type Queue[T QueueItem[T]] struct {
 	services.StateMachine
 
 	cond         sync.Cond
@@ -48,7 +86,7 @@ type TransmitQueue struct {
 	asyncDeleter asyncDeleter
 	mu           *sync.RWMutex
 
-	pq     *priorityQueue
+	pq     *priorityQueue[T]
 	maxlen int
 	closed bool
 
@@ -57,18 +95,14 @@ type TransmitQueue struct {
 	transmitQueueLoad prometheus.Gauge
 }
 
-type Transmission struct {
-	Req       *pb.TransmitRequest    // the payload to transmit
-	ReportCtx ocrtypes.ReportContext // contains priority information (latest epoch/round wins)
-}
-
+// NewQueue creates a Queue reporting its length to transmitQueueLoad.
 // maxlen controls how many items will be stored in the queue
 // 0 means unlimited - be careful, this can cause memory leaks
-func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int, transmissions []*Transmission, asyncDeleter asyncDeleter) *TransmitQueue {
-	pq := priorityQueue(transmissions)
+func NewQueue[T QueueItem[T]](lggr logger.Logger, transmitQueueLoad prometheus.Gauge, maxlen int, transmissions []T, asyncDeleter asyncDeleter) *Queue[T] {
+	pq := priorityQueue[T](transmissions)
 	heap.Init(&pq) // ensure the heap is ordered
 	mu := new(sync.RWMutex)
-	return &TransmitQueue{
+	return &Queue[T]{
 		services.StateMachine{},
 		sync.Cond{L: mu},
 		lggr.Named("TransmitQueue"),
@@ -78,11 +112,11 @@ func NewTransmitQueue(lggr logger.Logger, feedID string, maxlen int, transmissio
 		maxlen,
 		false,
 		nil,
-		transmitQueueLoad.WithLabelValues(feedID, fmt.Sprintf("%d", maxlen)),
+		transmitQueueLoad,
 	}
 }
 
-func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.ReportContext) (ok bool) {
+func (tq *Queue[T]) Push(t T) (ok bool) {
 	tq.cond.L.Lock()
 	defer tq.cond.L.Unlock()
 
@@ -94,41 +128,42 @@ func (tq *TransmitQueue) Push(req *pb.TransmitRequest, reportCtx ocrtypes.Report
 		// evict oldest entry to make room
 		tq.lggr.Criticalf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen)
 		removed := heap.PopMax(tq.pq)
-		if transmission, ok := removed.(*Transmission); ok {
-			tq.asyncDeleter.AsyncDelete(transmission.Req)
+		if transmission, ok := removed.(T); ok {
+			tq.asyncDeleter.AsyncDelete(transmission.TransmitRequest())
 		}
 	}
 
-	heap.Push(tq.pq, &Transmission{req, reportCtx})
+	heap.Push(tq.pq, t)
 	tq.cond.Signal()
 
 	return true
 }
 
 // BlockingPop will block until at least one item is in the heap, and then return it
-// If the queue is closed, it will immediately return nil
-func (tq *TransmitQueue) BlockingPop() (t *Transmission) {
+// If the queue is closed, it will immediately return the zero value of T, e.g. nil
+func (tq *Queue[T]) BlockingPop() (t T) {
 	tq.cond.L.Lock()
 	defer tq.cond.L.Unlock()
 	if tq.closed {
-		return nil
+		return t
 	}
-	for t = tq.pop(); t == nil; t = tq.pop() {
+	var ok bool
+	for t, ok = tq.pop(); !ok; t, ok = tq.pop() {
 		tq.cond.Wait()
 		if tq.closed {
-			return nil
+			return t
 		}
 	}
 	return t
 }
 
-func (tq *TransmitQueue) IsEmpty() bool {
+func (tq *Queue[T]) IsEmpty() bool {
 	tq.mu.RLock()
 	defer tq.mu.RUnlock()
 	return tq.pq.Len() == 0
 }
 
-func (tq *TransmitQueue) Start(context.Context) error {
+func (tq *Queue[T]) Start(context.Context) error {
 	return tq.StartOnce("TransmitQueue", func() error {
 		t := time.NewTicker(utils.WithJitter(promInterval))
 		wg := new(sync.WaitGroup)
@@ -144,7 +179,7 @@ func (tq *TransmitQueue) Start(context.Context) error {
 	})
 }
 
-func (tq *TransmitQueue) Close() error {
+func (tq *Queue[T]) Close() error {
 	return tq.StopOnce("TransmitQueue", func() error {
 		tq.cond.L.Lock()
 		tq.closed = true
@@ -155,7 +190,7 @@ func (tq *TransmitQueue) Close() error {
 	})
 }
 
-func (tq *TransmitQueue) monitorLoop(c <-chan time.Time, chStop <-chan struct{}, wg *sync.WaitGroup) {
+func (tq *Queue[T]) monitorLoop(c <-chan time.Time, chStop <-chan struct{}, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	for {
@@ -168,25 +203,25 @@ func (tq *TransmitQueue) monitorLoop(c <-chan time.Time, chStop <-chan struct{},
 	}
 }
 
-func (tq *TransmitQueue) report() {
+func (tq *Queue[T]) report() {
 	tq.mu.RLock()
 	length := tq.pq.Len()
 	tq.mu.RUnlock()
 	tq.transmitQueueLoad.Set(float64(length))
 }
 
-func (tq *TransmitQueue) Ready() error {
+func (tq *Queue[T]) Ready() error {
 	return nil
 }
-func (tq *TransmitQueue) Name() string { return tq.lggr.Name() }
-func (tq *TransmitQueue) HealthReport() map[string]error {
+func (tq *Queue[T]) Name() string { return tq.lggr.Name() }
+func (tq *Queue[T]) HealthReport() map[string]error {
 	report := map[string]error{tq.Name(): errors.Join(
 		tq.status(),
 	)}
 	return report
 }
 
-func (tq *TransmitQueue) status() (merr error) {
+func (tq *Queue[T]) status() (merr error) {
 	tq.mu.RLock()
 	length := tq.pq.Len()
 	closed := tq.closed
@@ -202,11 +237,11 @@ func (tq *TransmitQueue) status() (merr error) {
 
 // pop latest Transmission from the heap
 // Not thread-safe
-func (tq *TransmitQueue) pop() *Transmission {
+func (tq *Queue[T]) pop() (t T, ok bool) {
 	if tq.pq.Len() == 0 {
-		return nil
+		return t, false
 	}
-	return heap.Pop(tq.pq).(*Transmission)
+	return heap.Pop(tq.pq).(T), true
 }
 
 // HEAP
@@ -214,35 +249,33 @@ func (tq *TransmitQueue) pop() *Transmission {
 
 // WARNING: None of these methods are thread-safe, caller must synchronize
 
-var _ heap.Interface = &priorityQueue{}
+var _ heap.Interface = &priorityQueue[*Transmission]{}
 
-type priorityQueue []*Transmission
+type priorityQueue[T QueueItem[T]] []T
 
-func (pq priorityQueue) Len() int { return len(pq) }
+func (pq priorityQueue[T]) Len() int { return len(pq) }
 
-func (pq priorityQueue) Less(i, j int) bool {
-	// We want Pop to give us the latest round, so we use greater than here
-	// i.e. a later epoch/round is "less" than an earlier one
-	return pq[i].ReportCtx.ReportTimestamp.Epoch > pq[j].ReportCtx.ReportTimestamp.Epoch &&
-		pq[i].ReportCtx.ReportTimestamp.Round > pq[j].ReportCtx.ReportTimestamp.Round
+func (pq priorityQueue[T]) Less(i, j int) bool {
+	return pq[i].Less(pq[j])
 }
 
-func (pq priorityQueue) Swap(i, j int) {
+func (pq priorityQueue[T]) Swap(i, j int) {
 	pq[i], pq[j] = pq[j], pq[i]
 }
 
-func (pq *priorityQueue) Pop() any {
+func (pq *priorityQueue[T]) Pop() any {
 	n := len(*pq)
 	if n == 0 {
 		return nil
 	}
 	old := *pq
 	item := old[n-1]
-	old[n-1] = nil // avoid memory leak
+	var zero T
+	old[n-1] = zero // avoid memory leak
 	*pq = old[0 : n-1]
 	return item
 }
 
-func (pq *priorityQueue) Push(x any) {
-	*pq = append(*pq, x.(*Transmission))
+func (pq *priorityQueue[T]) Push(x any) {
+	*pq = append(*pq, x.(T))
 }
@@ -43,6 +43,18 @@ var (
 	},
 		[]string{"serverURL", "feedID"},
 	)
+	promCacheStaleFallbackCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mercury_cache_stale_fallback_count",
+		Help: "Running count of times that we served a stale report because the mercury server did not return a fresh one in time",
+	},
+		[]string{"serverURL", "feedID"},
+	)
+	promCacheReportAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mercury_cache_report_age_seconds",
+		Help: "Age of the last report served from the cache, i.e. the time since it was fetched from a mercury server",
+	},
+		[]string{"serverURL", "feedID"},
+	)
 )
 
 type Fetcher interface {
@@ -81,10 +93,21 @@ type Config struct {
 	// LatestReportDeadline controls how long to wait for a response before
 	// retrying. Setting this to zero will wait indefinitely.
 	LatestReportDeadline time.Duration
+	// StaleFallbackAge is the maximum age of the last known report that will
+	// be returned when no fresh report could be fetched before the caller's
+	// context is done, or the server is failing, e.g. during a short outage.
+	//
+	// Setting to zero disables the fallback, i.e. an error is returned.
+	StaleFallbackAge time.Duration
+	// SharedAcrossServers shares the reports fetched from any server of a
+	// CacheSet with the caches of all other servers for the same feed. A
+	// shared report is returned until it is older than LatestReportTTL, and
+	// serves as the stale fallback.
+	SharedAcrossServers bool
 }
 
 func NewCache(lggr logger.Logger, client Client, cfg Config) Cache {
-	return newMemCache(lggr, client, cfg)
+	return newMemCache(lggr, client, cfg, nil)
 }
 
 type cacheVal struct {
@@ -97,6 +120,11 @@ type cacheVal struct {
 	err error
 
 	expiresAt time.Time
+
+	// lastVal is the last successfully fetched value, which is kept across
+	// failed fetches
+	lastVal       *pb.LatestReportResponse
+	lastFetchedAt time.Time
 }
 
 func (v *cacheVal) read() (*pb.LatestReportResponse, error) {
@@ -105,6 +133,12 @@ func (v *cacheVal) read() (*pb.LatestReportResponse, error) {
 	return v.val, v.err
 }
 
+func (v *cacheVal) lastKnown() (*pb.LatestReportResponse, time.Time) {
+	v.RLock()
+	defer v.RUnlock()
+	return v.lastVal, v.lastFetchedAt
+}
+
 // caller expected to hold lock
 func (v *cacheVal) initiateFetch() <-chan struct{} {
 	if v.fetching {
@@ -121,7 +155,7 @@ func (v *cacheVal) setError(err error) {
 	v.err = err
 }
 
-func (v *cacheVal) completeFetch(val *pb.LatestReportResponse, err error, expiresAt time.Time) {
+func (v *cacheVal) completeFetch(val *pb.LatestReportResponse, err error, fetchedAt, expiresAt time.Time) {
 	v.Lock()
 	defer v.Unlock()
 	if !v.fetching {
@@ -131,6 +165,8 @@ func (v *cacheVal) completeFetch(val *pb.LatestReportResponse, err error, expire
 	v.err = err
 	if err == nil {
 		v.expiresAt = expiresAt
+		v.lastVal = val
+		v.lastFetchedAt = fetchedAt
 	}
 	close(v.fetchCh)
 	v.fetchCh = nil
@@ -138,25 +174,13 @@ func (v *cacheVal) completeFetch(val *pb.LatestReportResponse, err error, expire
 }
 
 func (v *cacheVal) abandonFetch(err error) {
-	v.completeFetch(nil, err, time.Now())
-}
-
-func (v *cacheVal) waitForResult(ctx context.Context, chResult <-chan struct{}, chStop <-chan struct{}) (*pb.LatestReportResponse, error) {
-	select {
-	case <-ctx.Done():
-		_, err := v.read()
-		return nil, errors.Join(err, ctx.Err())
-	case <-chStop:
-		return nil, errors.New("stopped")
-	case <-chResult:
-		return v.read()
-	}
+	v.completeFetch(nil, err, time.Time{}, time.Now())
 }
 
 // memCache stores values in memory
 // it will never return a stale value older than latestPriceTTL, instead
 // waiting for a successful fetch or caller context cancels, whichever comes
-// first
+// first, unless a StaleFallbackAge is configured
 type memCache struct {
 	services.StateMachine
 	lggr logger.Logger
@@ -166,18 +190,22 @@ type memCache struct {
 	cfg Config
 
 	cache sync.Map
+	// shared holds the reports of all servers of a CacheSet, if
+	// SharedAcrossServers is set
+	shared *sharedReports
 
 	wg     sync.WaitGroup
 	chStop services.StopChan
 }
 
-func newMemCache(lggr logger.Logger, client Client, cfg Config) *memCache {
+func newMemCache(lggr logger.Logger, client Client, cfg Config, shared *sharedReports) *memCache {
 	return &memCache{
 		services.StateMachine{},
 		lggr.Named("MemCache"),
 		client,
 		cfg,
 		sync.Map{},
+		shared,
 		sync.WaitGroup{},
 		make(chan (struct{})),
 	}
@@ -197,22 +225,28 @@ func (m *memCache) LatestReport(ctx context.Context, req *pb.LatestReportRequest
 		return m.client.RawClient().LatestReport(ctx, req)
 	}
 	vi, loaded := m.cache.LoadOrStore(feedIDHex, &cacheVal{
-		sync.RWMutex{},
-		false,
-		nil,
-		nil,
-		nil,
-		time.Now(), // first result is always "expired" and requires fetch
+		expiresAt: time.Now(), // first result is always "expired" and requires fetch
 	})
 	v := vi.(*cacheVal)
 
 	m.lggr.Tracew("LatestReport", "feedID", feedIDHex, "loaded", loaded)
 
+	if m.shared != nil {
+		if val, fetchedAt, ok := m.shared.load(feedIDHex); ok && time.Since(fetchedAt) < m.cfg.LatestReportTTL {
+			// CACHE HIT (fetched by this or any other server)
+			promCacheHitCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
+			m.observeAge(feedIDHex, fetchedAt)
+			m.lggr.Tracew("LatestReport CACHE HIT (shared)", "feedID", feedIDHex)
+			return val, nil
+		}
+	}
+
 	// HOT PATH
 	v.RLock()
 	if time.Now().Before(v.expiresAt) {
 		// CACHE HIT
 		promCacheHitCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
+		m.observeAge(feedIDHex, v.lastFetchedAt)
 		m.lggr.Tracew("LatestReport CACHE HIT (hot path)", "feedID", feedIDHex)
 
 		defer v.RUnlock()
@@ -224,7 +258,7 @@ func (m *memCache) LatestReport(ctx context.Context, req *pb.LatestReportRequest
 		// if someone else is fetching then wait for the fetch to complete
 		ch := v.fetchCh
 		v.RUnlock()
-		return v.waitForResult(ctx, ch, m.chStop)
+		return m.waitForResult(ctx, feedIDHex, v, ch)
 	}
 	// CACHE MISS
 	promCacheMissCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
@@ -236,6 +270,7 @@ func (m *memCache) LatestReport(ctx context.Context, req *pb.LatestReportRequest
 	if time.Now().Before(v.expiresAt) {
 		// CACHE HIT
 		promCacheHitCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
+		m.observeAge(feedIDHex, v.lastFetchedAt)
 		m.lggr.Tracew("LatestReport CACHE HIT (cold path)", "feedID", feedIDHex)
 		defer v.Unlock()
 		return v.val, nil
@@ -246,7 +281,7 @@ func (m *memCache) LatestReport(ctx context.Context, req *pb.LatestReportRequest
 		// if someone else is fetching then wait for the fetch to complete
 		ch := v.fetchCh
 		v.Unlock()
-		return v.waitForResult(ctx, ch, m.chStop)
+		return m.waitForResult(ctx, feedIDHex, v, ch)
 	}
 	// CACHE MISS
 	promCacheMissCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
@@ -264,7 +299,53 @@ func (m *memCache) LatestReport(ctx context.Context, req *pb.LatestReportRequest
 		v.abandonFetch(err)
 		return nil, err
 	}
-	return v.waitForResult(ctx, ch, m.chStop)
+	return m.waitForResult(ctx, feedIDHex, v, ch)
+}
+
+func (m *memCache) waitForResult(ctx context.Context, feedIDHex string, v *cacheVal, chResult <-chan struct{}) (*pb.LatestReportResponse, error) {
+	if _, err := v.read(); err != nil {
+		// the server is failing, so do not wait for it if we can fall back
+		if val, ok := m.staleFallback(feedIDHex, v); ok {
+			return val, nil
+		}
+	}
+	select {
+	case <-ctx.Done():
+		if val, ok := m.staleFallback(feedIDHex, v); ok {
+			return val, nil
+		}
+		_, err := v.read()
+		return nil, errors.Join(err, ctx.Err())
+	case <-m.chStop:
+		return nil, errors.New("stopped")
+	case <-chResult:
+		return v.read()
+	}
+}
+
+// staleFallback returns the most recently fetched report for the feed, if it
+// is no older than StaleFallbackAge
+func (m *memCache) staleFallback(feedIDHex string, v *cacheVal) (*pb.LatestReportResponse, bool) {
+	if m.cfg.StaleFallbackAge <= 0 {
+		return nil, false
+	}
+	val, fetchedAt := v.lastKnown()
+	if m.shared != nil {
+		if sval, sfetchedAt, ok := m.shared.load(feedIDHex); ok && sfetchedAt.After(fetchedAt) {
+			val, fetchedAt = sval, sfetchedAt
+		}
+	}
+	if val == nil || time.Since(fetchedAt) > m.cfg.StaleFallbackAge {
+		return nil, false
+	}
+	promCacheStaleFallbackCount.WithLabelValues(m.client.ServerURL(), feedIDHex).Inc()
+	m.observeAge(feedIDHex, fetchedAt)
+	m.lggr.Debugw("LatestReport STALE FALLBACK", "feedID", feedIDHex, "fetchedAt", fetchedAt)
+	return val, true
+}
+
+func (m *memCache) observeAge(feedIDHex string, fetchedAt time.Time) {
+	promCacheReportAge.WithLabelValues(m.client.ServerURL(), feedIDHex).Set(time.Since(fetchedAt).Seconds())
 }
 
 const minBackoffRetryInterval = 50 * time.Millisecond
@@ -296,7 +377,10 @@ func (m *memCache) fetch(req *pb.LatestReportRequest, v *cacheVal) {
 	var val *pb.LatestReportResponse
 	var err error
 	defer func() {
-		v.completeFetch(val, err, t.Add(m.cfg.LatestReportTTL))
+		v.completeFetch(val, err, t, t.Add(m.cfg.LatestReportTTL))
+		if err == nil && m.shared != nil {
+			m.shared.store(mercuryutils.BytesToFeedID(req.FeedId).String(), val, t)
+		}
 	}()
 
 	for {
@@ -382,6 +466,9 @@ func (m *memCache) cleanup() {
 		}
 		return true
 	})
+	if m.shared != nil {
+		m.shared.cleanup(m.cfg.LatestReportTTL + m.cfg.MaxStaleAge)
+	}
 }
 
 func (m *memCache) Close() error {
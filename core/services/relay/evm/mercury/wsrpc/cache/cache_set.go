@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 )
 
 // CacheSet holds a set of mercury caches keyed by server URL
@@ -26,6 +28,7 @@ type cacheSet struct {
 
 	lggr   logger.Logger
 	caches map[string]Cache
+	shared *sharedReports
 
 	cfg Config
 }
@@ -35,11 +38,16 @@ func NewCacheSet(lggr logger.Logger, cfg Config) CacheSet {
 }
 
 func newCacheSet(lggr logger.Logger, cfg Config) *cacheSet {
+	var shared *sharedReports
+	if cfg.SharedAcrossServers {
+		shared = newSharedReports()
+	}
 	return &cacheSet{
 		sync.RWMutex{},
 		services.StateMachine{},
 		lggr.Named("CacheSet"),
 		make(map[string]Cache),
+		shared,
 		cfg,
 	}
 }
@@ -95,7 +103,7 @@ func (cs *cacheSet) get(ctx context.Context, client Client) (Fetcher, error) {
 	if exists {
 		return c, nil
 	}
-	c = newMemCache(cs.lggr, client, cs.cfg)
+	c = newMemCache(cs.lggr, client, cs.cfg, cs.shared)
 	if err := c.Start(ctx); err != nil {
 		return nil, err
 	}
@@ -116,3 +124,48 @@ func (cs *cacheSet) HealthReport() map[string]error {
 	return report
 }
 func (cs *cacheSet) Name() string { return cs.lggr.Name() }
+
+type sharedReport struct {
+	val       *pb.LatestReportResponse
+	fetchedAt time.Time
+}
+
+// sharedReports holds the most recently fetched report per feed ID across
+// all servers
+type sharedReports struct {
+	sync.RWMutex
+	reports map[string]sharedReport
+}
+
+func newSharedReports() *sharedReports {
+	return &sharedReports{reports: make(map[string]sharedReport)}
+}
+
+func (s *sharedReports) load(feedIDHex string) (*pb.LatestReportResponse, time.Time, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	r, exists := s.reports[feedIDHex]
+	return r.val, r.fetchedAt, exists
+}
+
+// store only replaces reports that were fetched earlier, since fetches from
+// different servers may complete out of order
+func (s *sharedReports) store(feedIDHex string, val *pb.LatestReportResponse, fetchedAt time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	if r, exists := s.reports[feedIDHex]; exists && r.fetchedAt.After(fetchedAt) {
+		return
+	}
+	s.reports[feedIDHex] = sharedReport{val, fetchedAt}
+}
+
+// cleanup removes reports older than maxAge
+func (s *sharedReports) cleanup(maxAge time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	for k, r := range s.reports {
+		if time.Since(r.fetchedAt) > maxAge {
+			delete(s.reports, k)
+		}
+	}
+}
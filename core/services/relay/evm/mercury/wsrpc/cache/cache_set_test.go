@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc/pb"
 )
 
 func Test_CacheSet(t *testing.T) {
@@ -54,4 +56,26 @@ func Test_CacheSet(t *testing.T) {
 			assert.Len(t, cs.caches, 1)
 		})
 	})
+
+	t.Run("with SharedAcrossServers, shares reports between the caches of all servers", func(t *testing.T) {
+		sharedCs := newCacheSet(lggr, Config{LatestReportTTL: neverExpireTTL, SharedAcrossServers: true})
+		servicetest.Run(t, sharedCs)
+
+		resp := &pb.LatestReportResponse{Report: &pb.Report{Price: []byte("1")}}
+		f1, err := sharedCs.Get(ctx, &mockClient{serverURL: "server1", resp: resp})
+		require.NoError(t, err)
+		f2, err := sharedCs.Get(ctx, &mockClient{serverURL: "server2", err: errors.New("server unreachable")})
+		require.NoError(t, err)
+		assert.Len(t, sharedCs.caches, 2)
+
+		req := &pb.LatestReportRequest{FeedId: []byte{1}}
+		got, err := f1.LatestReport(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, resp, got)
+
+		// served from the report fetched from server1
+		got, err = f2.LatestReport(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, resp, got)
+	})
 }
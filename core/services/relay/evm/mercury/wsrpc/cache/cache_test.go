@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	mercuryutils "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
@@ -31,14 +32,14 @@ func Test_Cache(t *testing.T) {
 	feedID1Hex := mercuryutils.BytesToFeedID(req1.FeedId).String()
 
 	t.Run("errors with nil req", func(t *testing.T) {
-		c := newMemCache(lggr, client, cfg)
+		c := newMemCache(lggr, client, cfg, nil)
 
 		_, err := c.LatestReport(ctx, nil)
 		assert.EqualError(t, err, "req must not be nil")
 	})
 
 	t.Run("with LatestReportTTL=0 does no caching", func(t *testing.T) {
-		c := newMemCache(lggr, client, cfg)
+		c := newMemCache(lggr, client, cfg, nil)
 
 		req := &pb.LatestReportRequest{}
 		for i := 0; i < 5; i++ {
@@ -60,7 +61,7 @@ func Test_Cache(t *testing.T) {
 	t.Run("caches repeated calls to LatestReport, keyed by request", func(t *testing.T) {
 		cfg.LatestReportTTL = neverExpireTTL
 		client.err = nil
-		c := newMemCache(lggr, client, cfg)
+		c := newMemCache(lggr, client, cfg, nil)
 
 		t.Run("if cache is unstarted, returns error", func(t *testing.T) {
 			// starting the cache is required for state management if we
@@ -152,7 +153,7 @@ func Test_Cache(t *testing.T) {
 				err:       nil,
 				expiresAt: expires,
 			}
-			v.completeFetch(nil, errors.New("foo"), time.Now().Add(neverExpireTTL))
+			v.completeFetch(nil, errors.New("foo"), time.Now(), time.Now().Add(neverExpireTTL))
 			assert.Equal(t, expires, v.expiresAt)
 
 			v = &cacheVal{
@@ -163,13 +164,13 @@ func Test_Cache(t *testing.T) {
 				expiresAt: expires,
 			}
 			expires = time.Now().Add(neverExpireTTL)
-			v.completeFetch(nil, nil, expires)
+			v.completeFetch(nil, nil, time.Now(), expires)
 			assert.Equal(t, expires, v.expiresAt)
 		})
 	})
 
 	t.Run("timeouts", func(t *testing.T) {
-		c := newMemCache(lggr, client, cfg)
+		c := newMemCache(lggr, client, cfg, nil)
 		// simulate fetch already executing in background
 		v := &cacheVal{
 			fetching:  true,
@@ -198,4 +199,54 @@ func Test_Cache(t *testing.T) {
 			assert.EqualError(t, err, "some background fetch error\ncontext canceled")
 		})
 	})
+
+	t.Run("stale fallback", func(t *testing.T) {
+		resp := &pb.LatestReportResponse{Report: &pb.Report{Price: []byte("1")}}
+		newStartedCache := func(t *testing.T, cfg Config) *memCache {
+			c := newMemCache(lggr, &mockClient{err: errors.New("server unreachable")}, cfg, nil)
+			servicetest.Run(t, c)
+			// simulate an expired report that was fetched a minute ago
+			c.cache.Store(feedID1Hex, &cacheVal{
+				val:           resp,
+				expiresAt:     time.Now().Add(-1 * time.Second),
+				lastVal:       resp,
+				lastFetchedAt: time.Now().Add(-1 * time.Minute),
+			})
+			return c
+		}
+
+		t.Run("returns the last known report if the server is failing", func(t *testing.T) {
+			c := newStartedCache(t, Config{LatestReportTTL: neverExpireTTL, StaleFallbackAge: time.Hour})
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			got, err := c.LatestReport(timeoutCtx, req1)
+			require.NoError(t, err)
+			assert.Equal(t, resp, got)
+
+			// the fetch has failed by now, so the fallback is returned
+			// without waiting for the context
+			got, err = c.LatestReport(ctx, req1)
+			require.NoError(t, err)
+			assert.Equal(t, resp, got)
+		})
+		t.Run("returns error if the last known report is too old", func(t *testing.T) {
+			c := newStartedCache(t, Config{LatestReportTTL: neverExpireTTL, StaleFallbackAge: time.Second})
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			_, err := c.LatestReport(timeoutCtx, req1)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+		t.Run("returns error if disabled", func(t *testing.T) {
+			c := newStartedCache(t, Config{LatestReportTTL: neverExpireTTL})
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			_, err := c.LatestReport(timeoutCtx, req1)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+	})
 }
@@ -9,8 +9,9 @@ import (
 var _ Client = &mockClient{}
 
 type mockClient struct {
-	resp *pb.LatestReportResponse
-	err  error
+	resp      *pb.LatestReportResponse
+	err       error
+	serverURL string
 }
 
 func (m *mockClient) LatestReport(ctx context.Context, req *pb.LatestReportRequest) (resp *pb.LatestReportResponse, err error) {
@@ -18,6 +19,9 @@ func (m *mockClient) LatestReport(ctx context.Context, req *pb.LatestReportReque
 }
 
 func (m *mockClient) ServerURL() string {
+	if m.serverURL != "" {
+		return m.serverURL
+	}
 	return "mock client url"
 }
 
@@ -22,16 +22,19 @@ type DelegateConfig interface {
 }
 
 type Delegate struct {
-	lggr     logger.Logger
-	registry Registry
-	runner   ocrcommon.Runner
-	cfg      DelegateConfig
+	lggr         logger.Logger
+	registry     Registry
+	runner       ocrcommon.Runner
+	cfg          DelegateConfig
+	chainReaders ChainReaderFactory
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(lggr logger.Logger, registry Registry, runner ocrcommon.Runner, cfg DelegateConfig) *Delegate {
-	return &Delegate{lggr.Named("StreamsDelegate"), registry, runner, cfg}
+// NewDelegate returns the delegate of stream jobs. chainReaders may be nil,
+// in which case native sources which read on-chain state are not supported.
+func NewDelegate(lggr logger.Logger, registry Registry, runner ocrcommon.Runner, cfg DelegateConfig, chainReaders ChainReaderFactory) *Delegate {
+	return &Delegate{lggr.Named("StreamsDelegate"), registry, runner, cfg, chainReaders}
 }
 
 func (d *Delegate) JobType() job.Type {
@@ -50,13 +53,45 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 	id := *jb.StreamID
 	lggr := d.lggr.Named(fmt.Sprintf("%d", id)).With("streamID", id)
 
+	if len(jb.StreamSource) > 0 {
+		return d.servicesForSource(lggr, id, jb.StreamSource)
+	}
+
 	rrs := ocrcommon.NewResultRunSaver(d.runner, lggr, d.cfg.MaxSuccessfulRuns(), d.cfg.ResultWriteQueueDepth())
 	services = append(services, rrs, &StreamService{
-		d.registry,
-		id,
-		jb.PipelineSpec,
-		lggr,
-		rrs,
+		registry: d.registry,
+		id:       id,
+		spec:     jb.PipelineSpec,
+		lggr:     lggr,
+		rrs:      rrs,
+	})
+	return services, nil
+}
+
+func (d *Delegate) servicesForSource(lggr logger.Logger, id StreamID, cfg job.JSONConfig) (services []job.ServiceCtx, err error) {
+	sc, err := ParseSourceConfig(id, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var source Source
+	switch sc.Type {
+	case SourceTypeAggregator:
+		var cr ChainReaderService
+		source, cr, err = newAggregatorSource(lggr, d.chainReaders, sc)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, cr)
+	default:
+		source = newDerivedSource(id, d.registry, sc)
+	}
+
+	services = append(services, &StreamService{
+		registry: d.registry,
+		id:       id,
+		strm:     newNativeStream(id, source),
+		lggr:     lggr,
 	})
 	return services, nil
 }
@@ -69,11 +104,17 @@ type StreamService struct {
 	registry Registry
 	id       StreamID
 	spec     *pipeline.Spec
-	lggr     logger.Logger
-	rrs      ResultRunSaver
+	// strm is set instead of spec for streams with a native source
+	strm Stream
+	lggr logger.Logger
+	rrs  ResultRunSaver
 }
 
 func (s *StreamService) Start(_ context.Context) error {
+	if s.strm != nil {
+		s.lggr.Debugf("Starting stream %d with native source", s.id)
+		return s.registry.RegisterStream(s.id, s.strm)
+	}
 	if s.spec == nil {
 		return fmt.Errorf("pipeline spec unexpectedly missing for stream %q", s.id)
 	}
@@ -106,5 +147,16 @@ func ValidatedStreamSpec(tomlString string) (job.Job, error) {
 		return jb, errors.New("jobs of type 'stream' require streamID to be specified")
 	}
 
+	if len(jb.StreamSource) > 0 {
+		if jb.Pipeline.Source != "" {
+			return jb, errors.New("jobs of type 'stream' must specify either observationSource or streamSource, not both")
+		}
+		if _, err = ParseSourceConfig(*jb.StreamID, jb.StreamSource); err != nil {
+			return jb, err
+		}
+	} else if jb.Pipeline.Source == "" {
+		return jb, errors.New("jobs of type 'stream' require observationSource or streamSource to be specified")
+	}
+
 	return jb, nil
 }
@@ -19,7 +19,8 @@ func (m *mockRegistry) Get(streamID StreamID) (strm Stream, exists bool) { retur
 func (m *mockRegistry) Register(streamID StreamID, spec pipeline.Spec, rrs ResultRunSaver) error {
 	return nil
 }
-func (m *mockRegistry) Unregister(streamID StreamID) {}
+func (m *mockRegistry) RegisterStream(streamID StreamID, strm Stream) error { return nil }
+func (m *mockRegistry) Unregister(streamID StreamID)                        {}
 
 type mockDelegateConfig struct{}
 
@@ -31,7 +32,8 @@ func Test_Delegate(t *testing.T) {
 	registry := &mockRegistry{}
 	runner := &mockRunner{}
 	cfg := &mockDelegateConfig{}
-	d := NewDelegate(lggr, registry, runner, cfg)
+	chainReaders := &mockChainReaderFactory{cr: &mockChainReader{}}
+	d := NewDelegate(lggr, registry, runner, cfg, chainReaders)
 
 	t.Run("ServicesForSpec", func(t *testing.T) {
 		jb := job.Job{PipelineSpec: &pipeline.Spec{ID: 1}}
@@ -54,6 +56,40 @@ func Test_Delegate(t *testing.T) {
 			assert.NotNil(t, strmSrv.lggr)
 			assert.Equal(t, srvs[0], strmSrv.rrs)
 		})
+		t.Run("returns services for a derived source", func(t *testing.T) {
+			jb := jb
+			jb.StreamSource = job.JSONConfig{"type": "ratio", "streamIDs": []interface{}{1, 2}}
+
+			srvs, err := d.ServicesForSpec(testutils.Context(t), jb)
+			require.NoError(t, err)
+
+			require.Len(t, srvs, 1)
+			strmSrv := srvs[0].(*StreamService)
+			assert.Equal(t, StreamID(42), strmSrv.id)
+			assert.Nil(t, strmSrv.spec)
+			assert.IsType(t, &nativeStream{}, strmSrv.strm)
+		})
+		t.Run("returns services for an aggregator source", func(t *testing.T) {
+			jb := jb
+			jb.StreamSource = job.JSONConfig{"type": "aggregator", "chainID": "1", "contractAddress": "0x0000000000000000000000000000000000000001"}
+
+			srvs, err := d.ServicesForSpec(testutils.Context(t), jb)
+			require.NoError(t, err)
+
+			require.Len(t, srvs, 2)
+			assert.Equal(t, chainReaders.cr, srvs[0])
+			assert.IsType(t, &StreamService{}, srvs[1])
+			assert.Equal(t, "1", chainReaders.chainID)
+			assert.Equal(t, []string{"latestRoundData"}, chainReaders.methods)
+			assert.Equal(t, "0x0000000000000000000000000000000000000001", chainReaders.cr.bound)
+		})
+		t.Run("errors on invalid source", func(t *testing.T) {
+			jb := jb
+			jb.StreamSource = job.JSONConfig{"type": "foo"}
+
+			_, err := d.ServicesForSpec(testutils.Context(t), jb)
+			assert.ErrorContains(t, err, `invalid streamSource type "foo"`)
+		})
 	})
 }
 
@@ -88,6 +124,73 @@ answer1      [type=median index=0];
 				assert.Equal(t, "voter-turnout", jb.Name.String)
 			},
 		},
+		{
+			name: "stream spec with derived source",
+			toml: `
+type               = "stream"
+streamID           = 3
+name               = "eth-btc"
+schemaVersion      = 1
+
+[streamSource]
+type               = "ratio"
+streamIDs          = [1, 2]
+decimals           = 8
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "", jb.Pipeline.Source)
+				sc, err := ParseSourceConfig(3, jb.StreamSource)
+				require.NoError(t, err)
+				assert.Equal(t, SourceTypeRatio, sc.Type)
+				assert.Equal(t, []StreamID{1, 2}, sc.StreamIDs)
+				assert.Equal(t, uint8(8), sc.decimals())
+			},
+		},
+		{
+			name: "error if both observationSource and streamSource",
+			toml: `
+type               = "stream"
+streamID           = 3
+schemaVersion      = 1
+observationSource  = """
+ds1 [type=memo value=1];
+"""
+
+[streamSource]
+type               = "product"
+streamIDs          = [1, 2]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				assert.EqualError(t, err, "jobs of type 'stream' must specify either observationSource or streamSource, not both")
+			},
+		},
+		{
+			name: "error if neither observationSource nor streamSource",
+			toml: `
+type               = "stream"
+streamID           = 3
+schemaVersion      = 1
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				assert.EqualError(t, err, "jobs of type 'stream' require observationSource or streamSource to be specified")
+			},
+		},
+		{
+			name: "error if invalid streamSource",
+			toml: `
+type               = "stream"
+streamID           = 3
+schemaVersion      = 1
+
+[streamSource]
+type               = "ratio"
+streamIDs          = [1, 3]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				assert.EqualError(t, err, "streamSource of stream 3 must not depend on itself")
+			},
+		},
 		{
 			name: "unparseable toml",
 			toml: `not toml`,
@@ -0,0 +1,49 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
+)
+
+var _ streams.ChainReaderFactory = (*chainReaderFactory)(nil)
+
+type chainReaderFactory struct {
+	chains legacyevm.LegacyChainContainer
+}
+
+// NewChainReaderFactory returns a factory of ChainReaders of the methods of
+// contracts on the EVM chains.
+func NewChainReaderFactory(chains legacyevm.LegacyChainContainer) streams.ChainReaderFactory {
+	return &chainReaderFactory{chains}
+}
+
+func (f *chainReaderFactory) NewChainReader(lggr logger.Logger, chainID string, contractName string, contractABI string, methods ...string) (streams.ChainReaderService, error) {
+	if f.chains == nil {
+		return nil, fmt.Errorf("EVM is not enabled")
+	}
+	chain, err := f.chains.Get(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[string]*types.ChainReaderDefinition, len(methods))
+	for _, method := range methods {
+		configs[method] = &types.ChainReaderDefinition{
+			ChainSpecificName: method,
+			ReadType:          types.Method,
+		}
+	}
+	return evm.NewChainReaderService(lggr, chain.LogPoller(), chain, types.ChainReaderConfig{
+		Contracts: map[string]types.ChainContractReader{
+			contractName: {
+				ContractABI: contractABI,
+				Configs:     configs,
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package streams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+var (
+	promScheduledRunErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_scheduled_run_error_count",
+		Help: "Number of scheduled runs of a stream's pipeline which failed and did not update its cached result",
+	},
+		[]string{"streamID"},
+	)
+	promStaleObservationCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_stale_observation_count",
+		Help: "Number of times a stream was observed, but its cached result was missing or too old to be served",
+	},
+		[]string{"streamID"},
+	)
+)
+
+// ErrNoObservation is returned by a scheduled stream which has not yet
+// completed a successful run.
+var ErrNoObservation = errors.New("stream has no observation yet")
+
+// RegistryConfig controls how the registry runs the pipelines of streams.
+type RegistryConfig interface {
+	// ObservationInterval is how often the pipeline of a stream is run in the
+	// background; zero runs it on every observation instead.
+	ObservationInterval() time.Duration
+	// MaxStaleness is the maximum age of a result served to observers.
+	MaxStaleness() time.Duration
+}
+
+type observation struct {
+	run        *pipeline.Run
+	trrs       pipeline.TaskRunResults
+	observedAt time.Time
+}
+
+// scheduledStream runs the pipeline of a stream at a fixed interval and
+// serves the last successful result to all of its observers, so that
+// channels sharing a stream do not each run its pipeline.
+type scheduledStream struct {
+	lggr         logger.Logger
+	id           StreamID
+	stream       Stream
+	interval     time.Duration
+	maxStaleness time.Duration
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	latest *observation
+}
+
+func newScheduledStream(lggr logger.Logger, id StreamID, strm Stream, interval, maxStaleness time.Duration) *scheduledStream {
+	return &scheduledStream{
+		lggr:         lggr.Named("ScheduledStream").With("streamID", id),
+		id:           id,
+		stream:       strm,
+		interval:     interval,
+		maxStaleness: maxStaleness,
+		stopCh:       make(services.StopChan),
+	}
+}
+
+func (s *scheduledStream) start() {
+	s.wg.Add(1)
+	go s.runLoop()
+}
+
+func (s *scheduledStream) close() {
+	close(s.stopCh)
+	s.wg.Wait()
+}
+
+// Run returns the result of the latest successful scheduled run, unless it
+// is older than maxStaleness.
+func (s *scheduledStream) Run(_ context.Context) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	s.mu.RLock()
+	latest := s.latest
+	s.mu.RUnlock()
+
+	if latest == nil {
+		promStaleObservationCount.WithLabelValues(fmt.Sprintf("%d", s.id)).Inc()
+		return nil, nil, ErrNoObservation
+	}
+	if age := time.Since(latest.observedAt); age > s.maxStaleness {
+		promStaleObservationCount.WithLabelValues(fmt.Sprintf("%d", s.id)).Inc()
+		return nil, nil, fmt.Errorf("latest observation of stream is stale: observed %s ago, max staleness is %s", age, s.maxStaleness)
+	}
+	return latest.run, latest.trrs, nil
+}
+
+func (s *scheduledStream) runLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.observe()
+
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *scheduledStream) observe() {
+	// A result older than maxStaleness would never be served, so there is no
+	// point in waiting longer than that for one
+	ctx, cancel := s.stopCh.CtxCancel(context.WithTimeout(context.Background(), s.maxStaleness))
+	defer cancel()
+
+	run, trrs, err := s.stream.Run(ctx)
+	if err == nil && trrs.FinalResult(s.lggr).HasErrors() {
+		err = errors.New("pipeline run returned errors")
+	}
+	if err != nil {
+		s.lggr.Debugw("Scheduled run failed; keeping previous observation", "err", err)
+		promScheduledRunErrorCount.WithLabelValues(fmt.Sprintf("%d", s.id)).Inc()
+		return
+	}
+
+	s.mu.Lock()
+	s.latest = &observation{run, trrs, time.Now()}
+	s.mu.Unlock()
+}
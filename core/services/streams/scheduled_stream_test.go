@@ -0,0 +1,92 @@
+package streams
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type mockRegistryConfig struct {
+	observationInterval time.Duration
+	maxStaleness        time.Duration
+}
+
+func (m mockRegistryConfig) ObservationInterval() time.Duration { return m.observationInterval }
+func (m mockRegistryConfig) MaxStaleness() time.Duration        { return m.maxStaleness }
+
+func Test_ScheduledStream(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{DotDagSource: `
+succeed             [type=memo value=42]
+succeed;
+`}
+	ctx := testutils.Context(t)
+
+	t.Run("Run", func(t *testing.T) {
+		runner := &mockRunner{}
+		sstrm := newScheduledStream(lggr, 1, newStream(lggr, 1, spec, runner, nil), time.Hour, time.Hour)
+
+		t.Run("errors before the first successful run", func(t *testing.T) {
+			_, _, err := sstrm.Run(ctx)
+			assert.ErrorIs(t, err, ErrNoObservation)
+		})
+
+		t.Run("serves the latest successful run", func(t *testing.T) {
+			runner.run = &pipeline.Run{ID: 1}
+			runner.trrs = []pipeline.TaskRunResult{{ID: UUID, Task: &MockTask{}, Result: pipeline.Result{Value: "42"}}}
+			sstrm.observe()
+
+			run, trrs, err := sstrm.Run(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), run.ID)
+			require.Len(t, trrs, 1)
+			assert.Equal(t, UUID, trrs[0].ID)
+		})
+
+		t.Run("keeps the last good result if a run fails", func(t *testing.T) {
+			runner.err = errors.New("something exploded")
+			sstrm.observe()
+			runner.err = nil
+
+			runner.run = &pipeline.Run{ID: 2}
+			runner.trrs = []pipeline.TaskRunResult{{Task: &MockTask{}, Result: pipeline.Result{Error: errors.New("bad response")}}}
+			sstrm.observe()
+
+			run, _, err := sstrm.Run(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), run.ID)
+		})
+
+		t.Run("errors if the latest result is stale", func(t *testing.T) {
+			sstrm.maxStaleness = time.Nanosecond
+			time.Sleep(time.Millisecond)
+
+			_, _, err := sstrm.Run(ctx)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "latest observation of stream is stale")
+		})
+	})
+
+	t.Run("runs the pipeline on schedule until closed", func(t *testing.T) {
+		runner := &mockRunner{
+			run:  &pipeline.Run{ID: 3},
+			trrs: []pipeline.TaskRunResult{{Task: &MockTask{}, Result: pipeline.Result{Value: "42"}}},
+		}
+		sstrm := newScheduledStream(lggr, 2, newStream(lggr, 2, spec, runner, nil), 10*time.Millisecond, time.Hour)
+		sstrm.start()
+
+		require.Eventually(t, func() bool {
+			_, _, err := sstrm.Run(ctx)
+			return err == nil
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		sstrm.close()
+	})
+}
@@ -0,0 +1,248 @@
+package streams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// Native source types of stream jobs.
+const (
+	// SourceTypeAggregator reads the latest answer of an on-chain aggregator.
+	SourceTypeAggregator = "aggregator"
+	// SourceTypeRatio divides the value of the first of StreamIDs by the
+	// value of the second, e.g. to derive a cross rate.
+	SourceTypeRatio = "ratio"
+	// SourceTypeProduct multiplies the values of StreamIDs.
+	SourceTypeProduct = "product"
+)
+
+// DefaultSourceDecimals is the fixed-point precision of derived sources,
+// unless one is configured.
+const DefaultSourceDecimals = 18
+
+const (
+	aggregatorContractName = "aggregator"
+	latestRoundDataMethod  = "latestRoundData"
+)
+
+// SourceConfig is the streamSource of a stream job.
+type SourceConfig struct {
+	Type string `json:"type"`
+	// StreamIDs are the inputs of derived (ratio and product) sources.
+	StreamIDs []StreamID `json:"streamIDs"`
+	// Decimals is the fixed-point precision of the inputs and the value of
+	// derived sources.
+	Decimals *uint8 `json:"decimals"`
+	// ChainID and ContractAddress locate the contract of aggregator sources.
+	ChainID         string `json:"chainID"`
+	ContractAddress string `json:"contractAddress"`
+}
+
+// ParseSourceConfig parses and validates the native source of stream id.
+func ParseSourceConfig(id StreamID, cfg job.JSONConfig) (sc SourceConfig, err error) {
+	if err = json.Unmarshal(cfg.Bytes(), &sc); err != nil {
+		return sc, fmt.Errorf("invalid streamSource: %w", err)
+	}
+	return sc, sc.validate(id)
+}
+
+func (sc SourceConfig) validate(id StreamID) error {
+	switch sc.Type {
+	case SourceTypeAggregator:
+		if len(sc.StreamIDs) > 0 || sc.Decimals != nil {
+			return fmt.Errorf("streamSource of type %q does not support streamIDs or decimals", sc.Type)
+		}
+		if sc.ChainID == "" {
+			return fmt.Errorf("streamSource of type %q requires a chainID", sc.Type)
+		}
+		if !common.IsHexAddress(sc.ContractAddress) {
+			return fmt.Errorf("streamSource of type %q requires a valid contractAddress, got: %q", sc.Type, sc.ContractAddress)
+		}
+	case SourceTypeRatio, SourceTypeProduct:
+		if sc.ChainID != "" || sc.ContractAddress != "" {
+			return fmt.Errorf("streamSource of type %q does not support chainID or contractAddress", sc.Type)
+		}
+		if sc.Type == SourceTypeRatio && len(sc.StreamIDs) != 2 {
+			return fmt.Errorf("streamSource of type %q requires exactly 2 streamIDs, got: %d", sc.Type, len(sc.StreamIDs))
+		}
+		if sc.Type == SourceTypeProduct && len(sc.StreamIDs) < 2 {
+			return fmt.Errorf("streamSource of type %q requires at least 2 streamIDs, got: %d", sc.Type, len(sc.StreamIDs))
+		}
+		if slices.Contains(sc.StreamIDs, id) {
+			return fmt.Errorf("streamSource of stream %d must not depend on itself", id)
+		}
+	default:
+		return fmt.Errorf("invalid streamSource type %q, must be one of: %q, %q, %q", sc.Type, SourceTypeAggregator, SourceTypeRatio, SourceTypeProduct)
+	}
+	return nil
+}
+
+func (sc SourceConfig) decimals() uint8 {
+	if sc.Decimals == nil {
+		return DefaultSourceDecimals
+	}
+	return *sc.Decimals
+}
+
+// Source observes the value of a stream without running a pipeline.
+type Source interface {
+	Observe(ctx context.Context) (*big.Int, error)
+}
+
+// nativeStream is a stream whose value is observed by a native Source. Its
+// results have the shape of a pipeline run with a single terminal task, so
+// that they are consumed like those of any other stream.
+type nativeStream struct {
+	id     StreamID
+	source Source
+}
+
+func newNativeStream(id StreamID, source Source) *nativeStream {
+	return &nativeStream{id, source}
+}
+
+func (s *nativeStream) Run(ctx context.Context) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	val, err := s.source.Observe(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Run failed: %w", err)
+	}
+	now := time.Now()
+	return nil, pipeline.TaskRunResults{{
+		ID:         uuid.New(),
+		Task:       &pipeline.MemoTask{},
+		Result:     pipeline.Result{Value: val},
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+	}}, nil
+}
+
+type observingKey struct{}
+
+// derivedSource computes a fixed-point value from the values of other
+// streams.
+type derivedSource struct {
+	id        StreamID
+	getter    Getter
+	typ       string
+	streamIDs []StreamID
+	scale     *big.Int
+}
+
+func newDerivedSource(id StreamID, getter Getter, sc SourceConfig) *derivedSource {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(sc.decimals())), nil)
+	return &derivedSource{id, getter, sc.Type, sc.StreamIDs, scale}
+}
+
+func (s *derivedSource) Observe(ctx context.Context) (*big.Int, error) {
+	// Track the derived streams being observed, so that a cycle of derived
+	// streams errors rather than recursing forever
+	observing, _ := ctx.Value(observingKey{}).([]StreamID)
+	if slices.Contains(observing, s.id) {
+		return nil, fmt.Errorf("cycle in derived streams: %v", append(observing, s.id))
+	}
+	ctx = context.WithValue(ctx, observingKey{}, append(slices.Clone(observing), s.id))
+
+	vals := make([]*big.Int, len(s.streamIDs))
+	for i, id := range s.streamIDs {
+		strm, exists := s.getter.Get(id)
+		if !exists {
+			return nil, fmt.Errorf("input stream %d is not registered", id)
+		}
+		_, trrs, err := strm.Run(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to observe input stream %d: %w", id, err)
+		}
+		if vals[i], err = ExtractBigInt(trrs); err != nil {
+			return nil, fmt.Errorf("failed to observe input stream %d: %w", id, err)
+		}
+	}
+
+	switch s.typ {
+	case SourceTypeRatio:
+		if vals[1].Sign() == 0 {
+			return nil, fmt.Errorf("cannot divide by input stream %d: value is zero", s.streamIDs[1])
+		}
+		res := new(big.Int).Mul(vals[0], s.scale)
+		return res.Quo(res, vals[1]), nil
+	case SourceTypeProduct:
+		res := new(big.Int).Set(vals[0])
+		for _, v := range vals[1:] {
+			res.Mul(res, v)
+			res.Quo(res, s.scale)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported derived source type: %q", s.typ)
+	}
+}
+
+// ChainReaderService is a ChainReader with a lifecycle, as created by a
+// ChainReaderFactory.
+type ChainReaderService interface {
+	job.ServiceCtx
+	commontypes.ChainReader
+}
+
+// ChainReaderFactory creates the ChainReaders of native sources which read
+// on-chain state.
+type ChainReaderFactory interface {
+	// NewChainReader returns a ChainReader of the methods of contractName,
+	// as defined by contractABI, on the chain with chainID.
+	NewChainReader(lggr logger.Logger, chainID string, contractName string, contractABI string, methods ...string) (ChainReaderService, error)
+}
+
+// latestRoundData are the return values of AggregatorV3Interface.latestRoundData.
+type latestRoundData struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}
+
+// aggregatorSource reads the latest answer of an aggregator contract.
+type aggregatorSource struct {
+	reader commontypes.ChainReader
+}
+
+// newAggregatorSource returns a source reading the aggregator at the
+// configured address, and the ChainReader service that it depends on.
+func newAggregatorSource(lggr logger.Logger, factory ChainReaderFactory, sc SourceConfig) (*aggregatorSource, ChainReaderService, error) {
+	if factory == nil {
+		return nil, nil, fmt.Errorf("streamSource of type %q is not supported: no chain readers", SourceTypeAggregator)
+	}
+	cr, err := factory.NewChainReader(lggr, sc.ChainID, aggregatorContractName, aggregator_v3_interface.AggregatorV3InterfaceMetaData.ABI, latestRoundDataMethod)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create chain reader for chain %s: %w", sc.ChainID, err)
+	}
+	if err = cr.Bind(context.Background(), []commontypes.BoundContract{{Name: aggregatorContractName, Address: sc.ContractAddress}}); err != nil {
+		return nil, nil, fmt.Errorf("failed to bind aggregator %s: %w", sc.ContractAddress, err)
+	}
+	return &aggregatorSource{cr}, cr, nil
+}
+
+func (s *aggregatorSource) Observe(ctx context.Context) (*big.Int, error) {
+	var res latestRoundData
+	if err := s.reader.GetLatestValue(ctx, aggregatorContractName, latestRoundDataMethod, nil, &res); err != nil {
+		return nil, fmt.Errorf("failed to read latestRoundData: %w", err)
+	}
+	if res.Answer == nil {
+		return nil, fmt.Errorf("latestRoundData returned no answer")
+	}
+	return res.Answer, nil
+}
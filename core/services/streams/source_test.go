@@ -0,0 +1,203 @@
+package streams
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type mockChainReader struct {
+	bound  string
+	answer *big.Int
+	err    error
+}
+
+func (m *mockChainReader) Start(context.Context) error { return nil }
+func (m *mockChainReader) Close() error                { return nil }
+func (m *mockChainReader) GetLatestValue(ctx context.Context, contractName string, method string, params, returnVal any) error {
+	if m.err != nil {
+		return m.err
+	}
+	returnVal.(*latestRoundData).Answer = m.answer
+	return nil
+}
+func (m *mockChainReader) Bind(ctx context.Context, bindings []commontypes.BoundContract) error {
+	m.bound = bindings[0].Address
+	return nil
+}
+
+type mockChainReaderFactory struct {
+	cr      *mockChainReader
+	chainID string
+	methods []string
+}
+
+func (m *mockChainReaderFactory) NewChainReader(lggr logger.Logger, chainID string, contractName string, contractABI string, methods ...string) (ChainReaderService, error) {
+	m.chainID = chainID
+	m.methods = methods
+	return m.cr, nil
+}
+
+type mapGetter map[StreamID]Stream
+
+func (m mapGetter) Get(streamID StreamID) (strm Stream, exists bool) {
+	strm, exists = m[streamID]
+	return
+}
+
+type valueSource struct {
+	val *big.Int
+	err error
+}
+
+func (v *valueSource) Observe(context.Context) (*big.Int, error) { return v.val, v.err }
+
+func Test_ParseSourceConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		cfg    job.JSONConfig
+		errMsg string
+	}{
+		{"ratio", job.JSONConfig{"type": "ratio", "streamIDs": []interface{}{1, 2}}, ""},
+		{"product", job.JSONConfig{"type": "product", "streamIDs": []interface{}{1, 2, 3}, "decimals": 8}, ""},
+		{"aggregator", job.JSONConfig{"type": "aggregator", "chainID": "1", "contractAddress": "0x0000000000000000000000000000000000000001"}, ""},
+		{"unknown type", job.JSONConfig{"type": "foo"}, `invalid streamSource type "foo"`},
+		{"ratio of one stream", job.JSONConfig{"type": "ratio", "streamIDs": []interface{}{1}}, "requires exactly 2 streamIDs, got: 1"},
+		{"product of one stream", job.JSONConfig{"type": "product", "streamIDs": []interface{}{1}}, "requires at least 2 streamIDs, got: 1"},
+		{"derived from itself", job.JSONConfig{"type": "product", "streamIDs": []interface{}{1, 42}}, "must not depend on itself"},
+		{"derived with contract", job.JSONConfig{"type": "ratio", "streamIDs": []interface{}{1, 2}, "chainID": "1"}, "does not support chainID or contractAddress"},
+		{"aggregator without chain", job.JSONConfig{"type": "aggregator", "contractAddress": "0x0000000000000000000000000000000000000001"}, "requires a chainID"},
+		{"aggregator with invalid address", job.JSONConfig{"type": "aggregator", "chainID": "1", "contractAddress": "foo"}, "requires a valid contractAddress"},
+		{"aggregator with streams", job.JSONConfig{"type": "aggregator", "chainID": "1", "streamIDs": []interface{}{1}}, "does not support streamIDs or decimals"},
+		{"invalid decimals", job.JSONConfig{"type": "ratio", "streamIDs": []interface{}{1, 2}, "decimals": -1}, "invalid streamSource"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSourceConfig(42, tc.cfg)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func Test_NativeStream(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	t.Run("returns the value as a single terminal result", func(t *testing.T) {
+		strm := newNativeStream(1, &valueSource{val: big.NewInt(42)})
+
+		run, trrs, err := strm.Run(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, run)
+
+		val, err := ExtractBigInt(trrs)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), val)
+	})
+	t.Run("errors if the source errors", func(t *testing.T) {
+		strm := newNativeStream(1, &valueSource{err: errors.New("boom")})
+
+		_, _, err := strm.Run(ctx)
+		assert.EqualError(t, err, "Run failed: boom")
+	})
+}
+
+func Test_DerivedSource(t *testing.T) {
+	ctx := testutils.Context(t)
+	decimals := uint8(2)
+	getter := mapGetter{
+		1: newNativeStream(1, &valueSource{val: big.NewInt(300_00)}),
+		2: newNativeStream(2, &valueSource{val: big.NewInt(1_50)}),
+		3: newNativeStream(3, &valueSource{val: big.NewInt(0)}),
+		4: newNativeStream(4, &valueSource{err: errors.New("boom")}),
+	}
+
+	t.Run("ratio", func(t *testing.T) {
+		src := newDerivedSource(10, getter, SourceConfig{Type: SourceTypeRatio, StreamIDs: []StreamID{1, 2}, Decimals: &decimals})
+
+		val, err := src.Observe(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(200_00), val)
+	})
+	t.Run("ratio errors on division by zero", func(t *testing.T) {
+		src := newDerivedSource(10, getter, SourceConfig{Type: SourceTypeRatio, StreamIDs: []StreamID{1, 3}, Decimals: &decimals})
+
+		_, err := src.Observe(ctx)
+		assert.EqualError(t, err, "cannot divide by input stream 3: value is zero")
+	})
+	t.Run("product", func(t *testing.T) {
+		src := newDerivedSource(10, getter, SourceConfig{Type: SourceTypeProduct, StreamIDs: []StreamID{1, 2, 2}, Decimals: &decimals})
+
+		val, err := src.Observe(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(675_00), val)
+	})
+	t.Run("uses 18 decimals by default", func(t *testing.T) {
+		getter := mapGetter{
+			1: newNativeStream(1, &valueSource{val: new(big.Int).Mul(big.NewInt(3), big.NewInt(1e18))}),
+			2: newNativeStream(2, &valueSource{val: new(big.Int).Mul(big.NewInt(2), big.NewInt(1e18))}),
+		}
+		src := newDerivedSource(10, getter, SourceConfig{Type: SourceTypeRatio, StreamIDs: []StreamID{1, 2}})
+
+		val, err := src.Observe(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1.5e18), val)
+	})
+	t.Run("errors if an input is missing or fails", func(t *testing.T) {
+		src := newDerivedSource(10, getter, SourceConfig{Type: SourceTypeProduct, StreamIDs: []StreamID{1, 5}})
+		_, err := src.Observe(ctx)
+		assert.EqualError(t, err, "input stream 5 is not registered")
+
+		src = newDerivedSource(10, getter, SourceConfig{Type: SourceTypeProduct, StreamIDs: []StreamID{1, 4}})
+		_, err = src.Observe(ctx)
+		assert.EqualError(t, err, "failed to observe input stream 4: Run failed: boom")
+	})
+	t.Run("errors on a cycle of derived streams", func(t *testing.T) {
+		getter := mapGetter{1: newNativeStream(1, &valueSource{val: big.NewInt(1)})}
+		getter[10] = newNativeStream(10, newDerivedSource(10, getter, SourceConfig{Type: SourceTypeProduct, StreamIDs: []StreamID{1, 11}}))
+		getter[11] = newNativeStream(11, newDerivedSource(11, getter, SourceConfig{Type: SourceTypeProduct, StreamIDs: []StreamID{1, 10}}))
+
+		_, _, err := getter[10].Run(ctx)
+		assert.ErrorContains(t, err, "cycle in derived streams: [10 11 10]")
+	})
+}
+
+func Test_AggregatorSource(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	sc := SourceConfig{Type: SourceTypeAggregator, ChainID: "1", ContractAddress: "0x0000000000000000000000000000000000000001"}
+
+	t.Run("errors without chain readers", func(t *testing.T) {
+		_, _, err := newAggregatorSource(lggr, nil, sc)
+		assert.EqualError(t, err, `streamSource of type "aggregator" is not supported: no chain readers`)
+	})
+
+	cr := &mockChainReader{answer: big.NewInt(123)}
+	src, srv, err := newAggregatorSource(lggr, &mockChainReaderFactory{cr: cr}, sc)
+	require.NoError(t, err)
+	assert.Equal(t, cr, srv)
+
+	t.Run("observes the latest answer", func(t *testing.T) {
+		val, err := src.Observe(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(123), val)
+	})
+	t.Run("errors if the read fails", func(t *testing.T) {
+		cr.err = errors.New("boom")
+
+		_, err := src.Observe(ctx)
+		assert.EqualError(t, err, "failed to read latestRoundData: boom")
+	})
+}
@@ -16,6 +16,9 @@ type StreamID = llotypes.StreamID
 type Registry interface {
 	Getter
 	Register(streamID StreamID, spec pipeline.Spec, rrs ResultRunSaver) error
+	// RegisterStream registers a stream which is not defined by a pipeline,
+	// e.g. one with a native source.
+	RegisterStream(streamID StreamID, strm Stream) error
 	Unregister(streamID StreamID)
 }
 
@@ -27,18 +30,20 @@ type streamRegistry struct {
 	sync.RWMutex
 	lggr    logger.Logger
 	runner  Runner
+	cfg     RegistryConfig
 	streams map[StreamID]Stream
 }
 
-func NewRegistry(lggr logger.Logger, runner Runner) Registry {
-	return newRegistry(lggr, runner)
+func NewRegistry(lggr logger.Logger, runner Runner, cfg RegistryConfig) Registry {
+	return newRegistry(lggr, runner, cfg)
 }
 
-func newRegistry(lggr logger.Logger, runner Runner) *streamRegistry {
+func newRegistry(lggr logger.Logger, runner Runner, cfg RegistryConfig) *streamRegistry {
 	return &streamRegistry{
 		sync.RWMutex{},
 		lggr.Named("Registry"),
 		runner,
+		cfg,
 		make(map[StreamID]Stream),
 	}
 }
@@ -51,17 +56,30 @@ func (s *streamRegistry) Get(streamID StreamID) (strm Stream, exists bool) {
 }
 
 func (s *streamRegistry) Register(streamID StreamID, spec pipeline.Spec, rrs ResultRunSaver) error {
+	return s.RegisterStream(streamID, newStream(s.lggr, streamID, spec, s.runner, rrs))
+}
+
+func (s *streamRegistry) RegisterStream(streamID StreamID, strm Stream) error {
 	s.Lock()
 	defer s.Unlock()
 	if _, exists := s.streams[streamID]; exists {
 		return fmt.Errorf("stream already registered for id: %d", streamID)
 	}
-	s.streams[streamID] = NewStream(s.lggr, streamID, spec, s.runner, rrs)
+	if interval := s.cfg.ObservationInterval(); interval > 0 {
+		sstrm := newScheduledStream(s.lggr, streamID, strm, interval, s.cfg.MaxStaleness())
+		sstrm.start()
+		s.streams[streamID] = sstrm
+		return nil
+	}
+	s.streams[streamID] = strm
 	return nil
 }
 
 func (s *streamRegistry) Unregister(streamID StreamID) {
 	s.Lock()
 	defer s.Unlock()
+	if sstrm, ok := s.streams[streamID].(*scheduledStream); ok {
+		sstrm.close()
+	}
 	delete(s.streams, streamID)
 }
@@ -3,6 +3,7 @@ package streams
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,7 +27,7 @@ func Test_Registry(t *testing.T) {
 	runner := &mockRunner{}
 
 	t.Run("Get", func(t *testing.T) {
-		sr := newRegistry(lggr, runner)
+		sr := newRegistry(lggr, runner, mockRegistryConfig{})
 
 		sr.streams[1] = &mockStream{run: &pipeline.Run{ID: 1}}
 		sr.streams[2] = &mockStream{run: &pipeline.Run{ID: 2}}
@@ -49,7 +50,7 @@ func Test_Registry(t *testing.T) {
 		assert.False(t, exists)
 	})
 	t.Run("Register", func(t *testing.T) {
-		sr := newRegistry(lggr, runner)
+		sr := newRegistry(lggr, runner, mockRegistryConfig{})
 
 		t.Run("registers new stream", func(t *testing.T) {
 			assert.Len(t, sr.streams, 0)
@@ -79,7 +80,7 @@ func Test_Registry(t *testing.T) {
 		})
 	})
 	t.Run("Unregister", func(t *testing.T) {
-		sr := newRegistry(lggr, runner)
+		sr := newRegistry(lggr, runner, mockRegistryConfig{})
 
 		sr.streams[1] = &mockStream{run: &pipeline.Run{ID: 1}}
 		sr.streams[2] = &mockStream{run: &pipeline.Run{ID: 2}}
@@ -104,4 +105,26 @@ func Test_Registry(t *testing.T) {
 			assert.False(t, exists)
 		})
 	})
+	t.Run("scheduling", func(t *testing.T) {
+		sr := newRegistry(lggr, runner, mockRegistryConfig{observationInterval: time.Hour, maxStaleness: time.Hour})
+
+		err := sr.Register(1, pipeline.Spec{ID: 32, DotDagSource: "source"}, nil)
+		require.NoError(t, err)
+
+		v, exists := sr.Get(1)
+		require.True(t, exists)
+		sstrm, ok := v.(*scheduledStream)
+		require.True(t, ok, "expected a scheduled stream, got %T", v)
+		assert.Equal(t, StreamID(1), sstrm.id)
+
+		sr.Unregister(1)
+
+		_, exists = sr.Get(1)
+		assert.False(t, exists)
+		select {
+		case <-sstrm.stopCh:
+		default:
+			t.Fatal("expected scheduled stream to be stopped")
+		}
+	})
 }
@@ -3,6 +3,7 @@ package vrf
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
@@ -41,6 +42,15 @@ type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	mailMon      *mailbox.Monitor
+
+	// queuesMu guards queues, the V2 and V2Plus listeners of the running
+	// jobs by job ID, see RequestQueues.
+	queuesMu sync.RWMutex
+	queues   map[int32]requestQueuer
+}
+
+type requestQueuer interface {
+	RequestQueues() map[string][]v2.QueuedRequest
 }
 
 func NewDelegate(
@@ -60,6 +70,7 @@ func NewDelegate(
 		legacyChains: legacyChains,
 		lggr:         lggr.Named("VRF"),
 		mailMon:      mailMon,
+		queues:       make(map[int32]requestQueuer),
 	}
 }
 
@@ -67,10 +78,40 @@ func (d *Delegate) JobType() job.Type {
 	return job.VRF
 }
 
-func (d *Delegate) BeforeJobCreated(job.Job)              {}
-func (d *Delegate) AfterJobCreated(job.Job)               {}
-func (d *Delegate) BeforeJobDeleted(job.Job)              {}
-func (d *Delegate) OnDeleteJob(job.Job, pg.Queryer) error { return nil }
+func (d *Delegate) BeforeJobCreated(job.Job) {}
+func (d *Delegate) AfterJobCreated(job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(job.Job) {}
+
+func (d *Delegate) OnDeleteJob(jb job.Job, _ pg.Queryer) error {
+	d.queuesMu.Lock()
+	defer d.queuesMu.Unlock()
+	delete(d.queues, jb.ID)
+	return nil
+}
+
+// RequestQueues returns the request queue of each subscription of a V2 or
+// V2Plus job as of its last processing round, and false if the services of the
+// job have not been created.
+func (d *Delegate) RequestQueues(jobID int32) (map[string][]v2.QueuedRequest, bool) {
+	d.queuesMu.RLock()
+	defer d.queuesMu.RUnlock()
+	q, ok := d.queues[jobID]
+	if !ok {
+		return nil, false
+	}
+	return q.RequestQueues(), true
+}
+
+// withRequestQueues records the listener of a job, so that its request queues
+// can be shown.
+func (d *Delegate) withRequestQueues(jobID int32, lsn job.ServiceCtx) job.ServiceCtx {
+	if q, ok := lsn.(requestQueuer); ok {
+		d.queuesMu.Lock()
+		defer d.queuesMu.Unlock()
+		d.queues[jobID] = q
+	}
+	return lsn
+}
 
 // ServicesForSpec satisfies the job.Delegate interface.
 func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
@@ -164,28 +205,30 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				return nil, errors.Wrap(err2, "NewAggregatorV3Interface")
 			}
 
-			return []job.ServiceCtx{
-				v2.New(
-					chain.Config().EVM(),
-					chain.Config().EVM().GasEstimator(),
-					lV2Plus,
-					chain,
-					chain.ID(),
-					d.q,
-					v2.NewCoordinatorV2_5(coordinatorV2Plus),
-					batchCoordinatorV2,
-					vrfOwner,
-					aggregator,
-					d.pr,
-					d.ks.Eth(),
-					jb,
-					func() {},
-					// the lookback in the deduper must be >= the lookback specified for the log poller
-					// otherwise we will end up re-delivering logs that were already delivered.
-					vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
-					vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-				),
-			}, nil
+			lsn, err2 := v2.New(
+				chain.Config().EVM(),
+				chain.Config().EVM().GasEstimator(),
+				lV2Plus,
+				chain,
+				chain.ID(),
+				d.q,
+				v2.NewCoordinatorV2_5(coordinatorV2Plus),
+				batchCoordinatorV2,
+				vrfOwner,
+				aggregator,
+				d.pr,
+				d.ks.Eth(),
+				jb,
+				func() {},
+				// the lookback in the deduper must be >= the lookback specified for the log poller
+				// otherwise we will end up re-delivering logs that were already delivered.
+				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
+				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+			)
+			if err2 != nil {
+				return nil, err2
+			}
+			return []job.ServiceCtx{d.withRequestQueues(jb.ID, lsn)}, nil
 		}
 		if _, ok := task.(*pipeline.VRFTaskV2); ok {
 			if err2 := CheckFromAddressesExist(ctx, jb, d.ks.Eth()); err != nil {
@@ -219,7 +262,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				lV2.Infow("Running without VRFOwnerAddress set on the spec")
 			}
 
-			return []job.ServiceCtx{v2.New(
+			lsn, err := v2.New(
 				chain.Config().EVM(),
 				chain.Config().EVM().GasEstimator(),
 				lV2,
@@ -238,8 +281,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				// otherwise we will end up re-delivering logs that were already delivered.
 				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
-			),
-			}, nil
+			)
+			if err != nil {
+				return nil, err
+			}
+			return []job.ServiceCtx{d.withRequestQueues(jb.ID, lsn)}, nil
 		}
 		if _, ok := task.(*pipeline.VRFTask); ok {
 			return []job.ServiceCtx{&v1.Listener{
@@ -81,8 +81,8 @@ func New(
 	reqAdded func(),
 	inflightCache vrfcommon.InflightCache,
 	fulfillmentDeduper *vrfcommon.LogDeduper,
-) job.ServiceCtx {
-	return &listenerV2{
+) (job.ServiceCtx, error) {
+	lsn := &listenerV2{
 		cfg:                   cfg,
 		feeCfg:                feeCfg,
 		l:                     logger.Sugared(l),
@@ -104,6 +104,21 @@ func New(
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
 	}
+	if job.VRFSpec.MulticallAddress != nil {
+		// The fulfillments are simulated together through the multicall
+		// contract, see simulateWithMulticall, so the pipeline is run without
+		// its simulation.
+		spec := *job.PipelineSpec
+		p, err := pipeline.ParseWithoutTasks(spec.DotDagSource, pipeline.TaskTypeETHCall)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing pipeline without simulation")
+		}
+		spec.Pipeline = p
+		if lsn.pipelineWithoutSimulation, err = pipelineRunner.InitializePipeline(spec); err != nil {
+			return nil, errors.Wrap(err, "initializing pipeline without simulation")
+		}
+	}
+	return lsn, nil
 }
 
 type listenerV2 struct {
@@ -120,9 +135,12 @@ type listenerV2 struct {
 
 	pipelineRunner pipeline.Runner
 	job            job.Job
-	q              pg.Q
-	gethks         keystore.Eth
-	chStop         services.StopChan
+	// pipelineWithoutSimulation is the pipeline of the job without its eth_call
+	// tasks, set when fulfillments are simulated through a multicall contract.
+	pipelineWithoutSimulation *pipeline.Pipeline
+	q                         pg.Q
+	gethks                    keystore.Eth
+	chStop                    services.StopChan
 
 	reqAdded func() // A simple debug helper
 
@@ -152,6 +170,11 @@ type listenerV2 struct {
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// lastQueues are the request queues of the subscriptions as of the
+	// last processing round, see RequestQueues.
+	lastQueuesMu sync.RWMutex
+	lastQueues   map[string][]QueuedRequest
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
@@ -1,7 +1,6 @@
 package v2
 
 import (
-	"cmp"
 	"context"
 	"database/sql"
 	"fmt"
@@ -109,7 +108,12 @@ func (lsn *listenerV2) pruneConfirmedRequestCounts() {
 // Its easier to optimistically assume it will go though and in the rare case of a reversion
 // we simply retry TODO: follow up where if we see a fulfillment revert, return log to the queue.
 func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingRequests []pendingRequest) {
-	confirmed := lsn.getConfirmedLogsBySub(lsn.getLatestHead(), pendingRequests)
+	latestHead := lsn.getLatestHead()
+	confirmed := lsn.getConfirmedLogsBySub(latestHead, pendingRequests)
+	for _, reqs := range confirmed {
+		sortRequests(reqs, lsn.job.VRFSpec.FulfillmentPriority)
+	}
+	lsn.reportRequestQueues(lsn.requestQueues(latestHead, pendingRequests, confirmed))
 	var processedMu sync.Mutex
 	processed := make(map[string]struct{})
 	start := time.Now()
@@ -135,7 +139,8 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 		lsn.l.Infow("No pending requests ready for processing")
 		return
 	}
-	for subID, reqs := range confirmed {
+	for _, subID := range orderSubscriptions(confirmed, lsn.job.VRFSpec.PrioritySubscriptionIDs) {
+		reqs := confirmed[subID]
 		l := lsn.l.With("subID", subID, "startTime", time.Now(), "numReqsForSub", len(reqs))
 		// Get the balance of the subscription and also it's active status.
 		// The reason we need both is that we cannot determine if a subscription
@@ -179,15 +184,6 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 			subIsActive = true
 		}
 
-		// Sort requests in ascending order by CallbackGasLimit
-		// so that we process the "cheapest" requests for each subscription
-		// first. This allows us to break out of the processing loop as early as possible
-		// in the event that a subscription is too underfunded to have it's
-		// requests processed.
-		slices.SortFunc(reqs, func(a, b pendingRequest) int {
-			return cmp.Compare(a.req.CallbackGasLimit(), b.req.CallbackGasLimit())
-		})
-
 		p := lsn.processRequestsPerSub(ctx, sID, startLinkBalance, startEthBalance, reqs, subIsActive)
 		processedMu.Lock()
 		for reqID := range p {
@@ -371,6 +367,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 		observeRequestSimDuration(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), unfulfilled)
 
 		pipelines := lsn.runPipelines(ctx, l, maxGasPriceWei, unfulfilled)
+		lsn.sortByMaxFee(pipelines)
 		batches := newBatchFulfillments(batchMaxGas, lsn.coordinator.Version())
 		outOfBalance := false
 		for _, p := range pipelines {
@@ -427,6 +424,10 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					}
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
+						if lsn.skipUnaffordable() {
+							ll.Infow("Insufficient balance to fulfill a request based on estimate, skipping", "err", p.err)
+							continue
+						}
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, breaking", "err", p.err)
 						outOfBalance = true
 
@@ -452,6 +453,10 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 
 			if startBalanceNoReserved.Cmp(p.maxFee) < 0 {
 				// Insufficient funds, have to wait for a user top up.
+				if lsn.skipUnaffordable() {
+					ll.Infow("Insufficient balance to fulfill a request, skipping")
+					continue
+				}
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient balance to fulfill a request, breaking")
@@ -704,6 +709,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 		maxGasPriceWei := lsn.feeCfg.PriceMaxKey(fromAddresses[0])
 		observeRequestSimDuration(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), unfulfilled)
 		pipelines := lsn.runPipelines(ctx, l, maxGasPriceWei, unfulfilled)
+		lsn.sortByMaxFee(pipelines)
 		for _, p := range pipelines {
 			ll := l.With("reqID", p.req.req.RequestID().String(),
 				"txHash", p.req.req.Raw().TxHash,
@@ -758,6 +764,10 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					}
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 {
+						if lsn.skipUnaffordable() {
+							ll.Infow("Insufficient balance to fulfill a request based on estimate, skipping", "err", p.err)
+							continue
+						}
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, returning", "err", p.err)
 						return processed
 					}
@@ -780,6 +790,10 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 
 			if startBalanceNoReserved.Cmp(p.maxFee) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
+				if lsn.skipUnaffordable() {
+					ll.Infow("Insufficient balance to fulfill a request, skipping")
+					continue
+				}
 				ll.Infow("Insufficient balance to fulfill a request, returning")
 				return processed
 			}
@@ -1045,26 +1059,55 @@ func (lsn *listenerV2) runPipelines(
 		start   = time.Now()
 		results = make([]vrfPipelineResult, len(reqs))
 		wg      = sync.WaitGroup{}
+
+		weiPerUnitLink *big.Int
+		linkPriceErr   error
 	)
 
+	// The LINK price is only needed to estimate the fees of requests paid in LINK,
+	// read it once for all of them rather than once per request.
+	if slices.ContainsFunc(reqs, func(req pendingRequest) bool { return !req.req.NativePayment() }) {
+		weiPerUnitLink, linkPriceErr = lsn.latestWeiPerUnitLink(ctx)
+	}
+
 	for i, req := range reqs {
 		wg.Add(1)
 		go func(i int, req pendingRequest) {
 			defer wg.Done()
-			results[i] = lsn.simulateFulfillment(ctx, maxGasPriceWei, req, l)
+			results[i] = lsn.simulateFulfillment(ctx, maxGasPriceWei, weiPerUnitLink, linkPriceErr, req, l)
 		}(i, req)
 	}
 	wg.Wait()
+	if lsn.job.VRFSpec.MulticallAddress != nil {
+		lsn.simulateWithMulticall(ctx, maxGasPriceWei, results, l)
+	}
 
 	l.Debugw("Finished running pipelines",
 		"count", len(reqs), "time", time.Since(start).String())
 	return results
 }
 
-func (lsn *listenerV2) estimateFee(
-	ctx context.Context,
+// latestWeiPerUnitLink reads the LINK price that the fees of requests paid in LINK are
+// estimated with.
+func (lsn *listenerV2) latestWeiPerUnitLink(ctx context.Context) (*big.Int, error) {
+	if lsn.aggregator == nil {
+		return nil, errors.New("no LINK/ETH aggregator")
+	}
+	// Don't use up too much time to get this info, it's not critical for operating vrf.
+	callCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: callCtx})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregator latestAnswer: %w", err)
+	}
+	return roundData.Answer, nil
+}
+
+func estimateFee(
 	req RandomWordsRequested,
 	maxGasPriceWei *assets.Wei,
+	weiPerUnitLink *big.Int,
+	linkPriceErr error,
 ) (*big.Int, error) {
 	// NativePayment() returns true if and only if the version is V2+ and the
 	// request was made in ETH.
@@ -1073,18 +1116,14 @@ func (lsn *listenerV2) estimateFee(
 	}
 
 	// In the event we are using LINK we need to estimate the fee in juels
-	// Don't use up too much time to get this info, it's not critical for operating vrf.
-	callCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
-	defer cancel()
-	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: callCtx})
-	if err != nil {
-		return nil, fmt.Errorf("get aggregator latestAnswer: %w", err)
+	if linkPriceErr != nil {
+		return nil, linkPriceErr
 	}
 
 	return EstimateFeeJuels(
 		req.CallbackGasLimit(),
 		maxGasPriceWei.ToInt(),
-		roundData.Answer,
+		weiPerUnitLink,
 	)
 }
 
@@ -1093,6 +1132,8 @@ func (lsn *listenerV2) estimateFee(
 func (lsn *listenerV2) simulateFulfillment(
 	ctx context.Context,
 	maxGasPriceWei *assets.Wei,
+	weiPerUnitLink *big.Int,
+	linkPriceErr error,
 	req pendingRequest,
 	lg logger.Logger,
 ) vrfPipelineResult {
@@ -1101,7 +1142,7 @@ func (lsn *listenerV2) simulateFulfillment(
 		err error
 	)
 	// estimate how much funds are needed so that we can log it if the simulation fails.
-	res.fundsNeeded, err = lsn.estimateFee(ctx, req.req, maxGasPriceWei)
+	res.fundsNeeded, err = estimateFee(req.req, maxGasPriceWei, weiPerUnitLink, linkPriceErr)
 	if err != nil {
 		// not critical, just log and continue
 		lg.Warnw("unable to estimate funds needed for request, continuing anyway",
@@ -1127,21 +1168,23 @@ func (lsn *listenerV2) simulateFulfillment(
 			"logData":        req.req.Raw().Data,
 		},
 	})
+	spec := *lsn.job.PipelineSpec
+	if lsn.job.VRFSpec.MulticallAddress != nil {
+		// The fulfillment is simulated together with the others of the chunk
+		// through the multicall contract, see simulateWithMulticall.
+		spec.Pipeline = lsn.pipelineWithoutSimulation
+	}
 	var trrs pipeline.TaskRunResults
-	res.run, trrs, err = lsn.pipelineRunner.ExecuteRun(ctx, *lsn.job.PipelineSpec, vars, lg)
+	res.run, trrs, err = lsn.pipelineRunner.ExecuteRun(ctx, spec, vars, lg)
 	if err != nil {
 		res.err = fmt.Errorf("executing run: %w", err)
 		return res
 	}
 	// The call task will fail if there are insufficient funds
 	if res.run.AllErrors.HasError() {
-		res.err = errors.WithStack(res.run.AllErrors.ToError())
+		res.err = simulationError(errors.WithStack(res.run.AllErrors.ToError()))
 
-		if strings.Contains(res.err.Error(), "blockhash not found in store") {
-			res.err = multierr.Combine(res.err, errBlockhashNotInStore{})
-		} else if isProofVerificationError(res.err.Error()) {
-			res.err = multierr.Combine(res.err, errProofVerificationFailed{})
-		} else if strings.Contains(res.err.Error(), "execution reverted") {
+		if errors.Is(res.err, errPossiblyInsufficientFunds{}) {
 			// Even if the simulation fails, we want to get the
 			// txData for the fulfillRandomWords call, in case
 			// we need to force fulfill.
@@ -1165,11 +1208,14 @@ func (lsn *listenerV2) simulateFulfillment(
 					res.reqCommitment = NewRequestCommitment(m["requestCommitment"])
 				}
 			}
-			res.err = multierr.Combine(res.err, errPossiblyInsufficientFunds{})
 		}
 
 		return res
 	}
+	if lsn.job.VRFSpec.MulticallAddress != nil {
+		setFulfillment(&res, trrs)
+		return res
+	}
 	finalResult := trrs.FinalResult(lg)
 	if len(finalResult.Values) != 1 {
 		res.err = errors.Errorf("unexpected number of outputs, expected 1, was %d", len(finalResult.Values))
@@ -1189,6 +1235,25 @@ func (lsn *listenerV2) simulateFulfillment(
 		return res
 	}
 
+	setFulfillment(&res, trrs)
+	return res
+}
+
+// simulationError marks the error of a failed simulation with its cause.
+func simulationError(err error) error {
+	if strings.Contains(err.Error(), "blockhash not found in store") {
+		return multierr.Combine(err, errBlockhashNotInStore{})
+	} else if isProofVerificationError(err.Error()) {
+		return multierr.Combine(err, errProofVerificationFailed{})
+	} else if strings.Contains(err.Error(), "execution reverted") {
+		return multierr.Combine(err, errPossiblyInsufficientFunds{})
+	}
+	return err
+}
+
+// setFulfillment sets the fulfillment generated by the VRF task, and its gas
+// limit, from the results of a successful pipeline run.
+func setFulfillment(res *vrfPipelineResult, trrs pipeline.TaskRunResults) {
 	for _, trr := range trrs {
 		if trr.Task.Type() == pipeline.TaskTypeVRFV2 {
 			m := trr.Result.Value.(map[string]interface{})
@@ -1208,7 +1273,6 @@ func (lsn *listenerV2) simulateFulfillment(
 			res.gasLimit = trr.Result.Value.(uint32)
 		}
 	}
-	return res
 }
 
 func (lsn *listenerV2) fromAddresses() []common.Address {
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// multicall3ABI is the ABI of the aggregate3 function of the Multicall3
+// contract, see https://github.com/mds1/multicall.
+var multicall3ABI = evmtypes.MustGetABI(`[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`)
+
+const (
+	// multicallGasOverhead is the gas used by the multicall contract itself,
+	// in addition to the gas limits of the simulated fulfillments.
+	multicallGasOverhead = 100_000
+	// multicallCallGasOverhead is the gas used by the multicall contract for
+	// each simulated fulfillment.
+	multicallCallGasOverhead = 10_000
+)
+
+// multicall3Call is a Multicall3.Call3.
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// multicall3Result is a Multicall3.Result.
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// simulateWithMulticall simulates the fulfillments of the results whose
+// pipeline run succeeded in a single eth_call through the job's multicall
+// contract, and sets their maxFee, or their err if the fulfillment reverts.
+// The fulfillments are simulated in order, so each one sees the balance left
+// by the previous ones.
+func (lsn *listenerV2) simulateWithMulticall(
+	ctx context.Context,
+	maxGasPriceWei *assets.Wei,
+	results []vrfPipelineResult,
+	lg logger.Logger,
+) {
+	var (
+		calls   []multicall3Call
+		indexes []int
+		gas     uint64 = multicallGasOverhead
+	)
+	for i, res := range results {
+		if res.err != nil {
+			continue
+		}
+		data, err := hexutil.Decode(res.payload)
+		if err != nil {
+			results[i].err = errors.Wrap(err, "decoding fulfillment payload")
+			continue
+		}
+		calls = append(calls, multicall3Call{
+			Target:       lsn.coordinator.Address(),
+			AllowFailure: true,
+			CallData:     data,
+		})
+		indexes = append(indexes, i)
+		gas += uint64(res.gasLimit) + multicallCallGasOverhead
+	}
+	if len(calls) == 0 {
+		return
+	}
+
+	returnData, err := lsn.multicall(ctx, maxGasPriceWei, gas, calls)
+	if err != nil {
+		lg.Errorw("Failed to simulate fulfillments with multicall", "numFulfillments", len(calls), "err", err)
+		for _, i := range indexes {
+			results[i].err = errors.Wrap(err, "simulating fulfillment with multicall")
+		}
+		return
+	}
+	for j, i := range indexes {
+		if !returnData[j].Success {
+			results[i].err = simulationError(revertError(returnData[j].ReturnData))
+			continue
+		}
+		// fulfillRandomWords returns the payment for the request
+		results[i].maxFee = new(big.Int).SetBytes(returnData[j].ReturnData)
+	}
+	lg.Debugw("Simulated fulfillments with multicall", "numFulfillments", len(calls), "gas", gas)
+}
+
+// multicall calls the job's multicall contract with calls, at the given gas
+// price like the ethcall tasks of the pipeline, and returns their results.
+func (lsn *listenerV2) multicall(ctx context.Context, gasPrice *assets.Wei, gas uint64, calls []multicall3Call) ([]multicall3Result, error) {
+	data, err := multicall3ABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "packing aggregate3 call")
+	}
+	to := lsn.job.VRFSpec.MulticallAddress.Address()
+	out, err := lsn.chain.Client().CallContract(ctx, ethereum.CallMsg{
+		To:       &to,
+		Gas:      gas,
+		GasPrice: gasPrice.ToInt(),
+		Data:     data,
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "calling aggregate3")
+	}
+	values, err := multicall3ABI.Unpack("aggregate3", out)
+	if err != nil {
+		return nil, errors.Wrap(err, "unpacking aggregate3 results")
+	}
+	results := *abi.ConvertType(values[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(results) != len(calls) {
+		return nil, errors.Errorf("expected %d aggregate3 results, got %d", len(calls), len(results))
+	}
+	return results, nil
+}
+
+// revertError returns the error of a reverted call with the given revert
+// data, in the format of the ethcall task.
+func revertError(data []byte) error {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return fmt.Errorf("execution reverted: %s", reason)
+	}
+	return fmt.Errorf("execution reverted: %s", hexutil.Encode(data))
+}
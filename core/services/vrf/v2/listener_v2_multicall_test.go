@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+func TestListener_SimulateWithMulticall(t *testing.T) {
+	multicallAddress := ethkey.EIP55AddressFromAddress(testutils.NewAddress())
+	coordinatorAddress := testutils.NewAddress()
+	coordinatorV2, err := vrf_coordinator_v2.NewVRFCoordinatorV2(coordinatorAddress, nil)
+	require.NoError(t, err)
+
+	ec := evmclimocks.NewClient(t)
+	chain := evmmocks.NewChain(t)
+	chain.On("Client").Return(ec).Maybe()
+	lsn := &listenerV2{
+		job:         job.Job{VRFSpec: &job.VRFSpec{MulticallAddress: &multicallAddress}},
+		chain:       chain,
+		coordinator: NewCoordinatorV2(coordinatorV2),
+	}
+
+	maxGasPrice := assets.GWei(100)
+	results := []vrfPipelineResult{
+		{payload: "0x01", gasLimit: 100_000},
+		{err: errors.New("proof generation failed")},
+		{payload: "0x02", gasLimit: 200_000},
+		{payload: "0x03", gasLimit: 300_000},
+	}
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("insufficient balance")
+	require.NoError(t, err)
+	out, err := multicall3ABI.Methods["aggregate3"].Outputs.Pack([]multicall3Result{
+		{Success: true, ReturnData: common.LeftPadBytes(big.NewInt(1_000).Bytes(), 32)},
+		{Success: false, ReturnData: append(hexutil.MustDecode("0x08c379a0"), reason...)},
+		{Success: true, ReturnData: common.LeftPadBytes(big.NewInt(3_000).Bytes(), 32)},
+	})
+	require.NoError(t, err)
+
+	ec.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		values, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(msg.Data[4:])
+		require.NoError(t, err)
+		calls := *abi.ConvertType(values[0], new([]multicall3Call)).(*[]multicall3Call)
+		return *msg.To == multicallAddress.Address() &&
+			msg.Gas == multicallGasOverhead+600_000+3*multicallCallGasOverhead &&
+			msg.GasPrice.Cmp(maxGasPrice.ToInt()) == 0 &&
+			assert.Equal(t, []multicall3Call{
+				{Target: coordinatorAddress, AllowFailure: true, CallData: []byte{1}},
+				{Target: coordinatorAddress, AllowFailure: true, CallData: []byte{2}},
+				{Target: coordinatorAddress, AllowFailure: true, CallData: []byte{3}},
+			}, calls)
+	}), (*big.Int)(nil)).Return(out, nil).Once()
+
+	lsn.simulateWithMulticall(testutils.Context(t), maxGasPrice, results, logger.TestLogger(t))
+
+	require.NoError(t, results[0].err)
+	assert.Equal(t, big.NewInt(1_000), results[0].maxFee)
+	assert.EqualError(t, results[1].err, "proof generation failed")
+	require.Error(t, results[2].err)
+	assert.True(t, errors.Is(results[2].err, errPossiblyInsufficientFunds{}))
+	assert.Contains(t, results[2].err.Error(), "execution reverted: insufficient balance")
+	assert.Nil(t, results[2].maxFee)
+	require.NoError(t, results[3].err)
+	assert.Equal(t, big.NewInt(3_000), results[3].maxFee)
+
+	t.Run("failed multicall fails all simulations", func(t *testing.T) {
+		results := []vrfPipelineResult{{payload: "0x01"}, {payload: "0x02"}}
+		ec.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("rpc down")).Once()
+
+		lsn.simulateWithMulticall(testutils.Context(t), maxGasPrice, results, logger.TestLogger(t))
+
+		for _, res := range results {
+			assert.ErrorContains(t, res.err, "rpc down")
+			assert.False(t, errors.Is(res.err, errPossiblyInsufficientFunds{}))
+		}
+	})
+}
@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// Statuses of pending requests in the request queue of a subscription,
+// describing why a request is pending.
+const (
+	// requestStatusUnconfirmed requests await their required confirmations.
+	requestStatusUnconfirmed = "unconfirmed"
+	// requestStatusBackoff requests failed to be fulfilled before, and await
+	// their next try.
+	requestStatusBackoff = "backoff"
+	// requestStatusInflight requests have a fulfillment transaction that is
+	// not yet confirmed on-chain.
+	requestStatusInflight = "inflight"
+	// requestStatusReady requests are processed in this round, in the order
+	// of their position, until the subscription runs out of funds.
+	requestStatusReady = "ready"
+)
+
+// QueuedRequest is a pending request in the request queue of a subscription.
+type QueuedRequest struct {
+	RequestID        string    `json:"requestID"`
+	Status           string    `json:"status"`
+	Position         int       `json:"position,omitempty"`
+	Attempts         int       `json:"attempts"`
+	ConfirmedAtBlock uint64    `json:"confirmedAtBlock"`
+	NextTry          time.Time `json:"nextTry"`
+}
+
+// sortRequests orders the requests of a subscription by the given fulfillment
+// priority.
+func sortRequests(reqs []pendingRequest, priority string) {
+	switch priority {
+	case vrfcommon.FulfillmentPriorityAge:
+		slices.SortStableFunc(reqs, func(a, b pendingRequest) int {
+			if c := cmp.Compare(a.req.Raw().BlockNumber, b.req.Raw().BlockNumber); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.req.Raw().Index, b.req.Raw().Index)
+		})
+	case vrfcommon.FulfillmentPriorityPayment:
+		// Payments are only known once simulated, see sortByMaxFee. Until then
+		// requests are ordered by their callback gas limit, which the fee
+		// estimated at the max gas price grows with.
+		slices.SortStableFunc(reqs, func(a, b pendingRequest) int {
+			return cmp.Compare(b.req.CallbackGasLimit(), a.req.CallbackGasLimit())
+		})
+	default:
+		// Sort requests in ascending order by CallbackGasLimit
+		// so that we process the "cheapest" requests for each subscription
+		// first. This allows us to break out of the processing loop as early as possible
+		// in the event that a subscription is too underfunded to have it's
+		// requests processed.
+		slices.SortStableFunc(reqs, func(a, b pendingRequest) int {
+			return cmp.Compare(a.req.CallbackGasLimit(), b.req.CallbackGasLimit())
+		})
+	}
+}
+
+// sortByMaxFee orders the simulated fulfillments of a chunk of requests by the
+// maximum fee they pay, largest first, when requests are prioritized by
+// payment. The requests of a chunk are paid in the same currency, LINK or
+// native. Fulfillments whose simulation failed have no fee, and follow the
+// others in their order.
+func (lsn *listenerV2) sortByMaxFee(results []vrfPipelineResult) {
+	if lsn.job.VRFSpec.FulfillmentPriority != vrfcommon.FulfillmentPriorityPayment {
+		return
+	}
+	slices.SortStableFunc(results, func(a, b vrfPipelineResult) int {
+		switch {
+		case a.maxFee == nil && b.maxFee == nil:
+			return 0
+		case a.maxFee == nil:
+			return 1
+		case b.maxFee == nil:
+			return -1
+		}
+		return b.maxFee.Cmp(a.maxFee)
+	})
+}
+
+// skipUnaffordable returns true if a request that the subscription cannot
+// afford is skipped, rather than ending the processing of the subscription.
+// Requests ordered by gas limit are processed cheapest first, so once one is
+// unaffordable, all following ones are too; with other priorities a
+// following request may still be cheaper.
+func (lsn *listenerV2) skipUnaffordable() bool {
+	switch lsn.job.VRFSpec.FulfillmentPriority {
+	case vrfcommon.FulfillmentPriorityAge, vrfcommon.FulfillmentPriorityPayment:
+		return true
+	default:
+		return false
+	}
+}
+
+// orderSubscriptions returns the IDs of the subscriptions of confirmed in the
+// order in which they are processed: the priority subscriptions in the given
+// order, then all others by the age of their oldest request.
+func orderSubscriptions(confirmed map[string][]pendingRequest, prioritySubIDs []string) []string {
+	var ordered, others []string
+	for _, subID := range prioritySubIDs {
+		if _, ok := confirmed[subID]; ok && !slices.Contains(ordered, subID) {
+			ordered = append(ordered, subID)
+		}
+	}
+	oldest := make(map[string]uint64, len(confirmed))
+	for subID, reqs := range confirmed {
+		if slices.Contains(ordered, subID) {
+			continue
+		}
+		others = append(others, subID)
+		for i, req := range reqs {
+			if i == 0 || req.req.Raw().BlockNumber < oldest[subID] {
+				oldest[subID] = req.req.Raw().BlockNumber
+			}
+		}
+	}
+	slices.SortFunc(others, func(a, b string) int {
+		if c := cmp.Compare(oldest[a], oldest[b]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	return append(ordered, others...)
+}
+
+// requestQueues returns the request queue of each subscription with pending
+// requests. The requests of each subscription in confirmed must be sorted in
+// processing order.
+func (lsn *listenerV2) requestQueues(latestHead uint64, pendingRequests []pendingRequest, confirmed map[string][]pendingRequest) map[string][]QueuedRequest {
+	positions := make(map[string]int)
+	for _, reqs := range confirmed {
+		position := 0
+		for _, req := range reqs {
+			if lsn.inflightCache.Contains(req.req.Raw()) {
+				continue
+			}
+			position++
+			positions[req.req.RequestID().String()] = position
+		}
+	}
+
+	queues := make(map[string][]QueuedRequest)
+	seen := make(map[string]struct{})
+	for _, req := range pendingRequests {
+		reqID := req.req.RequestID().String()
+		if _, ok := seen[reqID]; ok {
+			continue
+		}
+		seen[reqID] = struct{}{}
+
+		q := QueuedRequest{
+			RequestID:        reqID,
+			Attempts:         req.attempts,
+			ConfirmedAtBlock: req.confirmedAtBlock,
+		}
+		switch {
+		case req.confirmedAtBlock > latestHead:
+			q.Status = requestStatusUnconfirmed
+		case lsn.inflightCache.Contains(req.req.Raw()):
+			q.Status = requestStatusInflight
+		case positions[reqID] == 0:
+			q.Status = requestStatusBackoff
+			q.NextTry = nextTry(req.attempts, lsn.job.VRFSpec.BackoffInitialDelay, lsn.job.VRFSpec.BackoffMaxDelay, req.lastTry)
+		default:
+			q.Status = requestStatusReady
+			q.Position = positions[reqID]
+		}
+		subID := req.req.SubID().String()
+		queues[subID] = append(queues[subID], q)
+	}
+	return queues
+}
+
+// reportRequestQueues logs the request queue of each subscription, keeps them
+// for RequestQueues, and updates the queue size metrics. Only the priority
+// subscriptions are labelled by their ID in the metrics, the sizes of all other
+// subscriptions are summed up under "other".
+func (lsn *listenerV2) reportRequestQueues(queues map[string][]QueuedRequest) {
+	sizes := make(map[string]map[string]int)
+	for subID, queue := range queues {
+		subSizes := make(map[string]int)
+		for _, q := range queue {
+			subSizes[q.Status]++
+		}
+		lsn.l.Debugw("Request queue of subscription",
+			"subID", subID,
+			"fulfillmentPriority", lsn.job.VRFSpec.FulfillmentPriority,
+			"sizes", subSizes,
+			"queue", queue)
+
+		label := "other"
+		if slices.Contains(lsn.job.VRFSpec.PrioritySubscriptionIDs, subID) {
+			label = subID
+		}
+		if sizes[label] == nil {
+			sizes[label] = make(map[string]int)
+		}
+		for status, size := range subSizes {
+			sizes[label][status] += size
+		}
+	}
+	vrfcommon.UpdateQueueSizeBySubscription(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), sizes)
+
+	lsn.lastQueuesMu.Lock()
+	defer lsn.lastQueuesMu.Unlock()
+	lsn.lastQueues = queues
+}
+
+// RequestQueues returns the request queue of each subscription with pending
+// requests, as of the last processing round.
+func (lsn *listenerV2) RequestQueues() map[string][]QueuedRequest {
+	lsn.lastQueuesMu.RLock()
+	defer lsn.lastQueuesMu.RUnlock()
+	queues := make(map[string][]QueuedRequest, len(lsn.lastQueues))
+	for subID, queue := range lsn.lastQueues {
+		queues[subID] = slices.Clone(queue)
+	}
+	return queues
+}
@@ -0,0 +1,162 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+func newScheduledRequest(reqID int64, subID uint64, blockNumber uint64, logIndex uint, callbackGasLimit uint32) pendingRequest {
+	return pendingRequest{
+		confirmedAtBlock: blockNumber + 3,
+		req: NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId:        big.NewInt(reqID),
+			SubId:            subID,
+			CallbackGasLimit: callbackGasLimit,
+			Raw: types.Log{
+				BlockNumber: blockNumber,
+				Index:       logIndex,
+				TxHash:      common.BigToHash(big.NewInt(reqID)),
+			},
+		}),
+	}
+}
+
+func requestIDs(reqs []pendingRequest) (ids []int64) {
+	for _, req := range reqs {
+		ids = append(ids, req.req.RequestID().Int64())
+	}
+	return
+}
+
+func TestListener_SortRequests(t *testing.T) {
+	reqs := func() []pendingRequest {
+		return []pendingRequest{
+			newScheduledRequest(1, 1, 12, 0, 200_000),
+			newScheduledRequest(2, 1, 10, 1, 500_000),
+			newScheduledRequest(3, 1, 10, 0, 100_000),
+			newScheduledRequest(4, 1, 11, 0, 200_000),
+		}
+	}
+
+	var tests = []struct {
+		name     string
+		priority string
+		expected []int64
+	}{
+		{"default", "", []int64{3, 1, 4, 2}},
+		{"gas limit", vrfcommon.FulfillmentPriorityGasLimit, []int64{3, 1, 4, 2}},
+		{"age", vrfcommon.FulfillmentPriorityAge, []int64{3, 2, 4, 1}},
+		{"payment", vrfcommon.FulfillmentPriorityPayment, []int64{2, 1, 4, 3}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := reqs()
+			sortRequests(r, tc.priority)
+			assert.Equal(t, tc.expected, requestIDs(r))
+		})
+	}
+}
+
+func TestListener_SortByMaxFee(t *testing.T) {
+	results := func() []vrfPipelineResult {
+		return []vrfPipelineResult{
+			{req: newScheduledRequest(1, 1, 10, 0, 500_000), maxFee: big.NewInt(100)},
+			{req: newScheduledRequest(2, 1, 10, 1, 100_000), err: errPossiblyInsufficientFunds{}},
+			{req: newScheduledRequest(3, 1, 10, 2, 200_000), maxFee: big.NewInt(300)},
+			{req: newScheduledRequest(4, 1, 10, 3, 200_000), maxFee: big.NewInt(200)},
+		}
+	}
+	resultIDs := func(results []vrfPipelineResult) (ids []int64) {
+		for _, res := range results {
+			ids = append(ids, res.req.req.RequestID().Int64())
+		}
+		return
+	}
+
+	for _, tc := range []struct {
+		name     string
+		priority string
+		expected []int64
+	}{
+		{"payment", vrfcommon.FulfillmentPriorityPayment, []int64{3, 4, 1, 2}},
+		{"other priorities keep the order", vrfcommon.FulfillmentPriorityAge, []int64{1, 2, 3, 4}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lsn := &listenerV2{job: job.Job{VRFSpec: &job.VRFSpec{FulfillmentPriority: tc.priority}}}
+			r := results()
+			lsn.sortByMaxFee(r)
+			assert.Equal(t, tc.expected, resultIDs(r))
+		})
+	}
+}
+
+func TestListener_OrderSubscriptions(t *testing.T) {
+	confirmed := map[string][]pendingRequest{
+		"1": {newScheduledRequest(1, 1, 20, 0, 100_000)},
+		"2": {newScheduledRequest(2, 2, 30, 0, 100_000), newScheduledRequest(3, 2, 5, 0, 100_000)},
+		"3": {newScheduledRequest(4, 3, 10, 0, 100_000)},
+		"4": {newScheduledRequest(5, 4, 10, 0, 100_000)},
+	}
+
+	t.Run("by age of oldest request", func(t *testing.T) {
+		assert.Equal(t, []string{"2", "3", "4", "1"}, orderSubscriptions(confirmed, nil))
+	})
+	t.Run("priority subscriptions first", func(t *testing.T) {
+		assert.Equal(t, []string{"4", "1", "2", "3"}, orderSubscriptions(confirmed, []string{"4", "5", "1", "4"}))
+	})
+}
+
+func TestListener_RequestQueues(t *testing.T) {
+	j, err := vrfcommon.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
+		BackoffInitialDelay: time.Minute,
+		BackoffMaxDelay:     time.Hour,
+	}).Toml())
+	require.NoError(t, err)
+	lsn := &listenerV2{
+		job:           j,
+		inflightCache: vrfcommon.NewInflightCache(10),
+	}
+
+	var (
+		latestHead  uint64 = 100
+		lastTry            = time.Now()
+		ready              = newScheduledRequest(1, 1, 90, 0, 200_000)
+		readyFirst         = newScheduledRequest(2, 1, 90, 1, 100_000)
+		unconfirmed        = newScheduledRequest(3, 1, 99, 0, 100_000)
+		backoff            = newScheduledRequest(4, 2, 90, 2, 100_000)
+		inflight           = newScheduledRequest(5, 2, 90, 3, 100_000)
+	)
+	backoff.attempts, backoff.lastTry = 1, lastTry
+	lsn.inflightCache.Add(inflight.req.Raw())
+
+	pending := []pendingRequest{ready, readyFirst, unconfirmed, backoff, inflight, ready}
+	confirmed := map[string][]pendingRequest{
+		"1": {ready, readyFirst},
+		"2": {inflight},
+	}
+	sortRequests(confirmed["1"], j.VRFSpec.FulfillmentPriority)
+
+	queues := lsn.requestQueues(latestHead, pending, confirmed)
+	assert.Equal(t, map[string][]QueuedRequest{
+		"1": {
+			{RequestID: "1", Status: requestStatusReady, Position: 2, ConfirmedAtBlock: 93},
+			{RequestID: "2", Status: requestStatusReady, Position: 1, ConfirmedAtBlock: 93},
+			{RequestID: "3", Status: requestStatusUnconfirmed, ConfirmedAtBlock: 102},
+		},
+		"2": {
+			{RequestID: "4", Status: requestStatusBackoff, Attempts: 1, ConfirmedAtBlock: 93, NextTry: lastTry.Add(time.Minute)},
+			{RequestID: "5", Status: requestStatusInflight, ConfirmedAtBlock: 93},
+		},
+	}, queues)
+}
@@ -35,6 +35,11 @@ var (
 		Help: "The number of VRF requests currently in the in-memory queue.",
 	}, []string{"job_name", "external_job_id", "vrf_version"})
 
+	MetricQueueSizeBySubscription = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_request_queue_size_by_subscription",
+		Help: "The number of VRF requests currently in the in-memory queue per priority subscription (other subscriptions are summed up as \"other\"), by the reason they are pending.",
+	}, []string{"job_name", "external_job_id", "vrf_version", "sub_id", "status"})
+
 	MetricProcessedReqs = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "vrf_processed_request_count",
 		Help: "The number of VRF requests processed.",
@@ -80,6 +85,20 @@ func UpdateQueueSize(jobName string, extJobID uuid.UUID, vrfVersion Version, siz
 		Set(float64(size))
 }
 
+// UpdateQueueSizeBySubscription sets the queue sizes of a job, given by subscription label and status.
+// Callers must keep the number of subscription labels bounded. The sizes of labels that are no longer
+// given are removed.
+func UpdateQueueSizeBySubscription(jobName string, extJobID uuid.UUID, vrfVersion Version, sizes map[string]map[string]int) {
+	MetricQueueSizeBySubscription.DeletePartialMatch(prometheus.Labels{
+		"job_name": jobName, "external_job_id": extJobID.String(), "vrf_version": string(vrfVersion)})
+	for subID, statuses := range sizes {
+		for status, size := range statuses {
+			MetricQueueSizeBySubscription.WithLabelValues(jobName, extJobID.String(), string(vrfVersion), subID, status).
+				Set(float64(size))
+		}
+	}
+}
+
 func IncProcessedReqs(jobName string, extJobID uuid.UUID, vrfVersion Version) {
 	MetricProcessedReqs.WithLabelValues(jobName, extJobID.String(), string(vrfVersion)).Inc()
 }
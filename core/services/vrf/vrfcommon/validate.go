@@ -3,6 +3,7 @@ package vrfcommon
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,13 @@ var (
 	ErrKeyNotSet = errors.New("key not set")
 )
 
+// Fulfillment priorities of VRF V2 jobs, see job.VRFSpec.FulfillmentPriority.
+const (
+	FulfillmentPriorityGasLimit = "gasLimit"
+	FulfillmentPriorityAge      = "age"
+	FulfillmentPriorityPayment  = "payment"
+)
+
 func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	var jb = job.Job{
 		ExternalJobID: uuid.New(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
@@ -79,6 +87,24 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 		return jb, fmt.Errorf("gasLanePrice must be positive, given: %s", spec.GasLanePrice.String())
 	}
 
+	switch spec.FulfillmentPriority {
+	case "":
+		spec.FulfillmentPriority = FulfillmentPriorityGasLimit
+	case FulfillmentPriorityGasLimit, FulfillmentPriorityAge, FulfillmentPriorityPayment:
+	default:
+		return jb, fmt.Errorf("fulfillmentPriority must be one of %q, %q or %q, given: %q",
+			FulfillmentPriorityGasLimit, FulfillmentPriorityAge, FulfillmentPriorityPayment, spec.FulfillmentPriority)
+	}
+
+	for i, subID := range spec.PrioritySubscriptionIDs {
+		id, ok := new(big.Int).SetString(subID, 10)
+		if !ok || id.Sign() < 0 {
+			return jb, fmt.Errorf("prioritySubscriptionIDs must be decimal subscription IDs, given: %q", subID)
+		}
+		// subscriptions are matched by their canonical decimal representation
+		spec.PrioritySubscriptionIDs[i] = id.String()
+	}
+
 	var foundVRFTask bool
 	for _, t := range jb.Pipeline.Tasks {
 		if t.Type() == pipeline.TaskTypeVRF || t.Type() == pipeline.TaskTypeVRFV2 || t.Type() == pipeline.TaskTypeVRFV2Plus {
@@ -1,7 +1,9 @@
 package webhook_test
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
@@ -97,4 +99,34 @@ func Test_Authorizer(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, can)
 	})
+	t.Run("signed request authorizes with the signing secret of the job", func(t *testing.T) {
+		const secret = "0123456789abcdef0123456789abcdef"
+		keyStore := cltest.NewKeyStore(t, db, pgtest.NewQConfig(true))
+		encrypted, err := keyStore.EncryptSecret([]byte(secret))
+		require.NoError(t, err)
+		jobWithSecret, webhookSpecWithSecret := cltest.MustInsertWebhookSpec(t, db)
+		_, err = db.Exec(`UPDATE webhook_specs SET encrypted_signing_secret = $1 WHERE id = $2`, encrypted, webhookSpecWithSecret.ID)
+		require.NoError(t, err)
+
+		body := []byte(`{"foo":"bar"}`)
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		a := webhook.NewSignedRequestAuthorizer(db.DB, webhook.SignedRequest{
+			Timestamp: timestamp,
+			Signature: webhook.Signature(secret, timestamp, body),
+			Body:      body,
+		}, nil, webhook.NewSigningSecrets(keyStore), webhook.NewSignatureReplays())
+
+		can, err := a.CanRun(testutils.Context(t), eiDisabledCfg{}, jobWithSecret.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+		can, err = a.CanRun(testutils.Context(t), eiDisabledCfg{}, jobWithSecret.ExternalJobID)
+		require.NoError(t, err)
+		assert.False(t, can, "replayed")
+		can, err = a.CanRun(testutils.Context(t), eiDisabledCfg{}, jobWithNoEI.ExternalJobID)
+		require.NoError(t, err)
+		assert.False(t, can)
+		can, err = a.CanRun(testutils.Context(t), eiDisabledCfg{}, uuid.New())
+		require.NoError(t, err)
+		assert.False(t, can)
+	})
 }
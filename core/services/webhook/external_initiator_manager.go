@@ -28,6 +28,9 @@ type ExternalInitiatorManager interface {
 	Notify(ctx context.Context, webhookSpecID int32) error
 	DeleteJob(ctx context.Context, webhookSpecID int32) error
 	FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error)
+	// Load returns the external initiator specs of a webhook spec, with their
+	// external initiators, and the external job ID of its job.
+	Load(webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error)
 }
 
 //go:generate mockery --quiet --name HTTPClient --output ./mocks/ --case=underscore
@@ -214,3 +217,6 @@ func (NullExternalInitiatorManager) DeleteJob(context.Context, int32) error { re
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
+func (NullExternalInitiatorManager) Load(int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error) {
+	return nil, uuid.Nil, nil
+}
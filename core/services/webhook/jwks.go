@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultJWKSTTL is how long a fetched key set is used before it is
+	// fetched again.
+	defaultJWKSTTL = 15 * time.Minute
+	// minJWKSRefreshInterval limits refetching a key set when a token refers
+	// to an unknown key, e.g. after the issuer rotated its keys.
+	minJWKSRefreshInterval = time.Minute
+	maxJWKSResponseSize    = 1 << 20
+)
+
+// JWKSCache fetches and caches JSON Web Key Sets by URL.
+type JWKSCache struct {
+	client *http.Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu   sync.Mutex
+	sets map[string]*jwks
+}
+
+type jwks struct {
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewJWKSCache returns a JWKSCache which fetches key sets with client.
+func NewJWKSCache(client *http.Client) *JWKSCache {
+	return &JWKSCache{
+		client: client,
+		ttl:    defaultJWKSTTL,
+		now:    time.Now,
+		sets:   make(map[string]*jwks),
+	}
+}
+
+// PublicKey returns the key identified by kid in the key set published at
+// url.
+func (c *JWKSCache) PublicKey(ctx context.Context, url string, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	set, cached := c.sets[url]
+	if cached && c.now().Sub(set.fetchedAt) < c.ttl {
+		if key, ok := set.keys[kid]; ok {
+			return key, nil
+		}
+		if c.now().Sub(set.fetchedAt) < minJWKSRefreshInterval {
+			return nil, errors.Wrapf(errUnknownKeyID, "%q", kid)
+		}
+	}
+
+	keys, err := c.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	c.sets[url] = &jwks{keys: keys, fetchedAt: c.now()}
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, errors.Wrapf(errUnknownKeyID, "%q", kid)
+	}
+	return key, nil
+}
+
+func (c *JWKSCache) fetch(ctx context.Context, url string) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create JWKS request")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch JWKS")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch JWKS: unexpected status %s", resp.Status)
+	}
+
+	var body struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxJWKSResponseSize)).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "failed to decode JWKS")
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(body.Keys))
+	for _, jwk := range body.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip keys of unsupported types rather than rejecting the set.
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URLInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid modulus")
+		}
+		e, err := decodeBase64URLInt(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exponent")
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBase64URLInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid x coordinate")
+		}
+		y, err := decodeBase64URLInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBase64URLInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -7,7 +7,11 @@ import (
 
 	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
 
+	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
+
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -61,6 +65,45 @@ func (_m *ExternalInitiatorManager) FindExternalInitiatorByName(name string) (br
 	return r0, r1
 }
 
+// Load provides a mock function with given fields: webhookSpecID
+func (_m *ExternalInitiatorManager) Load(webhookSpecID int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error) {
+	ret := _m.Called(webhookSpecID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 []job.ExternalInitiatorWebhookSpec
+	var r1 uuid.UUID
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error)); ok {
+		return rf(webhookSpecID)
+	}
+	if rf, ok := ret.Get(0).(func(int32) []job.ExternalInitiatorWebhookSpec); ok {
+		r0 = rf(webhookSpecID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.ExternalInitiatorWebhookSpec)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) uuid.UUID); ok {
+		r1 = rf(webhookSpecID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(int32) error); ok {
+		r2 = rf(webhookSpecID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Notify provides a mock function with given fields: ctx, webhookSpecID
 func (_m *ExternalInitiatorManager) Notify(ctx context.Context, webhookSpecID int32) error {
 	ret := _m.Called(ctx, webhookSpecID)
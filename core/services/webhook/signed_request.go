@@ -0,0 +1,269 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 signature of a
+	// signed webhook request, see Signature.
+	SignatureHeader = "X-Chainlink-Webhook-Signature"
+	// TimestampHeader carries the unix time in seconds at which a webhook
+	// request was signed.
+	TimestampHeader = "X-Chainlink-Webhook-Timestamp"
+	// DefaultSignatureMaxAge is used when a webhook job does not set
+	// signatureMaxAge.
+	DefaultSignatureMaxAge = 5 * time.Minute
+
+	signatureReplaysPruneInterval = time.Minute
+)
+
+var (
+	jwtSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+	errUnknownKeyID   = errors.New("unknown key ID")
+)
+
+// Signature returns the signature of a webhook request body signed at
+// timestamp, which is the hex encoded HMAC-SHA256 of "<timestamp>.<body>"
+// keyed with the job's signingSecret.
+func Signature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignedRequest holds the credentials of a webhook request which is
+// authenticated by the job itself rather than by a node user or an external
+// initiator.
+type SignedRequest struct {
+	Timestamp   string
+	Signature   string
+	BearerToken string
+	Body        []byte
+}
+
+// Verify reports whether the request carries a valid signature or token for
+// the webhook spec. An error is only returned if the request could not be
+// verified, e.g. because the key set of the spec could not be fetched.
+func (r SignedRequest) Verify(ctx context.Context, spec job.WebhookSpec, keys *JWKSCache) (bool, error) {
+	if r.isSigned(spec) {
+		return r.verifySignature(spec, time.Now()), nil
+	}
+	if r.BearerToken != "" && spec.JWKSURL.Valid {
+		return r.verifyToken(ctx, spec, keys)
+	}
+	return false, nil
+}
+
+// isSigned reports whether the request is verified by its signature, rather
+// than by a token.
+func (r SignedRequest) isSigned(spec job.WebhookSpec) bool {
+	return r.Signature != "" && spec.SigningSecret.Valid
+}
+
+// expiresAt returns the time after which the timestamp of the request is
+// outside the max age of the spec.
+func (r SignedRequest) expiresAt(spec job.WebhookSpec) (time.Time, error) {
+	unix, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(unix, 0).Add(signatureMaxAge(spec)), nil
+}
+
+func signatureMaxAge(spec job.WebhookSpec) time.Duration {
+	if maxAge := spec.SignatureMaxAge.Duration(); maxAge != 0 {
+		return maxAge
+	}
+	return DefaultSignatureMaxAge
+}
+
+func (r SignedRequest) verifySignature(spec job.WebhookSpec, now time.Time) bool {
+	unix, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	maxAge := signatureMaxAge(spec)
+	// Timestamps in the future are rejected alike, to tolerate clock skew
+	// without accepting requests signed arbitrarily far ahead.
+	age := now.Sub(time.Unix(unix, 0))
+	if age > maxAge || age < -maxAge {
+		return false
+	}
+	expected := Signature(spec.SigningSecret.String, r.Timestamp, r.Body)
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(r.Signature)))
+}
+
+func (r SignedRequest) verifyToken(ctx context.Context, spec job.WebhookSpec, keys *JWKSCache) (bool, error) {
+	opts := []jwt.ParserOption{jwt.WithValidMethods(jwtSigningMethods), jwt.WithExpirationRequired()}
+	if spec.JWTIssuer.Valid {
+		opts = append(opts, jwt.WithIssuer(spec.JWTIssuer.String))
+	}
+	if spec.JWTAudience.Valid {
+		opts = append(opts, jwt.WithAudience(spec.JWTAudience.String))
+	}
+
+	var fetchErr error
+	_, err := jwt.Parse(r.BearerToken, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := keys.PublicKey(ctx, spec.JWKSURL.String, kid)
+		if err != nil && !errors.Is(err, errUnknownKeyID) {
+			fetchErr = err
+		}
+		return key, err
+	}, opts...)
+	if fetchErr != nil {
+		return false, fetchErr
+	}
+	return err == nil, nil
+}
+
+// SecretsCipher decrypts the signing secrets of webhook specs, it is
+// implemented by the keystore.
+type SecretsCipher interface {
+	DecryptSecret(ciphertext []byte) ([]byte, error)
+}
+
+type signingSecret struct {
+	encrypted []byte
+	secret    string
+}
+
+// SigningSecrets decrypts the signing secrets of webhook jobs. Secrets are
+// cached by job while they don't change, as decrypting is expensive.
+type SigningSecrets struct {
+	cipher SecretsCipher
+
+	mu      sync.Mutex
+	secrets map[uuid.UUID]signingSecret
+}
+
+func NewSigningSecrets(cipher SecretsCipher) *SigningSecrets {
+	return &SigningSecrets{cipher: cipher, secrets: make(map[uuid.UUID]signingSecret)}
+}
+
+// Decrypt returns the signing secret of a job from its encrypted value.
+func (s *SigningSecrets) Decrypt(jobUUID uuid.UUID, encrypted []byte) (string, error) {
+	// The lock is held while decrypting, so that concurrent requests for a
+	// job which is not cached yet decrypt its secret only once.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cached, ok := s.secrets[jobUUID]; ok && bytes.Equal(cached.encrypted, encrypted) {
+		return cached.secret, nil
+	}
+	secret, err := s.cipher.DecryptSecret(encrypted)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt signing secret of job %s", jobUUID)
+	}
+	s.secrets[jobUUID] = signingSecret{encrypted: encrypted, secret: string(secret)}
+	return string(secret), nil
+}
+
+type signatureKey struct {
+	jobUUID   uuid.UUID
+	signature string
+}
+
+// SignatureReplays records the signatures of the signed requests accepted for
+// webhook jobs until their timestamps are outside the max age of their jobs,
+// so that a signed request can't be replayed. Signatures are recorded in
+// memory, and are not shared between nodes.
+type SignatureReplays struct {
+	mu        sync.Mutex
+	expiries  map[signatureKey]time.Time
+	nextPrune time.Time
+}
+
+func NewSignatureReplays() *SignatureReplays {
+	return &SignatureReplays{expiries: make(map[signatureKey]time.Time)}
+}
+
+// Record records a signature of a request to a job until expiresAt. It returns
+// false if the signature is already recorded.
+func (s *SignatureReplays) Record(jobUUID uuid.UUID, signature string, expiresAt, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.After(s.nextPrune) {
+		for key, expiry := range s.expiries {
+			if !expiry.After(now) {
+				delete(s.expiries, key)
+			}
+		}
+		s.nextPrune = now.Add(signatureReplaysPruneInterval)
+	}
+
+	// Signatures are compared case-insensitively, so they are recorded alike.
+	key := signatureKey{jobUUID, strings.ToLower(signature)}
+	if expiry, ok := s.expiries[key]; ok && expiry.After(now) {
+		return false
+	}
+	s.expiries[key] = expiresAt
+	return true
+}
+
+type signedRequestAuthorizer struct {
+	db      *sql.DB
+	req     SignedRequest
+	keys    *JWKSCache
+	secrets *SigningSecrets
+	replays *SignatureReplays
+}
+
+var _ Authorizer = &signedRequestAuthorizer{}
+
+// NewSignedRequestAuthorizer returns an Authorizer which allows a run of a
+// webhook job if req is signed with the signingSecret of the job, or carries
+// a JWT issued by the jwksURL of the job. A signed request is only allowed
+// once, replays are recorded in replays.
+func NewSignedRequestAuthorizer(db *sql.DB, req SignedRequest, keys *JWKSCache, secrets *SigningSecrets, replays *SignatureReplays) Authorizer {
+	return &signedRequestAuthorizer{db, req, keys, secrets, replays}
+}
+
+func (sa *signedRequestAuthorizer) CanRun(ctx context.Context, _ AuthorizerConfig, jobUUID uuid.UUID) (bool, error) {
+	var spec job.WebhookSpec
+	row := sa.db.QueryRowContext(ctx, `
+SELECT webhook_specs.encrypted_signing_secret, webhook_specs.signature_max_age, webhook_specs.jwks_url, webhook_specs.jwt_issuer, webhook_specs.jwt_audience
+FROM webhook_specs
+JOIN jobs ON jobs.webhook_spec_id = webhook_specs.id
+WHERE jobs.external_job_id = $1`, jobUUID)
+	err := row.Scan(&spec.EncryptedSigningSecret, &spec.SignatureMaxAge, &spec.JWKSURL, &spec.JWTIssuer, &spec.JWTAudience)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if sa.req.Signature != "" && len(spec.EncryptedSigningSecret) > 0 {
+		secret, err := sa.secrets.Decrypt(jobUUID, spec.EncryptedSigningSecret)
+		if err != nil {
+			return false, err
+		}
+		spec.SigningSecret = null.StringFrom(secret)
+	}
+	ok, err := sa.req.Verify(ctx, spec, sa.keys)
+	if err != nil || !ok || !sa.req.isSigned(spec) {
+		return ok, err
+	}
+	expiresAt, err := sa.req.expiresAt(spec)
+	if err != nil {
+		return false, nil
+	}
+	return sa.replays.Record(jobUUID, sa.req.Signature, expiresAt, time.Now()), nil
+}
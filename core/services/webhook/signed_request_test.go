@@ -0,0 +1,183 @@
+package webhook_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestSignedRequest_Verify_Signature(t *testing.T) {
+	t.Parallel()
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	body := []byte(`{"foo":"bar"}`)
+	spec := job.WebhookSpec{SigningSecret: null.StringFrom(secret)}
+	signedAt := func(ts time.Time) webhook.SignedRequest {
+		timestamp := strconv.FormatInt(ts.Unix(), 10)
+		return webhook.SignedRequest{Timestamp: timestamp, Signature: webhook.Signature(secret, timestamp, body), Body: body}
+	}
+
+	tests := []struct {
+		name string
+		spec job.WebhookSpec
+		req  webhook.SignedRequest
+		ok   bool
+	}{
+		{"valid", spec, signedAt(time.Now()), true},
+		{"no signing secret", job.WebhookSpec{}, signedAt(time.Now()), false},
+		{"older than the default max age", spec, signedAt(time.Now().Add(-webhook.DefaultSignatureMaxAge - time.Minute)), false},
+		{"too far in the future", spec, signedAt(time.Now().Add(webhook.DefaultSignatureMaxAge + time.Minute)), false},
+		{"within a custom max age", job.WebhookSpec{SigningSecret: null.StringFrom(secret), SignatureMaxAge: models.Interval(time.Hour)}, signedAt(time.Now().Add(-30 * time.Minute)), true},
+		{"outside a custom max age", job.WebhookSpec{SigningSecret: null.StringFrom(secret), SignatureMaxAge: models.Interval(time.Second)}, signedAt(time.Now().Add(-time.Minute)), false},
+		{"tampered body", spec, func() webhook.SignedRequest {
+			r := signedAt(time.Now())
+			r.Body = []byte(`{"foo":"baz"}`)
+			return r
+		}(), false},
+		{"tampered timestamp", spec, func() webhook.SignedRequest {
+			r := signedAt(time.Now())
+			r.Timestamp = strconv.FormatInt(time.Now().Unix()+1, 10)
+			return r
+		}(), false},
+		{"malformed timestamp", spec, webhook.SignedRequest{Timestamp: "yesterday", Signature: webhook.Signature(secret, "yesterday", body), Body: body}, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ok, err := test.req.Verify(testutils.Context(t), test.spec, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func TestSignatureReplays(t *testing.T) {
+	t.Parallel()
+
+	replays := webhook.NewSignatureReplays()
+	jobUUID := uuid.New()
+	now := time.Now()
+
+	assert.True(t, replays.Record(jobUUID, "abcdef", now.Add(time.Minute), now))
+	assert.False(t, replays.Record(jobUUID, "abcdef", now.Add(time.Minute), now.Add(time.Second)))
+	assert.False(t, replays.Record(jobUUID, "ABCDEF", now.Add(time.Minute), now.Add(time.Second)), "signatures are case-insensitive")
+	assert.True(t, replays.Record(uuid.New(), "abcdef", now.Add(time.Minute), now), "signatures are recorded per job")
+	assert.True(t, replays.Record(jobUUID, "012345", now.Add(time.Minute), now))
+
+	// Expired signatures are accepted again, though their timestamps are then
+	// rejected by SignedRequest.Verify.
+	assert.True(t, replays.Record(jobUUID, "abcdef", now.Add(3*time.Minute), now.Add(2*time.Minute)))
+}
+
+func TestSignedRequest_Verify_JWT(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	var fetches atomic.Int32
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+				{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())},
+				{"kty": "oct", "kid": "hmac", "k": b64([]byte("secret"))},
+			},
+		})
+		require.NoError(t, err)
+	}))
+	t.Cleanup(jwksServer.Close)
+
+	spec := job.WebhookSpec{
+		JWKSURL:     null.StringFrom(jwksServer.URL),
+		JWTIssuer:   null.StringFrom("issuer"),
+		JWTAudience: null.StringFrom("chainlink"),
+	}
+	claims := func(mod func(jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{"iss": "issuer", "aud": "chainlink", "exp": time.Now().Add(time.Minute).Unix()}
+		if mod != nil {
+			mod(c)
+		}
+		return c
+	}
+	sign := func(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		s, err := token.SignedString(key)
+		require.NoError(t, err)
+		return s
+	}
+
+	keys := webhook.NewJWKSCache(jwksServer.Client())
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"RSA", sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(nil)), true},
+		{"EC", sign(jwt.SigningMethodES256, "ec", ecKey, claims(nil)), true},
+		{"wrong key", sign(jwt.SigningMethodRS256, "rsa", otherKey, claims(nil)), false},
+		{"unknown key ID", sign(jwt.SigningMethodRS256, "other", otherKey, claims(nil)), false},
+		{"symmetric algorithm", sign(jwt.SigningMethodHS256, "hmac", []byte("secret"), claims(nil)), false},
+		{"expired", sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() })), false},
+		{"no expiry", sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { delete(c, "exp") })), false},
+		{"wrong issuer", sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["iss"] = "someone" })), false},
+		{"wrong audience", sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["aud"] = "someone" })), false},
+		{"malformed", "not a token", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ok, err := webhook.SignedRequest{BearerToken: test.token}.Verify(testutils.Context(t), spec, keys)
+			require.NoError(t, err)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+	// The key set is cached, and not refetched for unknown key IDs right away.
+	assert.Equal(t, int32(1), fetches.Load())
+
+	t.Run("without JWKS URL", func(t *testing.T) {
+		ok, err := webhook.SignedRequest{BearerToken: tests[0].token}.Verify(testutils.Context(t), job.WebhookSpec{}, keys)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("JWKS unavailable", func(t *testing.T) {
+		unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(unavailable.Close)
+
+		_, err := webhook.SignedRequest{BearerToken: tests[0].token}.Verify(testutils.Context(t), job.WebhookSpec{JWKSURL: null.StringFrom(unavailable.URL)}, keys)
+		require.Error(t, err)
+	})
+}
@@ -1,9 +1,12 @@
 package webhook
 
 import (
+	"net/url"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -16,8 +19,16 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	SigningSecret      null.String                        `toml:"signingSecret"`
+	SignatureMaxAge    models.Interval                    `toml:"signatureMaxAge"`
+	JWKSURL            null.String                        `toml:"jwksURL"`
+	JWTIssuer          null.String                        `toml:"jwtIssuer"`
+	JWTAudience        null.String                        `toml:"jwtAudience"`
 }
 
+// MinSigningSecretLength is the minimum length of a webhook job signingSecret.
+const MinSigningSecretLength = 32
+
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
 	var tree *toml.Tree
 	tree, err = toml.Load(tomlString)
@@ -47,19 +58,51 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 		}
 		eiWS := job.ExternalInitiatorWebhookSpec{
 			ExternalInitiatorID: ei.ID,
+			ExternalInitiator:   ei,
 			WebhookSpecID:       0, // It will be populated later, on save
 			Spec:                eiSpec.Spec,
 		}
 		externalInitiatorWebhookSpecs = append(externalInitiatorWebhookSpecs, eiWS)
 	}
 
+	err = multierr.Combine(err, validateSignedRequests(tomlSpec))
 	if err != nil {
 		return jb, err
 	}
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		SigningSecret:                 tomlSpec.SigningSecret,
+		SignatureMaxAge:               tomlSpec.SignatureMaxAge,
+		JWKSURL:                       tomlSpec.JWKSURL,
+		JWTIssuer:                     tomlSpec.JWTIssuer,
+		JWTAudience:                   tomlSpec.JWTAudience,
 	}
 
 	return jb, nil
 }
+
+func validateSignedRequests(spec TOMLWebhookSpec) (err error) {
+	if spec.SigningSecret.Valid {
+		if len(spec.SigningSecret.String) < MinSigningSecretLength {
+			err = multierr.Append(err, errors.Errorf("signingSecret must be at least %d characters long", MinSigningSecretLength))
+		}
+	} else if !spec.SignatureMaxAge.IsZero() {
+		err = multierr.Append(err, errors.New("signatureMaxAge requires signingSecret to be set"))
+	}
+	if spec.SignatureMaxAge.Duration() < 0 {
+		err = multierr.Append(err, errors.New("signatureMaxAge must not be negative"))
+	}
+
+	if spec.JWKSURL.Valid {
+		u, parseErr := url.Parse(spec.JWKSURL.String)
+		if parseErr != nil {
+			err = multierr.Append(err, errors.Wrap(parseErr, "invalid jwksURL"))
+		} else if u.Scheme != "https" && u.Scheme != "http" {
+			err = multierr.Append(err, errors.Errorf("jwksURL must be an http(s) URL, got scheme %q", u.Scheme))
+		}
+	} else if spec.JWTIssuer.Valid || spec.JWTAudience.Valid {
+		err = multierr.Append(err, errors.New("jwtIssuer and jwtAudience require jwksURL to be set"))
+	}
+	return err
+}
@@ -2,11 +2,13 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -142,6 +144,59 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with signed requests",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signingSecret   = "0123456789abcdef0123456789abcdef"
+            signatureMaxAge = "1m"
+            jwksURL         = "https://issuer.example/.well-known/jwks.json"
+            jwtAudience     = "chainlink"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, null.StringFrom("0123456789abcdef0123456789abcdef"), s.WebhookSpec.SigningSecret)
+				assert.Equal(t, time.Minute, s.WebhookSpec.SignatureMaxAge.Duration())
+				assert.Equal(t, null.StringFrom("https://issuer.example/.well-known/jwks.json"), s.WebhookSpec.JWKSURL)
+				assert.False(t, s.WebhookSpec.JWTIssuer.Valid)
+				assert.Equal(t, null.StringFrom("chainlink"), s.WebhookSpec.JWTAudience)
+			},
+		},
+		{
+			name: "with invalid signed requests",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signingSecret   = "too short"
+            jwksURL         = "ftp://issuer.example/jwks.json"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, `signingSecret must be at least 32 characters long; jwksURL must be an http(s) URL, got scheme "ftp"`)
+			},
+		},
+		{
+			name: "with signed request options but no secret or JWKS",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            signatureMaxAge = "1m"
+            jwtIssuer       = "issuer"
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "signatureMaxAge requires signingSecret to be set; jwtIssuer and jwtAudience require jwksURL to be set")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
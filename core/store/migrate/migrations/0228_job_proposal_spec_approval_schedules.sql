@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_proposal_spec_approval_schedules(
+    job_proposal_spec_id BIGINT PRIMARY KEY REFERENCES job_proposal_specs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    force BOOLEAN NOT NULL DEFAULT FALSE,
+    approve_at TIMESTAMPTZ,
+    approve_at_block BIGINT,
+    evm_chain_id NUMERIC(78,0),
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_approval_schedule CHECK (
+        (approve_at IS NOT NULL AND approve_at_block IS NULL AND evm_chain_id IS NULL) OR
+        (approve_at IS NULL AND approve_at_block IS NOT NULL AND evm_chain_id IS NOT NULL)
+    )
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_proposal_spec_approval_schedules;
+-- +goose StatementEnd
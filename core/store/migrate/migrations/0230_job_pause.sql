@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs ADD COLUMN paused_at TIMESTAMPTZ;
+ALTER TABLE jobs ADD COLUMN pause_reason TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs DROP COLUMN paused_at;
+ALTER TABLE jobs DROP COLUMN pause_reason;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE webhook_specs ADD COLUMN encrypted_signing_secret BYTEA;
+ALTER TABLE webhook_specs ADD COLUMN signature_max_age BIGINT;
+ALTER TABLE webhook_specs ADD COLUMN jwks_url TEXT;
+ALTER TABLE webhook_specs ADD COLUMN jwt_issuer TEXT;
+ALTER TABLE webhook_specs ADD COLUMN jwt_audience TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhook_specs DROP COLUMN encrypted_signing_secret;
+ALTER TABLE webhook_specs DROP COLUMN signature_max_age;
+ALTER TABLE webhook_specs DROP COLUMN jwks_url;
+ALTER TABLE webhook_specs DROP COLUMN jwt_issuer;
+ALTER TABLE webhook_specs DROP COLUMN jwt_audience;
+-- +goose StatementEnd
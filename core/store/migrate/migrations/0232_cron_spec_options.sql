@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cron_specs ADD COLUMN timezone TEXT NOT NULL DEFAULT '';
+ALTER TABLE cron_specs ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE cron_specs ADD COLUMN max_concurrent_runs BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE cron_specs ADD COLUMN catch_up_limit BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cron_specs DROP COLUMN timezone;
+ALTER TABLE cron_specs DROP COLUMN jitter;
+ALTER TABLE cron_specs DROP COLUMN max_concurrent_runs;
+ALTER TABLE cron_specs DROP COLUMN catch_up_limit;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN tls_client_cert TEXT NOT NULL DEFAULT '',
+    ADD COLUMN encrypted_tls_client_key BYTEA,
+    ADD COLUMN tls_root_ca TEXT NOT NULL DEFAULT '',
+    ADD COLUMN encrypted_signing_secret BYTEA,
+    ADD COLUMN health_check_path TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN tls_client_cert,
+    DROP COLUMN encrypted_tls_client_key,
+    DROP COLUMN tls_root_ca,
+    DROP COLUMN encrypted_signing_secret,
+    DROP COLUMN health_check_path;
+-- +goose StatementEnd
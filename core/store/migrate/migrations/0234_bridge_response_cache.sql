@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN response_cache_ttl BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN response_cache_max_stale BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN response_cache_ttl,
+    DROP COLUMN response_cache_max_stale;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs ADD COLUMN heartbeat_schedule TEXT NOT NULL DEFAULT '';
+ALTER TABLE flux_monitor_specs ADD COLUMN min_submission_interval BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs DROP COLUMN heartbeat_schedule;
+ALTER TABLE flux_monitor_specs DROP COLUMN min_submission_interval;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE llo_mercury_transmit_queue (
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    server_url TEXT NOT NULL,
+    payload_hash BYTEA NOT NULL,
+    payload BYTEA NOT NULL,
+    report_format INTEGER NOT NULL,
+    config_digest BYTEA NOT NULL,
+    config_seen_at TIMESTAMPTZ NOT NULL,
+    seq_nr BIGINT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (job_id, server_url, payload_hash)
+);
+
+CREATE INDEX idx_llo_mercury_transmit_queue_job_id_server_url_config_seen_at_seq_nr ON llo_mercury_transmit_queue (job_id, server_url, config_seen_at DESC, seq_nr DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE llo_mercury_transmit_queue;
+-- +goose StatementEnd
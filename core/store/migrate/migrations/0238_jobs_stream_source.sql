@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN stream_source JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN stream_source;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN fulfillment_priority TEXT NOT NULL DEFAULT 'gasLimit',
+    ADD COLUMN priority_subscription_ids TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN multicall_address BYTEA;
+
+-- +goose Down
+ALTER TABLE vrf_specs
+    DROP COLUMN fulfillment_priority,
+    DROP COLUMN priority_subscription_ids,
+    DROP COLUMN multicall_address;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX idx_upkeep_states_chainid_upkeepid_block ON evm.upkeep_states (evm_chain_id, upkeep_id, block_number DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_upkeep_states_chainid_upkeepid_block;
@@ -0,0 +1,25 @@
+-- +goose Up
+
+CREATE TABLE evm.log_upkeep_backfills (
+  id BIGSERIAL PRIMARY KEY,
+  evm_chain_id NUMERIC(20) NOT NULL,
+  address BYTEA NOT NULL, -- address of the registry of the upkeep
+  upkeep_id NUMERIC(78) NOT NULL, -- upkeep id is an evm word (uint256) which has a max size of precision 78
+  from_block BIGINT NOT NULL,
+  to_block BIGINT NOT NULL,
+  logs BIGINT NOT NULL DEFAULT 0,
+  error TEXT,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  processed_at TIMESTAMPTZ,
+  CONSTRAINT log_upkeep_backfills_block_range_chk CHECK (
+    from_block > 0 AND from_block <= to_block
+  )
+);
+
+CREATE INDEX idx_log_upkeep_backfills_chainid_address_pending ON evm.log_upkeep_backfills (evm_chain_id, address, id) WHERE processed_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS evm.idx_log_upkeep_backfills_chainid_address_pending;
+
+DROP TABLE evm.log_upkeep_backfills;
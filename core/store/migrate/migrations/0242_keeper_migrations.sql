@@ -0,0 +1,17 @@
+-- +goose Up
+
+CREATE TABLE keeper_migrations (
+  keeper_job_id INT PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+  ocr2_job_id INT NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+  created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  confirmed_at TIMESTAMPTZ,
+  CONSTRAINT keeper_migrations_jobs_chk CHECK (keeper_job_id <> ocr2_job_id)
+);
+
+CREATE UNIQUE INDEX idx_keeper_migrations_ocr2_job_id ON keeper_migrations (ocr2_job_id);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_keeper_migrations_ocr2_job_id;
+
+DROP TABLE keeper_migrations;
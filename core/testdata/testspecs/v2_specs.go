@@ -249,6 +249,9 @@ type VRFSpecParams struct {
 	BackoffMaxDelay               time.Duration
 	GasLanePrice                  *assets.Wei
 	PollPeriod                    time.Duration
+	FulfillmentPriority           string
+	PrioritySubscriptionIDs       []string
+	MulticallAddress              string
 }
 
 type VRFSpec struct {
@@ -437,6 +440,19 @@ observationSource = """
 	if vrfVersion == vrfcommon.V2 {
 		toml = toml + "\n" + fmt.Sprintf(`vrfOwnerAddress = "%s"`, vrfOwnerAddress)
 	}
+	if params.FulfillmentPriority != "" {
+		toml = toml + "\n" + fmt.Sprintf(`fulfillmentPriority = "%s"`, params.FulfillmentPriority)
+	}
+	if len(params.PrioritySubscriptionIDs) != 0 {
+		var subIDs []string
+		for _, subID := range params.PrioritySubscriptionIDs {
+			subIDs = append(subIDs, fmt.Sprintf("%q", subID))
+		}
+		toml = toml + "\n" + fmt.Sprintf(`prioritySubscriptionIDs = [%s]`, strings.Join(subIDs, ", "))
+	}
+	if params.MulticallAddress != "" {
+		toml = toml + "\n" + fmt.Sprintf(`multicallAddress = "%s"`, params.MulticallAddress)
+	}
 
 	return VRFSpec{VRFSpecParams: VRFSpecParams{
 		JobID:                    jobID,
@@ -456,6 +472,9 @@ observationSource = """
 		VRFOwnerAddress:          vrfOwnerAddress,
 		VRFVersion:               vrfVersion,
 		PollPeriod:               pollPeriod,
+		FulfillmentPriority:      params.FulfillmentPriority,
+		PrioritySubscriptionIDs:  params.PrioritySubscriptionIDs,
+		MulticallAddress:         params.MulticallAddress,
 	}, toml: toml}
 }
 
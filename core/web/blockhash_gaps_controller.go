@@ -0,0 +1,68 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// BlockhashGapsController shows the blockhashes missing from the blockhash
+// stores of jobs.
+type BlockhashGapsController struct {
+	App chainlink.Application
+}
+
+// Index scans the requests of the coordinators of a blockhashstore or
+// blockheaderfeeder job, and lists the blocks with unfulfilled requests whose
+// blockhash is missing from the job's blockhash store, lowest first.
+// Example:
+// "GET <application>/jobs/:ID/blockhash_gaps"
+func (bc *BlockhashGapsController) Index(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := bc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	if jb.Type != job.BlockhashStore && jb.Type != job.BlockHeaderFeeder {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job is not a blockhashstore or blockheaderfeeder job"))
+		return
+	}
+
+	detector, err := blockhashstore.NewGapDetectorForJob(bc.App.GetLogger(), bc.App.GetRelayers().LegacyEVMChains(), jb)
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			jsonAPIError(c, http.StatusNotFound, err)
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	gaps, err := detector.Detect(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.BlockhashGapResource{}
+	for _, g := range gaps {
+		resources = append(resources, presenters.NewBlockhashGapResource(g))
+	}
+	jsonAPIResponse(c, resources, "blockhash_gaps")
+}
@@ -0,0 +1,26 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestBlockhashGapsController_Index(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	// Not a blockhashstore or blockheaderfeeder job.
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/blockhash_gaps", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/blockhash_gaps")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/invalid/blockhash_gaps")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
@@ -49,6 +49,28 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.TLSClientCert != "" || bt.TLSClientKey != "" || bt.TLSRootCA != "" {
+		if bt.URL.Scheme != "https" {
+			fe.Add("TLS settings require an https URL")
+		}
+		if _, err := bridges.NewTLSConfig(bt.TLSClientCert, bt.TLSClientKey, bt.TLSRootCA); err != nil {
+			fe.Add(err.Error())
+		}
+	}
+	if bt.SigningSecret != "" && len(bt.SigningSecret) < bridges.MinSigningSecretLength {
+		fe.Add(fmt.Sprintf("SigningSecret must be at least %d characters", bridges.MinSigningSecretLength))
+	}
+	if bt.ResponseCacheTTL.Duration() < 0 || bt.ResponseCacheMaxStale.Duration() < 0 {
+		fe.Add("ResponseCacheTTL and ResponseCacheMaxStale must not be negative")
+	}
+	if bt.ResponseCacheMaxStale.Duration() > 0 && bt.ResponseCacheTTL.Duration() == 0 {
+		fe.Add("ResponseCacheMaxStale requires ResponseCacheTTL")
+	}
+	if bt.HealthCheckPath != "" {
+		if _, err := (bridges.BridgeType{URL: bt.URL, HealthCheckPath: bt.HealthCheckPath}).HealthCheckURL(); err != nil {
+			fe.Add(err.Error())
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -94,7 +116,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusConflict, apiErr)
 		return
 	}
-	resource := presenters.NewBridgeResource(*bt)
+	resource := btc.newBridgeResource(*bt)
 	resource.IncomingToken = bta.IncomingToken
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]interface{}{
@@ -113,7 +135,7 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *btc.newBridgeResource(bridge))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -139,13 +161,12 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResource(bt), "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
 func (btc *BridgeTypesController) Update(c *gin.Context) {
 	name := c.Param("BridgeName")
-	btr := &bridges.BridgeTypeRequest{}
 
 	taskType, err := bridges.ParseBridgeName(name)
 	if err != nil {
@@ -164,6 +185,14 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		return
 	}
 
+	// Settings omitted from the request keep their current values.
+	btr := &bridges.BridgeTypeRequest{
+		TLSClientCert:   bt.TLSClientCert,
+		TLSClientKey:    bt.TLSClientKey,
+		TLSRootCA:       bt.TLSRootCA,
+		SigningSecret:   bt.SigningSecret,
+		HealthCheckPath: bt.HealthCheckPath,
+	}
 	if err := c.ShouldBindJSON(btr); err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
@@ -184,7 +213,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeURL":                    bt.URL,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newBridgeResource(bt), "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -225,3 +254,13 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
+
+// newBridgeResource presents bt along with its latest health status, if the
+// bridge has been probed.
+func (btc *BridgeTypesController) newBridgeResource(bt bridges.BridgeType) *presenters.BridgeResource {
+	resource := presenters.NewBridgeResource(bt)
+	if status, ok := btc.App.BridgeHealthMonitor().Status(bt.Name); ok {
+		resource.Health = presenters.NewBridgeHealth(status)
+	}
+	return resource
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -101,6 +102,84 @@ func TestValidateBridgeType(t *testing.T) {
 				URL:  cltest.WebURL(t, "https://denergy.eth"),
 			},
 			nil,
+		},
+		{
+			"client certificate without key",
+			bridges.BridgeTypeRequest{
+				Name:          "mtlsadapter",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				TLSClientCert: "certificate",
+			},
+			models.NewJSONAPIErrorsWith("a client certificate and key must be provided together"),
+		},
+		{
+			"TLS settings with http URL",
+			bridges.BridgeTypeRequest{
+				Name:      "mtlsadapter",
+				URL:       cltest.WebURL(t, "http://denergy.eth"),
+				TLSRootCA: "not a certificate",
+			},
+			func() error {
+				errs := models.NewJSONAPIErrorsWith("TLS settings require an https URL")
+				errs.Add("invalid root CA: no PEM encoded certificates found")
+				return errs
+			}(),
+		},
+		{
+			"short signing secret",
+			bridges.BridgeTypeRequest{
+				Name:          "signedadapter",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				SigningSecret: "secret",
+			},
+			models.NewJSONAPIErrorsWith("SigningSecret must be at least 32 characters"),
+		},
+		{
+			"valid signing secret and health check path",
+			bridges.BridgeTypeRequest{
+				Name:            "signedadapter",
+				URL:             cltest.WebURL(t, "https://denergy.eth"),
+				SigningSecret:   "0123456789abcdef0123456789abcdef",
+				HealthCheckPath: "/health",
+			},
+			nil,
+		},
+		{
+			"relative health check path",
+			bridges.BridgeTypeRequest{
+				Name:            "healthadapter",
+				URL:             cltest.WebURL(t, "https://denergy.eth"),
+				HealthCheckPath: "health",
+			},
+			models.NewJSONAPIErrorsWith(`health check path "health" must be an absolute path`),
+		},
+		{
+			"valid response cache",
+			bridges.BridgeTypeRequest{
+				Name:                  "cachedadapter",
+				URL:                   cltest.WebURL(t, "https://denergy.eth"),
+				ResponseCacheTTL:      models.Interval(10 * time.Second),
+				ResponseCacheMaxStale: models.Interval(time.Minute),
+			},
+			nil,
+		},
+		{
+			"response cache max staleness without TTL",
+			bridges.BridgeTypeRequest{
+				Name:                  "cachedadapter",
+				URL:                   cltest.WebURL(t, "https://denergy.eth"),
+				ResponseCacheMaxStale: models.Interval(time.Minute),
+			},
+			models.NewJSONAPIErrorsWith("ResponseCacheMaxStale requires ResponseCacheTTL"),
+		},
+		{
+			"negative response cache TTL",
+			bridges.BridgeTypeRequest{
+				Name:             "cachedadapter",
+				URL:              cltest.WebURL(t, "https://denergy.eth"),
+				ResponseCacheTTL: models.Interval(-time.Second),
+			},
+			models.NewJSONAPIErrorsWith("ResponseCacheTTL and ResponseCacheMaxStale must not be negative"),
 		}}
 
 	for _, test := range tests {
@@ -250,8 +329,10 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 
 	bridgeName := testutils.RandomizeName("BRidgea")
 	bt := &bridges.BridgeType{
-		Name: bridges.MustParseBridgeName(bridgeName),
-		URL:  cltest.WebURL(t, "http://mybridge"),
+		Name:            bridges.MustParseBridgeName(bridgeName),
+		URL:             cltest.WebURL(t, "http://mybridge"),
+		SigningSecret:   "0123456789abcdef0123456789abcdef",
+		HealthCheckPath: "/health",
 	}
 	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
 
@@ -264,6 +345,8 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	ubt, err := app.BridgeORM().FindBridge(bt.Name)
 	assert.NoError(t, err)
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
+	assert.Equal(t, bt.SigningSecret, ubt.SigningSecret)
+	assert.Equal(t, bt.HealthCheckPath, ubt.HealthCheckPath)
 }
 
 func TestBridgeController_Show(t *testing.T) {
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	functionsConfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// DecryptionRequestsController shows the threshold decryption requests of jobs.
+type DecryptionRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists the pending decryption requests of a Functions job, oldest
+// first. The requests of a running job are read from its decryption queue,
+// whether it persists them or not. The requests persisted by a job which is
+// not running are read from the DB.
+// Example:
+// "GET <application>/jobs/:ID/decryption_requests"
+func (dc *DecryptionRequestsController) Index(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := dc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	if jb.OCR2OracleSpec == nil || jb.OCR2OracleSpec.PluginType != types.Functions {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job is not a functions job"))
+		return
+	}
+	var pluginConfig functionsConfig.PluginConfig
+	if err = json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to parse plugin config"))
+		return
+	}
+
+	requests, ok := dc.App.PendingDecryptionRequests(jb.ID)
+	if !ok {
+		if pluginConfig.DecryptionQueueConfig == nil || !pluginConfig.DecryptionQueueConfig.PersistPendingRequests {
+			jsonAPIError(c, http.StatusConflict, errors.New("job has no running decryption queue"))
+			return
+		}
+		orm := threshold.NewORM(dc.App.GetSqlxDB(), dc.App.GetLogger(), dc.App.GetConfig().Database(), jb.ID)
+		if requests, err = orm.FindPendingRequests(pg.WithParentCtx(c.Request.Context())); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	now := time.Now()
+	resources := []presenters.PendingDecryptionRequestResource{}
+	for _, req := range requests {
+		resources = append(resources, presenters.NewPendingDecryptionRequestResource(req, now))
+	}
+	jsonAPIResponse(c, resources, "pending_decryption_requests")
+}
@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestDecryptionRequestsController_Index(t *testing.T) {
+	app, client, _, otherJobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	jobID := mustInsertFunctionsJob(t, app.GetSqlxDB(), `{"decryptionQueueConfig": {"persistPendingRequests": true}}`)
+	inMemoryJobID := mustInsertFunctionsJob(t, app.GetSqlxDB(), `{"decryptionQueueConfig": {"persistPendingRequests": false}}`)
+
+	orm := threshold.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig().Database(), jobID)
+	require.NoError(t, orm.InsertPendingRequest([]byte{0x01}, []byte("ciphertext"), time.Now().Add(-time.Minute)))
+	require.NoError(t, orm.InsertPendingRequest([]byte{0x02}, []byte("other ciphertext"), time.Now()))
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/decryption_requests", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var resources []presenters.PendingDecryptionRequestResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resources))
+	require.Len(t, resources, 2)
+	assert.Equal(t, "0x01", resources[0].CiphertextID)
+	assert.Equal(t, len("ciphertext"), resources[0].CiphertextBytes)
+	assert.GreaterOrEqual(t, resources[0].AgeSeconds, float64(60))
+	assert.Equal(t, "0x02", resources[1].CiphertextID)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/decryption_requests", inMemoryJobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/decryption_requests", otherJobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/decryption_requests")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/invalid/decryption_requests")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
+func mustInsertFunctionsJob(t *testing.T, db *sqlx.DB, pluginConfig string) int32 {
+	var specID, pipelineSpecID, jobID int32
+	require.NoError(t, db.Get(&specID, `INSERT INTO ocr2_oracle_specs (
+relay, relay_config, contract_id, p2pv2_bootstrappers, ocr_key_bundle_id, monitoring_endpoint, transmitter_id,
+blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations, plugin_type, plugin_config, created_at, updated_at) VALUES (
+'evm', '{}', $1, '{}', $2, '', $3, 0, 0, 0, 'functions', $4, NOW(), NOW()
+) RETURNING id`, cltest.NewEIP55Address().String(), cltest.DefaultOCR2KeyBundleID, cltest.NewEIP55Address().String(), pluginConfig))
+	require.NoError(t, db.Get(&pipelineSpecID, `INSERT INTO pipeline_specs (dot_dag_source, created_at) VALUES ('', NOW()) RETURNING id`))
+	require.NoError(t, db.Get(&jobID, `INSERT INTO jobs (pipeline_spec_id, external_job_id, schema_version, type, ocr2_oracle_spec_id, created_at)
+VALUES ($1, $2, 1, 'offchainreporting2', $3, NOW()) RETURNING id`, pipelineSpecID, uuid.New(), specID))
+	return jobID
+}
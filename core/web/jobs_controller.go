@@ -4,13 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
@@ -18,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -31,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -115,11 +123,7 @@ func (jc *JobsController) Create(c *gin.Context) {
 	defer cancel()
 	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
+		jsonAPIError(c, createJobErrorStatus(err), err)
 		return
 	}
 
@@ -203,17 +207,22 @@ func (jc *JobsController) Update(c *gin.Context) {
 
 	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
+		jsonAPIError(c, createJobErrorStatus(err), err)
 		return
 	}
 
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// createJobErrorStatus returns the status code of a failure to create a job,
+// which is a bad request if the keys referenced by the job do not exist.
+func createJobErrorStatus(err error) int {
+	if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
 func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
@@ -263,5 +272,576 @@ func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status
 	if err != nil {
 		return jb, http.StatusBadRequest, err
 	}
+	jb.SourceTOML = tomlString
 	return jb, 0, nil
 }
+
+// PauseJobRequest represents a request to pause a job.
+type PauseJobRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Pause stops the services of a job, keeping the job so that it can be
+// resumed later.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request := PauseJobRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	err := jc.App.PauseJob(c.Request.Context(), j.ID, request.Reason)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": j.ID, "reason": request.Reason})
+	jc.showJob(c, j.ID)
+}
+
+// Resume restarts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.ResumeJob(c.Request.Context(), j.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": j.ID})
+	jc.showJob(c, j.ID)
+}
+
+func (jc *JobsController) showJob(c *gin.Context, id int32) {
+	jb, err := jc.App.JobORM().FindJobTx(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
+
+// Export returns all jobs along with the TOML specs they were created from.
+// The specs set the external job ID of their job, so that they can be applied
+// again, and secrets are redacted.
+// Example:
+// "GET <application>/jobs/export"
+func (jc *JobsController) Export(c *gin.Context) {
+	jobs, _, err := jc.App.JobORM().FindJobs(0, math.MaxInt32)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ids := make([]int32, len(jobs))
+	for i, jb := range jobs {
+		ids[i] = jb.ID
+	}
+	sources, err := jc.App.JobORM().FindJobSourceTOMLs(ids, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.JobExportResource{}
+	for _, jb := range jobs {
+		exported, err := jc.exportJob(jb, sources)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "job %d cannot be exported", jb.ID))
+			return
+		}
+		resources = append(resources, *presenters.NewJobExportResource(jb, exported))
+	}
+
+	jsonAPIResponse(c, resources, "jobExports")
+}
+
+// exportJob returns the spec of a job to export. Jobs created before their
+// specs were recorded are exported with a spec built from the stored job, which
+// must validate back to the same job.
+func (jc *JobsController) exportJob(jb job.Job, sources map[int32]string) (string, error) {
+	if source, ok := sources[jb.ID]; ok {
+		return exportSourceTOML(jb, source)
+	}
+
+	if err := jc.loadExternalInitiators(&jb); err != nil {
+		return "", err
+	}
+	built, err := job.BuildSourceTOML(jb)
+	if err != nil {
+		return "", err
+	}
+	secret, err := jc.signingSecret(&jb)
+	if err != nil {
+		return "", err
+	}
+	tomlString := built
+	if secret.Valid {
+		if tomlString, err = withSigningSecret(built, secret.String); err != nil {
+			return "", err
+		}
+	}
+	desired, _, err := jc.validateJobSpec(tomlString)
+	if err != nil {
+		return "", errors.Wrap(err, "spec built from the job is invalid")
+	}
+	same, err := sameJob(jb, desired)
+	if err != nil {
+		return "", err
+	}
+	if !same {
+		return "", errors.New("spec built from the job does not match it")
+	}
+	return exportSourceTOML(jb, built)
+}
+
+// ApplyJobsRequest represents a request to reconcile the jobs of the node to
+// a set of job specs.
+type ApplyJobsRequest struct {
+	Specs []ApplyJobSpec `json:"specs"`
+	// DeleteExtras deletes jobs which are not part of Specs. Jobs managed by
+	// the Feeds Manager are never deleted.
+	DeleteExtras bool `json:"deleteExtras"`
+	// DryRun only returns the plan, without changing any jobs.
+	DryRun bool `json:"dryRun"`
+}
+
+// ApplyJobSpec is a job spec to reconcile. Source identifies the spec in the
+// plan, e.g. by its file name.
+type ApplyJobSpec struct {
+	Source string `json:"source"`
+	TOML   string `json:"toml"`
+}
+
+const (
+	JobApplyActionCreate    = "create"
+	JobApplyActionReplace   = "replace"
+	JobApplyActionDelete    = "delete"
+	JobApplyActionUnchanged = "unchanged"
+	// JobApplyActionAdopt is a job created before its spec was recorded,
+	// which matches the applied spec. The spec is recorded without replacing
+	// the job.
+	JobApplyActionAdopt = "adopt"
+	// JobApplyActionExtra is a job which is not part of the applied specs,
+	// and is kept.
+	JobApplyActionExtra = "extra"
+
+	JobApplyStatusPlanned = "planned"
+	JobApplyStatusApplied = "applied"
+	JobApplyStatusFailed  = "failed"
+	JobApplyStatusSkipped = "skipped"
+)
+
+type jobApplyStep struct {
+	action   string
+	source   string
+	existing *job.Job
+	desired  *job.Job
+	status   string
+	err      error
+}
+
+// Apply reconciles the jobs of the node to a set of job specs, matched to
+// existing jobs by external job ID. Missing jobs are created, and jobs whose
+// spec changed are replaced. Steps are executed in order and the first failure
+// skips the remaining steps, leaving the jobs applied so far in place.
+// Jobs managed by the Feeds Manager are never replaced.
+// Example:
+// "POST <application>/jobs/apply"
+func (jc *JobsController) Apply(c *gin.Context) {
+	request := ApplyJobsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	steps, status, err := jc.planApply(c.Request.Context(), request)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	if !request.DryRun {
+		jc.executeApply(c.Request.Context(), steps)
+	}
+
+	resources := []presenters.JobApplyActionResource{}
+	for i, step := range steps {
+		resources = append(resources, newJobApplyActionResource(i, step))
+	}
+
+	jsonAPIResponse(c, resources, "jobApplyActions")
+}
+
+func (jc *JobsController) planApply(ctx context.Context, request ApplyJobsRequest) ([]*jobApplyStep, int, error) {
+	jobs, _, err := jc.App.JobORM().FindJobs(0, math.MaxInt32)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	ids := make([]int32, len(jobs))
+	existing := make(map[uuid.UUID]*job.Job, len(jobs))
+	for i := range jobs {
+		ids[i] = jobs[i].ID
+		existing[jobs[i].ExternalJobID] = &jobs[i]
+	}
+	tomls, err := jc.App.JobORM().FindJobSourceTOMLs(ids, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	var (
+		steps   []*jobApplyStep
+		sources = make(map[uuid.UUID]string)
+	)
+	for _, spec := range request.Specs {
+		externalJobID, err := explicitExternalJobID(spec.TOML)
+		if err != nil {
+			return nil, http.StatusUnprocessableEntity, errors.Wrapf(err, "%s", spec.Source)
+		}
+		if other, ok := sources[externalJobID]; ok {
+			return nil, http.StatusUnprocessableEntity, errors.Errorf("%s: duplicate externalJobID %s, also used by %s", spec.Source, externalJobID, other)
+		}
+		sources[externalJobID] = spec.Source
+
+		current := existing[externalJobID]
+		currentSecret, err := jc.signingSecret(current)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		tomlString, err := restoreRedactedSecrets(spec.TOML, currentSecret)
+		if err != nil {
+			return nil, http.StatusUnprocessableEntity, errors.Wrapf(err, "%s", spec.Source)
+		}
+		jb, status, err := jc.validateJobSpec(tomlString)
+		if err != nil {
+			return nil, status, errors.Wrapf(err, "%s", spec.Source)
+		}
+
+		step := &jobApplyStep{action: JobApplyActionCreate, source: spec.Source, desired: &jb}
+		steps = append(steps, step)
+		if current == nil {
+			continue
+		}
+
+		step.existing = current
+		step.action = JobApplyActionReplace
+		// Recorded specs don't contain the signingSecret, which is compared
+		// separately.
+		desiredTOML, desiredSecret, err := job.RemoveSigningSecret(jb.SourceTOML)
+		if err != nil {
+			return nil, http.StatusUnprocessableEntity, errors.Wrapf(err, "%s", spec.Source)
+		}
+		if currentTOML, ok := tomls[current.ID]; ok {
+			currentTOML = withExternalJobID(currentTOML, current.ExternalJobID)
+			if diff, derr := feeds.DiffSpecs(currentTOML, desiredTOML); derr == nil && diff.IsEmpty() && currentSecret == desiredSecret {
+				step.action = JobApplyActionUnchanged
+			}
+		} else if currentSecret == desiredSecret {
+			if err = jc.loadExternalInitiators(current); err != nil {
+				return nil, http.StatusInternalServerError, err
+			}
+			same, err := sameJob(*current, jb)
+			if err != nil {
+				return nil, http.StatusInternalServerError, errors.Wrapf(err, "%s: failed to compare job %d", spec.Source, current.ID)
+			}
+			if same {
+				step.action = JobApplyActionAdopt
+			}
+		}
+		if step.action != JobApplyActionReplace {
+			continue
+		}
+		managed, err := jc.App.GetFeedsService().IsJobManaged(ctx, int64(current.ID))
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		if managed {
+			return nil, http.StatusUnprocessableEntity, errors.Errorf("%s: job %d is managed by the feeds manager and cannot be replaced", spec.Source, current.ID)
+		}
+	}
+
+	for i := range jobs {
+		if _, ok := sources[jobs[i].ExternalJobID]; ok {
+			continue
+		}
+		step := &jobApplyStep{action: JobApplyActionExtra, existing: &jobs[i]}
+		if request.DeleteExtras {
+			managed, merr := jc.App.GetFeedsService().IsJobManaged(ctx, int64(jobs[i].ID))
+			if merr != nil {
+				return nil, http.StatusInternalServerError, merr
+			}
+			if !managed {
+				step.action = JobApplyActionDelete
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	for _, step := range steps {
+		if step.changes() {
+			step.status = JobApplyStatusPlanned
+		}
+	}
+
+	return steps, 0, nil
+}
+
+func (jc *JobsController) executeApply(ctx context.Context, steps []*jobApplyStep) {
+	failed := false
+	for _, step := range steps {
+		if !step.changes() {
+			continue
+		}
+		if failed {
+			step.status = JobApplyStatusSkipped
+			continue
+		}
+
+		if step.err = jc.applyStep(ctx, step); step.err != nil {
+			step.status = JobApplyStatusFailed
+			failed = true
+			continue
+		}
+		step.status = JobApplyStatusApplied
+	}
+}
+
+func (jc *JobsController) applyStep(ctx context.Context, step *jobApplyStep) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	switch step.action {
+	case JobApplyActionCreate:
+		if err := jc.App.AddJobV2(ctx, step.desired); err != nil {
+			return err
+		}
+	case JobApplyActionReplace:
+		if err := jc.replaceJob(ctx, *step.existing, step.desired); err != nil {
+			return err
+		}
+		jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": step.existing.ID})
+	case JobApplyActionDelete:
+		if err := jc.App.DeleteJob(ctx, step.existing.ID); err != nil {
+			return err
+		}
+		jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": step.existing.ID})
+		return nil
+	case JobApplyActionAdopt:
+		return jc.App.JobORM().InsertJobSourceTOML(step.existing.ID, step.desired.SourceTOML, pg.WithParentCtx(ctx))
+	}
+
+	if jbj, err := json.Marshal(step.desired); err == nil {
+		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	}
+	return nil
+}
+
+// replaceJob deletes an existing job and creates its replacement in a single
+// transaction, so that the existing job is kept if the replacement fails.
+func (jc *JobsController) replaceJob(ctx context.Context, existing job.Job, desired *job.Job) error {
+	spawner := jc.App.JobSpawner()
+	q := pg.NewQ(jc.App.GetSqlxDB(), jc.App.GetLogger(), jc.App.GetConfig().Database(), pg.WithParentCtx(ctx))
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if err := spawner.DeleteJob(existing.ID, pg.WithQueryer(tx)); err != nil {
+			return errors.Wrap(err, "failed to delete existing job")
+		}
+		return spawner.CreateJob(desired, pg.WithQueryer(tx))
+	})
+	if err == nil {
+		return nil
+	}
+
+	// Deleting the job stopped its services, which have to be started again
+	// now that the deletion was rolled back.
+	if _, active := spawner.ActiveJobs()[existing.ID]; active || existing.IsPaused() {
+		return err
+	}
+	jb, ferr := jc.App.JobORM().FindJob(ctx, existing.ID)
+	if ferr != nil {
+		return multierr.Append(err, errors.Wrap(ferr, "failed to find existing job to restart"))
+	}
+	if serr := spawner.StartService(ctx, jb); serr != nil {
+		return multierr.Append(err, errors.Wrap(serr, "failed to restart existing job"))
+	}
+	return err
+}
+
+func (s *jobApplyStep) changes() bool {
+	switch s.action {
+	case JobApplyActionCreate, JobApplyActionReplace, JobApplyActionDelete, JobApplyActionAdopt:
+		return true
+	}
+	return false
+}
+
+// explicitExternalJobID returns the externalJobID set in a spec. Specs must set
+// it explicitly, since it is what matches them to existing jobs.
+func explicitExternalJobID(tomlString string) (uuid.UUID, error) {
+	var spec struct {
+		ExternalJobID string `toml:"externalJobID"`
+	}
+	if err := toml.Unmarshal([]byte(tomlString), &spec); err != nil {
+		return uuid.Nil, errors.Wrap(err, "failed to parse TOML")
+	}
+	if spec.ExternalJobID == "" {
+		return uuid.Nil, errors.New("externalJobID must be set")
+	}
+	id, err := uuid.Parse(spec.ExternalJobID)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "invalid externalJobID")
+	}
+	return id, nil
+}
+
+// withExternalJobID sets the externalJobID of a spec which does not set it,
+// since recorded specs may rely on it being generated when the job was created.
+func withExternalJobID(tomlString string, externalJobID uuid.UUID) string {
+	if _, err := explicitExternalJobID(tomlString); err == nil {
+		return tomlString
+	}
+	return fmt.Sprintf("externalJobID = %q\n", externalJobID.String()) + tomlString
+}
+
+// redactedSigningSecret replaces the signingSecret of exported webhook specs.
+var redactedSigningSecret = models.Secret("").String()
+
+// withSigningSecret sets the signingSecret of a spec which does not set it.
+func withSigningSecret(tomlString, secret string) (string, error) {
+	line, err := toml.Marshal(struct {
+		SigningSecret string `toml:"signingSecret"`
+	}{secret})
+	if err != nil {
+		return "", err
+	}
+	return string(line) + tomlString, nil
+}
+
+// exportSourceTOML returns the recorded spec of a job with its externalJobID
+// set. Recorded specs don't contain the signingSecret of webhook jobs, which
+// is exported redacted.
+func exportSourceTOML(jb job.Job, source string) (string, error) {
+	exported, secret, err := job.RemoveSigningSecret(withExternalJobID(source, jb.ExternalJobID))
+	if err != nil {
+		return "", err
+	}
+	if secret.Valid || (jb.WebhookSpec != nil && jb.WebhookSpec.HasSigningSecret()) {
+		return withSigningSecret(exported, redactedSigningSecret)
+	}
+	return exported, nil
+}
+
+// restoreRedactedSecrets replaces the secrets redacted by an export with the
+// secret of the existing job, existingSecret.
+func restoreRedactedSecrets(tomlString string, existingSecret null.String) (string, error) {
+	withoutSecret, secret, err := job.RemoveSigningSecret(tomlString)
+	if err != nil {
+		return "", err
+	}
+	if !secret.Valid || secret.String != redactedSigningSecret {
+		return tomlString, nil
+	}
+	if !existingSecret.Valid {
+		return "", errors.New("signingSecret is redacted, and there is no existing job to restore it from")
+	}
+	return withSigningSecret(withoutSecret, existingSecret.String)
+}
+
+// signingSecret returns the decrypted signingSecret of an existing job.
+func (jc *JobsController) signingSecret(jb *job.Job) (null.String, error) {
+	if jb == nil || jb.WebhookSpec == nil || len(jb.WebhookSpec.EncryptedSigningSecret) == 0 {
+		return null.String{}, nil
+	}
+	secret, err := jc.App.GetKeyStore().DecryptSecret(jb.WebhookSpec.EncryptedSigningSecret)
+	if err != nil {
+		return null.String{}, errors.Wrapf(err, "failed to decrypt signingSecret of job %d", jb.ID)
+	}
+	return null.StringFrom(string(secret)), nil
+}
+
+// loadExternalInitiators loads the external initiators of a webhook job, which
+// are not loaded along with the job.
+func (jc *JobsController) loadExternalInitiators(jb *job.Job) error {
+	if jb.WebhookSpec == nil || jb.WebhookSpecID == nil {
+		return nil
+	}
+	eiSpecs, _, err := jc.App.GetExternalInitiatorManager().Load(*jb.WebhookSpecID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load external initiators of job %d", jb.ID)
+	}
+	jb.WebhookSpec.ExternalInitiatorWebhookSpecs = eiSpecs
+	return nil
+}
+
+// sameJob reports whether an existing job matches a validated spec. It is used
+// for jobs created before their specs were recorded, and compares the specs
+// built from both jobs, which leave out the IDs and timestamps set on creation
+// as well as the signingSecret.
+func sameJob(existing, desired job.Job) (bool, error) {
+	existingTOML, err := job.BuildSourceTOML(existing)
+	if err != nil {
+		return false, err
+	}
+	desiredTOML, err := job.BuildSourceTOML(desired)
+	if err != nil {
+		return false, err
+	}
+	diff, err := feeds.DiffSpecs(existingTOML, desiredTOML)
+	if err != nil {
+		return false, err
+	}
+	return diff.IsEmpty(), nil
+}
+
+func newJobApplyActionResource(i int, step *jobApplyStep) presenters.JobApplyActionResource {
+	resource := presenters.JobApplyActionResource{
+		JAID:   presenters.NewJAIDInt32(int32(i)),
+		Action: step.action,
+		Source: step.source,
+		Status: step.status,
+	}
+	jb := step.desired
+	if jb == nil {
+		jb = step.existing
+	}
+	resource.ExternalJobID = jb.ExternalJobID
+	resource.Name = jb.Name.ValueOrZero()
+	resource.Type = presenters.JobSpecType(jb.Type)
+	if step.action == JobApplyActionCreate || step.action == JobApplyActionReplace {
+		if step.status == JobApplyStatusApplied {
+			resource.JobID = strconv.Itoa(int(step.desired.ID))
+		}
+	} else {
+		resource.JobID = strconv.Itoa(int(step.existing.ID))
+	}
+	if step.err != nil {
+		resource.Error = step.err.Error()
+	}
+	return resource
+}
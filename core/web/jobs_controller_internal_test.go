@@ -0,0 +1,131 @@
+package web
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+func TestJobsController_exportSourceTOML(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	const source = `type = "webhook"
+schemaVersion = 1
+observationSource = """
+    ds [type=memo value="signingSecret = 1"];
+"""
+`
+	externalJobID := uuid.New()
+	jb := job.Job{
+		ExternalJobID: externalJobID,
+		WebhookSpec:   &job.WebhookSpec{EncryptedSigningSecret: []byte("encrypted")},
+	}
+
+	exported, err := exportSourceTOML(jb, source)
+	require.NoError(t, err)
+	assert.Contains(t, exported, `ds [type=memo value="signingSecret = 1"];`)
+	_, redacted, err := job.RemoveSigningSecret(exported)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom(redactedSigningSecret), redacted)
+
+	id, err := explicitExternalJobID(exported)
+	require.NoError(t, err)
+	assert.Equal(t, externalJobID, id)
+
+	t.Run("restores redacted secrets of existing jobs", func(t *testing.T) {
+		restored, err := restoreRedactedSecrets(exported, null.StringFrom(secret))
+		require.NoError(t, err)
+		withoutSecret, restoredSecret, err := job.RemoveSigningSecret(restored)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(secret), restoredSecret)
+		assert.Contains(t, withoutSecret, `ds [type=memo value="signingSecret = 1"];`)
+	})
+
+	t.Run("rejects redacted secrets without an existing job", func(t *testing.T) {
+		_, err := restoreRedactedSecrets(exported, null.String{})
+		require.Error(t, err)
+	})
+
+	t.Run("keeps explicit secrets", func(t *testing.T) {
+		explicit := "signingSecret = '" + secret + "'\n" + source
+		restored, err := restoreRedactedSecrets(explicit, null.String{})
+		require.NoError(t, err)
+		assert.Equal(t, explicit, restored)
+	})
+
+	t.Run("keeps explicit externalJobIDs", func(t *testing.T) {
+		assert.Equal(t, exported, withExternalJobID(exported, uuid.New()))
+	})
+}
+
+func TestJobsController_sameJob(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, nil)
+	const signedWebhookSpec = `type = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+signingSecret = "0123456789abcdef0123456789abcdef"
+signatureMaxAge = "1m"
+observationSource = "ds [type=memo value=1];"
+`
+	for _, tc := range []struct {
+		name     string
+		spec     string
+		validate func(string) (job.Job, error)
+	}{
+		{"cron", fmt.Sprintf(testspecs.CronSpecTemplate, uuid.New()), cron.ValidatedCronSpec},
+		{"direct request", fmt.Sprintf(testspecs.DirectRequestSpecTemplate, uuid.New(), uuid.New()), directrequest.ValidatedDirectRequestSpec},
+		{"flux monitor", fmt.Sprintf(testspecs.FluxMonitorSpecTemplate, "fm", uuid.New()), func(s string) (job.Job, error) {
+			return fluxmonitorv2.ValidatedFluxMonitorSpec(cfg.JobPipeline(), s)
+		}},
+		{"ocr2", fmt.Sprintf(testspecs.OCR2EVMSpecMinimalTemplate, "ocr2"), func(s string) (job.Job, error) {
+			return validate.ValidatedOracleSpecToml(cfg.OCR2(), cfg.Insecure(), s)
+		}},
+		{"vrf", testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml(), vrfcommon.ValidatedVRFSpec},
+		{"blockhash store", testspecs.GenerateBlockhashStoreSpec(testspecs.BlockhashStoreSpecParams{}).Toml(), blockhashstore.ValidatedSpec},
+		{"bootstrap", testspecs.GetOCRBootstrapSpec(), ocrbootstrap.ValidatedBootstrapSpecToml},
+		{"webhook", fmt.Sprintf(signedWebhookSpec, uuid.New()), func(s string) (job.Job, error) {
+			return webhook.ValidatedWebhookSpec(s, nil)
+		}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			existing, err := tc.validate(tc.spec)
+			require.NoError(t, err)
+
+			built, err := job.BuildSourceTOML(existing)
+			require.NoError(t, err)
+			_, secret, err := job.RemoveSigningSecret(tc.spec)
+			require.NoError(t, err)
+			if secret.Valid {
+				built, err = withSigningSecret(built, secret.String)
+				require.NoError(t, err)
+			}
+			rebuilt, err := tc.validate(built)
+			require.NoError(t, err, built)
+
+			same, err := sameJob(existing, rebuilt)
+			require.NoError(t, err)
+			assert.True(t, same, built)
+
+			rebuilt.Name = null.StringFrom("changed")
+			same, err = sameJob(existing, rebuilt)
+			require.NoError(t, err)
+			assert.False(t, same)
+		})
+	}
+}
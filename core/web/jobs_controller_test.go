@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"database/sql"
 	_ "embed"
 	"encoding/hex"
 	"encoding/json"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
@@ -537,6 +540,36 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	body, err := json.Marshal(web.PauseJobRequest{Reason: "maintenance"})
+	require.NoError(t, err)
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/pause", jobID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	require.NotNil(t, resource.PausedAt)
+	assert.Equal(t, "maintenance", resource.PauseReason)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%d/resume", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Nil(t, resource.PausedAt)
+	assert.Empty(t, resource.PauseReason)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 		c.OCR.Enabled = ptr(true)
@@ -766,3 +799,150 @@ func setupJobSpecsControllerTestsWithJobs(t *testing.T) (*cltest.TestApplication
 
 	return app, client, jb, jb.ID, erejb, erejb.ID
 }
+
+func TestJobsController_Apply(t *testing.T) {
+	app, client := setupJobsControllerTests(t)
+
+	cronSpec := func(externalJobID uuid.UUID, times int) string {
+		return strings.Replace(fmt.Sprintf(testspecs.CronSpecTemplate, externalJobID), "times=100", fmt.Sprintf("times=%d", times), 1)
+	}
+	apply := func(t *testing.T, request web.ApplyJobsRequest, expectedStatus int) []presenters.JobApplyActionResource {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/apply", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, expectedStatus)
+
+		var actions []presenters.JobApplyActionResource
+		if expectedStatus == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &actions))
+		}
+		return actions
+	}
+
+	id1, id2 := uuid.New(), uuid.New()
+	specs := []web.ApplyJobSpec{
+		{Source: "one.toml", TOML: cronSpec(id1, 100)},
+		{Source: "two.toml", TOML: cronSpec(id2, 100)},
+	}
+
+	t.Run("dry run does not change jobs", func(t *testing.T) {
+		actions := apply(t, web.ApplyJobsRequest{Specs: specs, DryRun: true}, http.StatusOK)
+		require.Len(t, actions, 2)
+		for _, a := range actions {
+			assert.Equal(t, web.JobApplyActionCreate, a.Action)
+			assert.Equal(t, web.JobApplyStatusPlanned, a.Status)
+		}
+
+		_, count, err := app.JobORM().FindJobs(0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("creates missing jobs", func(t *testing.T) {
+		actions := apply(t, web.ApplyJobsRequest{Specs: specs}, http.StatusOK)
+		require.Len(t, actions, 2)
+		for _, a := range actions {
+			assert.Equal(t, web.JobApplyActionCreate, a.Action)
+			assert.Equal(t, web.JobApplyStatusApplied, a.Status)
+			assert.NotEmpty(t, a.JobID)
+		}
+	})
+
+	t.Run("exports the applied specs", func(t *testing.T) {
+		response, cleanup := client.Get("/v2/jobs/export")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var exports []presenters.JobExportResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &exports))
+		require.Len(t, exports, 2)
+		tomls := map[uuid.UUID]string{}
+		for _, e := range exports {
+			tomls[e.ExternalJobID] = e.TOML
+		}
+		assert.Equal(t, specs[0].TOML, tomls[id1])
+		assert.Equal(t, specs[1].TOML, tomls[id2])
+	})
+
+	t.Run("replaces changed jobs and keeps extras", func(t *testing.T) {
+		actions := apply(t, web.ApplyJobsRequest{Specs: []web.ApplyJobSpec{
+			{Source: "one.toml", TOML: cronSpec(id1, 1000)},
+		}}, http.StatusOK)
+		require.Len(t, actions, 2)
+		assert.Equal(t, web.JobApplyActionReplace, actions[0].Action)
+		assert.Equal(t, web.JobApplyStatusApplied, actions[0].Status)
+		assert.Equal(t, web.JobApplyActionExtra, actions[1].Action)
+		assert.Equal(t, id2, actions[1].ExternalJobID)
+		assert.Empty(t, actions[1].Status)
+
+		jb, err := app.JobORM().FindJobByExternalJobID(id1)
+		require.NoError(t, err)
+		assert.Contains(t, jb.PipelineSpec.DotDagSource, "times=1000")
+	})
+
+	t.Run("deletes extras", func(t *testing.T) {
+		actions := apply(t, web.ApplyJobsRequest{Specs: []web.ApplyJobSpec{
+			{Source: "one.toml", TOML: cronSpec(id1, 1000)},
+		}, DeleteExtras: true}, http.StatusOK)
+		require.Len(t, actions, 2)
+		assert.Equal(t, web.JobApplyActionUnchanged, actions[0].Action)
+		assert.Equal(t, web.JobApplyActionDelete, actions[1].Action)
+		assert.Equal(t, web.JobApplyStatusApplied, actions[1].Status)
+
+		_, err := app.JobORM().FindJobByExternalJobID(id2)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("exports jobs created before their specs were recorded", func(t *testing.T) {
+		jb, err := app.JobORM().FindJobByExternalJobID(id1)
+		require.NoError(t, err)
+		_, err = app.GetSqlxDB().Exec(`DELETE FROM job_toml_sources WHERE job_id = $1`, jb.ID)
+		require.NoError(t, err)
+
+		response, cleanup := client.Get("/v2/jobs/export")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var exports []presenters.JobExportResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &exports))
+		require.Len(t, exports, 1)
+		exported, err := cron.ValidatedCronSpec(exports[0].TOML)
+		require.NoError(t, err)
+		assert.Equal(t, id1, exported.ExternalJobID)
+		assert.Equal(t, jb.CronSpec.CronSchedule, exported.CronSpec.CronSchedule)
+		assert.Contains(t, exported.Pipeline.Source, "times=1000")
+	})
+
+	t.Run("adopts jobs created before their specs were recorded", func(t *testing.T) {
+		jb, err := app.JobORM().FindJobByExternalJobID(id1)
+		require.NoError(t, err)
+		_, err = app.GetSqlxDB().Exec(`DELETE FROM job_toml_sources WHERE job_id = $1`, jb.ID)
+		require.NoError(t, err)
+
+		actions := apply(t, web.ApplyJobsRequest{Specs: []web.ApplyJobSpec{
+			{Source: "one.toml", TOML: cronSpec(id1, 1000)},
+		}}, http.StatusOK)
+		require.Len(t, actions, 1)
+		assert.Equal(t, web.JobApplyActionAdopt, actions[0].Action)
+		assert.Equal(t, web.JobApplyStatusApplied, actions[0].Status)
+		assert.Equal(t, strconv.Itoa(int(jb.ID)), actions[0].JobID)
+
+		sources, err := app.JobORM().FindJobSourceTOMLs([]int32{jb.ID})
+		require.NoError(t, err)
+		assert.Equal(t, cronSpec(id1, 1000), sources[jb.ID])
+	})
+
+	t.Run("rejects specs without an explicit externalJobID", func(t *testing.T) {
+		apply(t, web.ApplyJobsRequest{Specs: []web.ApplyJobSpec{
+			{Source: "no-id.toml", TOML: fmt.Sprintf(testspecs.DirectRequestSpecNoExternalJobID, 1)},
+		}}, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects duplicate externalJobIDs", func(t *testing.T) {
+		apply(t, web.ApplyJobsRequest{Specs: []web.ApplyJobSpec{
+			{Source: "one.toml", TOML: cronSpec(id1, 1000)},
+			{Source: "copy.toml", TOML: cronSpec(id1, 1000)},
+		}}, http.StatusUnprocessableEntity)
+	})
+}
@@ -0,0 +1,257 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeeperMigrationsController migrates legacy keeper jobs to OCR2 automation
+// jobs.
+type KeeperMigrationsController struct {
+	App chainlink.Application
+}
+
+// KeeperMigrationRequest is a request to generate the spec of the OCR2
+// automation job replacing a keeper job, and to create it if Create is set.
+type KeeperMigrationRequest struct {
+	ContractID            string   `json:"contractID"`
+	ContractVersion       string   `json:"contractVersion"`
+	OCRKeyBundleID        string   `json:"ocrKeyBundleID"`
+	TransmitterID         string   `json:"transmitterID"`
+	P2PV2Bootstrappers    []string `json:"p2pv2Bootstrappers"`
+	MercuryCredentialName string   `json:"mercuryCredentialName"`
+	Create                bool     `json:"create"`
+}
+
+// Show returns the state of a keeper job and its registry, and the status of
+// its migration.
+// Example:
+// "GET <application>/jobs/:ID/keeper_migration"
+func (kc *KeeperMigrationsController) Show(c *gin.Context) {
+	jb, ok := kc.findKeeperJob(c)
+	if !ok {
+		return
+	}
+	orm := kc.orm()
+	migration, ok := kc.findMigration(c, orm, jb.ID)
+	if !ok {
+		return
+	}
+	resource, ok := kc.inspect(c, orm, jb)
+	if !ok {
+		return
+	}
+	if migration != nil {
+		resource.SetMigration(*migration)
+	}
+	jsonAPIResponse(c, resource, "keeper_migrations")
+}
+
+// Create generates the spec of the OCR2 automation job replacing a keeper job.
+// If requested, the OCR2 job is created and the keeper job runs in shadow mode
+// alongside it: it keeps performing upkeeps until the migration is confirmed,
+// then stops. Deleting the OCR2 job resumes the keeper job.
+// Example:
+// "POST <application>/jobs/:ID/keeper_migration"
+func (kc *KeeperMigrationsController) Create(c *gin.Context) {
+	jb, ok := kc.findKeeperJob(c)
+	if !ok {
+		return
+	}
+	request := KeeperMigrationRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	spec, dropped, err := keeper.GenerateAutomationSpec(jb, keeper.AutomationSpecParams{
+		ContractID:            request.ContractID,
+		ContractVersion:       request.ContractVersion,
+		OCRKeyBundleID:        request.OCRKeyBundleID,
+		TransmitterID:         request.TransmitterID,
+		P2PV2Bootstrappers:    request.P2PV2Bootstrappers,
+		MercuryCredentialName: request.MercuryCredentialName,
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := kc.orm()
+	migration, ok := kc.findMigration(c, orm, jb.ID)
+	if !ok {
+		return
+	}
+	if request.Create && migration != nil {
+		jsonAPIError(c, http.StatusConflict, errors.Errorf("job %d was already migrated to job %d", jb.ID, migration.OCR2JobID))
+		return
+	}
+	resource, ok := kc.inspect(c, orm, jb)
+	if !ok {
+		return
+	}
+	if request.Create {
+		if migration, ok = kc.createAutomationJob(c, orm, jb, spec); !ok {
+			return
+		}
+	}
+	if migration != nil {
+		resource.SetMigration(*migration)
+	}
+	resource.AutomationSpec = spec
+	resource.DroppedSettings = dropped
+	jsonAPIResponse(c, resource, "keeper_migrations")
+}
+
+// Confirm confirms the migration of a keeper job, after which the keeper job
+// stops performing upkeeps. Operators confirm migrations once the upkeeps of
+// the legacy registry were migrated to the OCR2 automation registry and are
+// performed by it. The OCR2 automation job must have stored the contract config
+// of its registry.
+// Example:
+// "POST <application>/jobs/:ID/keeper_migration/confirm"
+func (kc *KeeperMigrationsController) Confirm(c *gin.Context) {
+	jb, ok := kc.findKeeperJob(c)
+	if !ok {
+		return
+	}
+	orm := kc.orm()
+	migration, err := orm.ConfirmMigration(jb.ID, pg.WithParentCtx(c.Request.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job was not migrated"))
+		return
+	} else if errors.Is(err, keeper.ErrAutomationJobNotConfigured) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resource, ok := kc.inspect(c, orm, jb)
+	if !ok {
+		return
+	}
+	resource.SetMigration(migration)
+	jsonAPIResponse(c, resource, "keeper_migrations")
+}
+
+// createAutomationJob creates the OCR2 automation job and records the
+// migration of the keeper job to it, in a single transaction.
+func (kc *KeeperMigrationsController) createAutomationJob(c *gin.Context, orm keeper.ORM, keeperJob job.Job, spec string) (*keeper.Migration, bool) {
+	jc := JobsController{kc.App}
+	jb, status, err := jc.validateJobSpec(spec)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	var migration keeper.Migration
+	q := pg.NewQ(kc.App.GetSqlxDB(), kc.App.GetLogger(), kc.App.GetConfig().Database(), pg.WithParentCtx(ctx))
+	err = q.Transaction(func(tx pg.Queryer) (txerr error) {
+		if txerr = kc.App.JobSpawner().CreateJob(&jb, pg.WithQueryer(tx)); txerr != nil {
+			return txerr
+		}
+		migration, txerr = orm.InsertMigration(keeperJob.ID, jb.ID, pg.WithQueryer(tx))
+		return txerr
+	})
+	if err != nil {
+		jsonAPIError(c, createJobErrorStatus(err), err)
+		return nil, false
+	}
+
+	jbj, err := json.Marshal(jb)
+	if err == nil {
+		kc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	} else {
+		kc.App.GetLogger().Errorf("Could not send audit log for JobCreation", "err", err)
+	}
+	return &migration, true
+}
+
+// findKeeperJob finds the keeper job of the request, writing an error
+// response if it is invalid.
+func (kc *KeeperMigrationsController) findKeeperJob(c *gin.Context) (job.Job, bool) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return job.Job{}, false
+	}
+	jb, err := kc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return job.Job{}, false
+	}
+	if jb.Type != job.Keeper || jb.KeeperSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job is not a keeper job"))
+		return job.Job{}, false
+	}
+	return jb, true
+}
+
+// findMigration returns the migration of the keeper job, or nil if it was not
+// migrated, writing an error response if it cannot be loaded.
+func (kc *KeeperMigrationsController) findMigration(c *gin.Context, orm keeper.ORM, jobID int32) (*keeper.Migration, bool) {
+	migration, err := orm.MigrationForJob(jobID, pg.WithParentCtx(c.Request.Context()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, true
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return &migration, true
+}
+
+// inspect returns the state of the registry of the keeper job as synced by
+// the job, and the on-chain version of the registry when it can be read.
+func (kc *KeeperMigrationsController) inspect(c *gin.Context, orm keeper.ORM, jb job.Job) (presenters.KeeperMigrationResource, bool) {
+	registry, err := orm.RegistryForJob(jb.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("registry of the keeper job was not synced yet"))
+		return presenters.KeeperMigrationResource{}, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return presenters.KeeperMigrationResource{}, false
+	}
+	ids, err := orm.AllUpkeepIDsForRegistry(registry.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return presenters.KeeperMigrationResource{}, false
+	}
+	upkeepIDs := []string{}
+	for _, id := range ids {
+		upkeepIDs = append(upkeepIDs, id.String())
+	}
+
+	evmChainID := jb.KeeperSpec.EVMChainID.String()
+	resource := presenters.NewKeeperMigrationResource(registry, evmChainID, upkeepIDs)
+	if chain, err := kc.App.GetRelayers().LegacyEVMChains().Get(evmChainID); err == nil {
+		if wrapper, err := keeper.NewRegistryWrapper(registry.ContractAddress, chain.Client()); err == nil {
+			resource.RegistryVersion = wrapper.Version.String()
+		} else {
+			kc.App.GetLogger().Warnw("Failed to read the version of the keeper registry", "address", registry.ContractAddress, "err", err)
+		}
+	}
+	return resource, true
+}
+
+func (kc *KeeperMigrationsController) orm() keeper.ORM {
+	return keeper.NewORM(kc.App.GetSqlxDB(), kc.App.GetLogger(), kc.App.GetConfig().Database())
+}
@@ -0,0 +1,109 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeeperMigrationsController(t *testing.T) {
+	app, client, _, ocrJobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	korm := keeper.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig().Database())
+	registry, keeperJob := cltest.MustInsertKeeperRegistry(t, app.GetSqlxDB(), korm, app.KeyStore.Eth(), 0, 1, 20)
+
+	t.Run("show", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/keeper_migration", keeperJob.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.KeeperMigrationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, keeperJob.ID, resource.KeeperJobID)
+		assert.Equal(t, registry.ContractAddress.String(), resource.RegistryAddress)
+		assert.Equal(t, registry.FromAddress.String(), resource.FromAddress)
+		assert.Equal(t, int32(20), resource.BlockCountPerTurn)
+		assert.Equal(t, keeper.MigrationStatusNotMigrated, resource.Status)
+		assert.Nil(t, resource.OCR2JobID)
+	})
+
+	t.Run("generate spec", func(t *testing.T) {
+		body, err := json.Marshal(web.KeeperMigrationRequest{
+			ContractID:         cltest.NewEIP55Address().String(),
+			OCRKeyBundleID:     cltest.DefaultOCR2KeyBundleID,
+			P2PV2Bootstrappers: []string{"12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"},
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/keeper_migration", keeperJob.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var resource presenters.KeeperMigrationResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Contains(t, resource.AutomationSpec, `pluginType = "ocr2automation"`)
+		assert.Contains(t, resource.AutomationSpec, fmt.Sprintf(`transmitterID = "%s"`, registry.FromAddress))
+		// The job was not created.
+		assert.Equal(t, keeper.MigrationStatusNotMigrated, resource.Status)
+	})
+
+	t.Run("confirm requires a migration", func(t *testing.T) {
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/keeper_migration/confirm", keeperJob.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("confirm requires a configured OCR2 job", func(t *testing.T) {
+		ocr2JobID := mustInsertOCR2AutomationJob(t, app.GetSqlxDB())
+		_, err := korm.InsertMigration(keeperJob.ID, ocr2JobID)
+		require.NoError(t, err)
+
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/keeper_migration/confirm", keeperJob.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+		migration, err := korm.MigrationForJob(keeperJob.ID)
+		require.NoError(t, err)
+		assert.Equal(t, keeper.MigrationStatusShadow, migration.Status())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		body, err := json.Marshal(web.KeeperMigrationRequest{ContractID: registry.ContractAddress.String()})
+		require.NoError(t, err)
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/keeper_migration", keeperJob.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+		response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/keeper_migration", ocrJobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+		response, cleanup = client.Get("/v2/jobs/999999999/keeper_migration")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
+func mustInsertOCR2AutomationJob(t *testing.T, db *sqlx.DB) int32 {
+	var specID, pipelineSpecID, jobID int32
+	require.NoError(t, db.Get(&specID, `INSERT INTO ocr2_oracle_specs (
+relay, relay_config, contract_id, p2pv2_bootstrappers, ocr_key_bundle_id, monitoring_endpoint, transmitter_id,
+blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations, plugin_type, plugin_config, created_at, updated_at) VALUES (
+'evm', '{}', $1, '{}', $2, '', $3, 0, 0, 0, 'ocr2automation', '{}', NOW(), NOW()
+) RETURNING id`, cltest.NewEIP55Address().String(), cltest.DefaultOCR2KeyBundleID, cltest.NewEIP55Address().String()))
+	require.NoError(t, db.Get(&pipelineSpecID, `INSERT INTO pipeline_specs (dot_dag_source, created_at) VALUES ('', NOW()) RETURNING id`))
+	require.NoError(t, db.Get(&jobID, `INSERT INTO jobs (pipeline_spec_id, external_job_id, schema_version, type, ocr2_oracle_spec_id, created_at)
+VALUES ($1, $2, 1, 'offchainreporting2', $3, NOW()) RETURNING id`, pipelineSpecID, uuid.New(), specID))
+	return jobID
+}
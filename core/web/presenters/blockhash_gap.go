@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+// BlockhashGapResource is a block with unfulfilled VRF requests whose
+// blockhash is missing from the blockhash store of a job, and is too old to
+// be stored without block headers.
+type BlockhashGapResource struct {
+	JAID
+	Block              uint64   `json:"block"`
+	CoordinatorVersion string   `json:"coordinatorVersion"`
+	NumRequests        int      `json:"numRequests"`
+	RequestIDs         []string `json:"requestIDs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BlockhashGapResource) GetName() string {
+	return "blockhash_gaps"
+}
+
+// NewBlockhashGapResource returns a new BlockhashGapResource for gap.
+func NewBlockhashGapResource(gap blockhashstore.Gap) BlockhashGapResource {
+	return BlockhashGapResource{
+		JAID:               NewJAID(fmt.Sprintf("%d-%s", gap.Block, gap.CoordinatorVersion)),
+		Block:              gap.Block,
+		CoordinatorVersion: gap.CoordinatorVersion,
+		NumRequests:        gap.NumRequests,
+		RequestIDs:         gap.RequestIDs,
+	}
+}
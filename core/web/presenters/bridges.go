@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -17,7 +18,32 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	// The client key and signing secret are never returned, only whether
+	// they are configured.
+	TLSClientCertSet      bool             `json:"tlsClientCertSet,omitempty"`
+	TLSRootCASet          bool             `json:"tlsRootCASet,omitempty"`
+	SigningSecretSet      bool             `json:"signingSecretSet,omitempty"`
+	HealthCheckPath       string           `json:"healthCheckPath,omitempty"`
+	Health                *BridgeHealth    `json:"health,omitempty"`
+	ResponseCacheTTL      *models.Interval `json:"responseCacheTTL,omitempty"`
+	ResponseCacheMaxStale *models.Interval `json:"responseCacheMaxStale,omitempty"`
+	CreatedAt             time.Time        `json:"createdAt"`
+}
+
+// BridgeHealth is the outcome of the latest health probe of a bridge.
+type BridgeHealth struct {
+	Healthy   bool      `json:"healthy"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// NewBridgeHealth constructs a new BridgeHealth
+func NewBridgeHealth(s bridges.HealthStatus) *BridgeHealth {
+	return &BridgeHealth{
+		Healthy:   s.Healthy,
+		Error:     s.Error,
+		CheckedAt: s.CheckedAt,
+	}
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,7 +53,7 @@ func (r BridgeResource) GetName() string {
 
 // NewBridgeResource constructs a new BridgeResource
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
-	return &BridgeResource{
+	r := &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
 		Name:                   b.Name.String(),
@@ -35,6 +61,17 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		TLSClientCertSet:       b.TLSClientCert != "",
+		TLSRootCASet:           b.TLSRootCA != "",
+		SigningSecretSet:       b.SigningSecret != "",
+		HealthCheckPath:        b.HealthCheckPath,
 		CreatedAt:              b.CreatedAt,
 	}
+	if !b.ResponseCacheTTL.IsZero() {
+		r.ResponseCacheTTL = &b.ResponseCacheTTL
+	}
+	if !b.ResponseCacheMaxStale.IsZero() {
+		r.ResponseCacheMaxStale = &b.ResponseCacheMaxStale
+	}
+	return r
 }
@@ -75,6 +75,45 @@ func TestBridgeResource(t *testing.T) {
 		}
 	}
 }
+`
+
+	assert.JSONEq(t, expected, string(b))
+
+	// Test TLS, signing and health check settings
+	bridge.TLSClientCert = "client certificate"
+	bridge.TLSClientKey = "client key"
+	bridge.SigningSecret = "0123456789abcdef0123456789abcdef"
+	bridge.HealthCheckPath = "/health"
+	bridge.ResponseCacheTTL = models.Interval(10 * time.Second)
+	r = NewBridgeResource(bridge)
+	r.Health = NewBridgeHealth(bridges.HealthStatus{Error: "adapter is down", CheckedAt: timestamp})
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":1,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":"1",
+			"tlsClientCertSet":true,
+			"signingSecretSet":true,
+			"healthCheckPath":"/health",
+			"responseCacheTTL":"10s",
+			"health":{
+				"healthy":false,
+				"error":"adapter is down",
+				"checkedAt":"2000-01-01T00:00:00Z"
+			},
+			"createdAt":"2000-01-01T00:00:00Z"
+		}
+	}
+}
 `
 
 	assert.JSONEq(t, expected, string(b))
@@ -72,20 +72,22 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 
 // FluxMonitorSpec defines the spec details of a FluxMonitor Job
 type FluxMonitorSpec struct {
-	ContractAddress     ethkey.EIP55Address `json:"contractAddress"`
-	Threshold           float32             `json:"threshold"`
-	AbsoluteThreshold   float32             `json:"absoluteThreshold"`
-	PollTimerPeriod     string              `json:"pollTimerPeriod"`
-	PollTimerDisabled   bool                `json:"pollTimerDisabled"`
-	IdleTimerPeriod     string              `json:"idleTimerPeriod"`
-	IdleTimerDisabled   bool                `json:"idleTimerDisabled"`
-	DrumbeatEnabled     bool                `json:"drumbeatEnabled"`
-	DrumbeatSchedule    *string             `json:"drumbeatSchedule"`
-	DrumbeatRandomDelay *string             `json:"drumbeatRandomDelay"`
-	MinPayment          *commonassets.Link  `json:"minPayment"`
-	CreatedAt           time.Time           `json:"createdAt"`
-	UpdatedAt           time.Time           `json:"updatedAt"`
-	EVMChainID          *big.Big            `json:"evmChainID"`
+	ContractAddress       ethkey.EIP55Address `json:"contractAddress"`
+	Threshold             float32             `json:"threshold"`
+	AbsoluteThreshold     float32             `json:"absoluteThreshold"`
+	PollTimerPeriod       string              `json:"pollTimerPeriod"`
+	PollTimerDisabled     bool                `json:"pollTimerDisabled"`
+	IdleTimerPeriod       string              `json:"idleTimerPeriod"`
+	IdleTimerDisabled     bool                `json:"idleTimerDisabled"`
+	DrumbeatEnabled       bool                `json:"drumbeatEnabled"`
+	DrumbeatSchedule      *string             `json:"drumbeatSchedule"`
+	DrumbeatRandomDelay   *string             `json:"drumbeatRandomDelay"`
+	HeartbeatSchedule     string              `json:"heartbeatSchedule,omitempty"`
+	MinSubmissionInterval *string             `json:"minSubmissionInterval,omitempty"`
+	MinPayment            *commonassets.Link  `json:"minPayment"`
+	CreatedAt             time.Time           `json:"createdAt"`
+	UpdatedAt             time.Time           `json:"updatedAt"`
+	EVMChainID            *big.Big            `json:"evmChainID"`
 }
 
 // NewFluxMonitorSpec initializes a new DirectFluxMonitorSpec from a
@@ -100,21 +102,28 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		drumbeatRandomDelay := spec.DrumbeatRandomDelay.String()
 		drumbeatRandomDelayPtr = &drumbeatRandomDelay
 	}
+	var minSubmissionIntervalPtr *string
+	if spec.MinSubmissionInterval > 0 {
+		minSubmissionInterval := spec.MinSubmissionInterval.String()
+		minSubmissionIntervalPtr = &minSubmissionInterval
+	}
 	return &FluxMonitorSpec{
-		ContractAddress:     spec.ContractAddress,
-		Threshold:           float32(spec.Threshold),
-		AbsoluteThreshold:   float32(spec.AbsoluteThreshold),
-		PollTimerPeriod:     spec.PollTimerPeriod.String(),
-		PollTimerDisabled:   spec.PollTimerDisabled,
-		IdleTimerPeriod:     spec.IdleTimerPeriod.String(),
-		IdleTimerDisabled:   spec.IdleTimerDisabled,
-		DrumbeatEnabled:     spec.DrumbeatEnabled,
-		DrumbeatSchedule:    drumbeatSchedulePtr,
-		DrumbeatRandomDelay: drumbeatRandomDelayPtr,
-		MinPayment:          spec.MinPayment,
-		CreatedAt:           spec.CreatedAt,
-		UpdatedAt:           spec.UpdatedAt,
-		EVMChainID:          spec.EVMChainID,
+		ContractAddress:       spec.ContractAddress,
+		Threshold:             float32(spec.Threshold),
+		AbsoluteThreshold:     float32(spec.AbsoluteThreshold),
+		PollTimerPeriod:       spec.PollTimerPeriod.String(),
+		PollTimerDisabled:     spec.PollTimerDisabled,
+		IdleTimerPeriod:       spec.IdleTimerPeriod.String(),
+		IdleTimerDisabled:     spec.IdleTimerDisabled,
+		DrumbeatEnabled:       spec.DrumbeatEnabled,
+		DrumbeatSchedule:      drumbeatSchedulePtr,
+		DrumbeatRandomDelay:   drumbeatRandomDelayPtr,
+		HeartbeatSchedule:     spec.HeartbeatSchedule,
+		MinSubmissionInterval: minSubmissionIntervalPtr,
+		MinPayment:            spec.MinPayment,
+		CreatedAt:             spec.CreatedAt,
+		UpdatedAt:             spec.UpdatedAt,
+		EVMChainID:            spec.EVMChainID,
 	}
 }
 
@@ -237,31 +246,49 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	SigningSecretSet bool            `json:"signingSecretSet,omitempty"`
+	SignatureMaxAge  models.Interval `json:"signatureMaxAge,omitempty"`
+	JWKSURL          string          `json:"jwksURL,omitempty"`
+	JWTIssuer        string          `json:"jwtIssuer,omitempty"`
+	JWTAudience      string          `json:"jwtAudience,omitempty"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	UpdatedAt        time.Time       `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		SigningSecretSet: spec.HasSigningSecret(),
+		SignatureMaxAge:  spec.SignatureMaxAge,
+		JWKSURL:          spec.JWKSURL.String,
+		JWTIssuer:        spec.JWTIssuer.String,
+		JWTAudience:      spec.JWTAudience.String,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule      string          `json:"schedule" tom:"schedule"`
+	Timezone          string          `json:"timezone,omitempty"`
+	Jitter            models.Interval `json:"jitter,omitempty"`
+	MaxConcurrentRuns uint32          `json:"maxConcurrentRuns,omitempty"`
+	CatchUpLimit      uint32          `json:"catchUpLimit,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:      spec.CronSchedule,
+		Timezone:          spec.Timezone,
+		Jitter:            spec.Jitter,
+		MaxConcurrentRuns: spec.MaxConcurrentRuns,
+		CatchUpLimit:      spec.CatchUpLimit,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
 	}
 }
 
@@ -285,6 +312,9 @@ type VRFSpec struct {
 	GasLanePrice                  *assets.Wei           `json:"gasLanePrice"`
 	RequestedConfsDelay           int64                 `json:"requestedConfsDelay"`
 	VRFOwnerAddress               *ethkey.EIP55Address  `json:"vrfOwnerAddress,omitempty"`
+	FulfillmentPriority           string                `json:"fulfillmentPriority,omitempty"`
+	PrioritySubscriptionIDs       []string              `json:"prioritySubscriptionIDs,omitempty"`
+	MulticallAddress              *ethkey.EIP55Address  `json:"multicallAddress,omitempty"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
@@ -308,6 +338,9 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		GasLanePrice:                  spec.GasLanePrice,
 		RequestedConfsDelay:           spec.RequestedConfsDelay,
 		VRFOwnerAddress:               spec.VRFOwnerAddress,
+		FulfillmentPriority:           spec.FulfillmentPriority,
+		PrioritySubscriptionIDs:       spec.PrioritySubscriptionIDs,
+		MulticallAddress:              spec.MulticallAddress,
 	}
 }
 
@@ -473,6 +506,8 @@ type JobResource struct {
 	GatewaySpec            *GatewaySpec            `json:"gatewaySpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
+	PausedAt               *time.Time              `json:"pausedAt,omitempty"`
+	PauseReason            string                  `json:"pauseReason,omitempty"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
@@ -488,6 +523,8 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		PausedAt:          j.PausedAt.Ptr(),
+		PauseReason:       j.PauseReason.ValueOrZero(),
 	}
 
 	switch j.Type {
@@ -536,3 +573,49 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobExportResource represents a job along with the TOML spec it was created
+// from. Jobs created before their specs were recorded have a spec built from
+// the stored job.
+type JobExportResource struct {
+	JAID
+	Name          string      `json:"name"`
+	Type          JobSpecType `json:"type"`
+	ExternalJobID uuid.UUID   `json:"externalJobID"`
+	TOML          string      `json:"toml"`
+}
+
+// NewJobExportResource initializes a new JobExportResource.
+func NewJobExportResource(j job.Job, toml string) *JobExportResource {
+	return &JobExportResource{
+		JAID:          NewJAIDInt32(j.ID),
+		Name:          j.Name.ValueOrZero(),
+		Type:          JobSpecType(j.Type),
+		ExternalJobID: j.ExternalJobID,
+		TOML:          toml,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobExportResource) GetName() string {
+	return "jobExports"
+}
+
+// JobApplyActionResource represents a single step of reconciling the jobs of
+// a node to a set of job specs.
+type JobApplyActionResource struct {
+	JAID
+	Action        string      `json:"action"`
+	Source        string      `json:"source"`
+	JobID         string      `json:"jobID"`
+	ExternalJobID uuid.UUID   `json:"externalJobID"`
+	Name          string      `json:"name"`
+	Type          JobSpecType `json:"type"`
+	Status        string      `json:"status,omitempty"`
+	Error         string      `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobApplyActionResource) GetName() string {
+	return "jobApplyActions"
+}
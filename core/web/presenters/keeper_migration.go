@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+// KeeperMigrationResource is the state of a legacy keeper job and its
+// registry, and of its migration to an OCR2 automation job.
+type KeeperMigrationResource struct {
+	JAID
+	KeeperJobID       int32      `json:"keeperJobID"`
+	EVMChainID        string     `json:"evmChainID"`
+	RegistryAddress   string     `json:"registryAddress"`
+	RegistryVersion   string     `json:"registryVersion,omitempty"`
+	FromAddress       string     `json:"fromAddress"`
+	BlockCountPerTurn int32      `json:"blockCountPerTurn"`
+	NumKeepers        int32      `json:"numKeepers"`
+	KeeperIndex       int32      `json:"keeperIndex"`
+	UpkeepIDs         []string   `json:"upkeepIDs"`
+	Status            string     `json:"status"`
+	OCR2JobID         *int32     `json:"ocr2JobID,omitempty"`
+	MigratedAt        *time.Time `json:"migratedAt,omitempty"`
+	ConfirmedAt       *time.Time `json:"confirmedAt,omitempty"`
+	AutomationSpec    string     `json:"automationSpec,omitempty"`
+	// DroppedSettings are the settings of the keeper job which could not be
+	// carried over to the generated OCR2 automation job spec.
+	DroppedSettings []string `json:"droppedSettings,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeeperMigrationResource) GetName() string {
+	return "keeper_migrations"
+}
+
+// NewKeeperMigrationResource returns a new KeeperMigrationResource for the
+// registry of a keeper job which was not migrated.
+func NewKeeperMigrationResource(registry keeper.Registry, evmChainID string, upkeepIDs []string) KeeperMigrationResource {
+	return KeeperMigrationResource{
+		JAID:              NewJAID(strconv.Itoa(int(registry.JobID))),
+		KeeperJobID:       registry.JobID,
+		EVMChainID:        evmChainID,
+		RegistryAddress:   registry.ContractAddress.String(),
+		FromAddress:       registry.FromAddress.String(),
+		BlockCountPerTurn: registry.BlockCountPerTurn,
+		NumKeepers:        registry.NumKeepers,
+		KeeperIndex:       registry.KeeperIndex,
+		UpkeepIDs:         upkeepIDs,
+		Status:            keeper.MigrationStatusNotMigrated,
+	}
+}
+
+// SetMigration sets the status of the migration of the keeper job.
+func (r *KeeperMigrationResource) SetMigration(migration keeper.Migration) {
+	r.Status = migration.Status()
+	r.OCR2JobID = &migration.OCR2JobID
+	r.MigratedAt = &migration.CreatedAt
+	r.ConfirmedAt = migration.ConfirmedAt
+}
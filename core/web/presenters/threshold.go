@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/threshold"
+)
+
+// PendingDecryptionRequestResource is a ciphertext awaiting decryption by the
+// DON of a job, as persisted by its decryption queue. The ciphertext itself
+// is not returned.
+type PendingDecryptionRequestResource struct {
+	JAID
+	CiphertextID    string    `json:"ciphertextId"`
+	CiphertextBytes int       `json:"ciphertextBytes"`
+	CreatedAt       time.Time `json:"createdAt"`
+	AgeSeconds      float64   `json:"ageSeconds"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PendingDecryptionRequestResource) GetName() string {
+	return "pending_decryption_requests"
+}
+
+// NewPendingDecryptionRequestResource returns a new PendingDecryptionRequestResource for req.
+func NewPendingDecryptionRequestResource(req threshold.PendingRequest, now time.Time) PendingDecryptionRequestResource {
+	id := "0x" + hex.EncodeToString(req.CiphertextID)
+	return PendingDecryptionRequestResource{
+		JAID:            NewJAID(id),
+		CiphertextID:    id,
+		CiphertextBytes: len(req.Ciphertext),
+		CreatedAt:       req.CreatedAt,
+		AgeSeconds:      now.Sub(req.CreatedAt).Seconds(),
+	}
+}
@@ -0,0 +1,133 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+)
+
+// UpkeepCheckRecord is a check of an upkeep recorded by the node: either a
+// check which found it ineligible, as persisted by the upkeep state store, or
+// the check which led to its last perform.
+type UpkeepCheckRecord struct {
+	WorkID              string     `json:"workID"`
+	BlockNumber         int64      `json:"blockNumber"`
+	Eligible            bool       `json:"eligible"`
+	IneligibilityReason string     `json:"ineligibilityReason,omitempty"`
+	PerformedTxHash     string     `json:"performedTxHash,omitempty"`
+	InsertedAt          *time.Time `json:"insertedAt,omitempty"`
+}
+
+// NewUpkeepCheckRecord returns a new UpkeepCheckRecord for an ineligible check.
+func NewUpkeepCheckRecord(workID string, blockNumber int64, reason uint8, insertedAt time.Time) UpkeepCheckRecord {
+	return UpkeepCheckRecord{
+		WorkID:              workID,
+		BlockNumber:         blockNumber,
+		IneligibilityReason: encoding.UpkeepFailureReason(reason).String(),
+		InsertedAt:          &insertedAt,
+	}
+}
+
+// NewPerformedUpkeepCheckRecord returns a new UpkeepCheckRecord for the
+// eligible check which was performed by the transaction txHash.
+func NewPerformedUpkeepCheckRecord(workID string, blockNumber int64, txHash string) UpkeepCheckRecord {
+	return UpkeepCheckRecord{
+		WorkID:          workID,
+		BlockNumber:     blockNumber,
+		Eligible:        true,
+		PerformedTxHash: txHash,
+	}
+}
+
+// UpkeepStateResource is the state of an automation v2.1 upkeep as seen by a
+// job: its recent ineligible checks and its last perform, newest first. The
+// last checked block and eligibility are those of the most recent of them.
+// Eligible checks are not recorded by the node until they are performed, so an
+// upkeep found eligible but not yet performed shows its previous check.
+type UpkeepStateResource struct {
+	JAID
+	UpkeepID            string              `json:"upkeepID"`
+	LastCheckedBlock    int64               `json:"lastCheckedBlock"`
+	Eligible            bool                `json:"eligible"`
+	IneligibilityReason string              `json:"ineligibilityReason,omitempty"`
+	LastPerformedTxHash string              `json:"lastPerformedTxHash,omitempty"`
+	LastPerformedBlock  int64               `json:"lastPerformedBlock,omitempty"`
+	LastPerformSuccess  bool                `json:"lastPerformSuccess"`
+	Checks              []UpkeepCheckRecord `json:"checks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepStateResource) GetName() string {
+	return "upkeep_states"
+}
+
+// UpkeepCheckResultResource is the result of a replayed check of an upkeep.
+type UpkeepCheckResultResource struct {
+	JAID
+	UpkeepID               string `json:"upkeepID"`
+	WorkID                 string `json:"workID"`
+	CheckBlock             uint64 `json:"checkBlock"`
+	Eligible               bool   `json:"eligible"`
+	IneligibilityReason    string `json:"ineligibilityReason"`
+	PipelineExecutionState string `json:"pipelineExecutionState"`
+	Retryable              bool   `json:"retryable"`
+	GasAllocated           uint64 `json:"gasAllocated"`
+	PerformData            string `json:"performData"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepCheckResultResource) GetName() string {
+	return "upkeep_check_results"
+}
+
+// NewUpkeepCheckResultResource returns a new UpkeepCheckResultResource for result.
+func NewUpkeepCheckResultResource(result ocr2keepers.CheckResult) UpkeepCheckResultResource {
+	return UpkeepCheckResultResource{
+		JAID:                   NewJAID(result.WorkID),
+		UpkeepID:               result.UpkeepID.BigInt().String(),
+		WorkID:                 result.WorkID,
+		CheckBlock:             uint64(result.Trigger.BlockNumber),
+		Eligible:               result.Eligible,
+		IneligibilityReason:    encoding.UpkeepFailureReason(result.IneligibilityReason).String(),
+		PipelineExecutionState: encoding.PipelineExecutionState(result.PipelineExecutionState).String(),
+		Retryable:              result.Retryable,
+		GasAllocated:           result.GasAllocated,
+		PerformData:            hexutil.Encode(result.PerformData),
+	}
+}
+
+// LogUpkeepBackfillResource is a request to re-deliver the logs of a block
+// range to a log trigger upkeep.
+type LogUpkeepBackfillResource struct {
+	JAID
+	UpkeepID    string     `json:"upkeepID"`
+	FromBlock   int64      `json:"fromBlock"`
+	ToBlock     int64      `json:"toBlock"`
+	Logs        int64      `json:"logs"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ProcessedAt *time.Time `json:"processedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogUpkeepBackfillResource) GetName() string {
+	return "log_upkeep_backfills"
+}
+
+// NewLogUpkeepBackfillResource returns a new LogUpkeepBackfillResource for backfill.
+func NewLogUpkeepBackfillResource(backfill logprovider.Backfill) LogUpkeepBackfillResource {
+	return LogUpkeepBackfillResource{
+		JAID:        NewJAID(strconv.FormatInt(backfill.ID, 10)),
+		UpkeepID:    backfill.UpkeepID.String(),
+		FromBlock:   backfill.FromBlock,
+		ToBlock:     backfill.ToBlock,
+		Logs:        backfill.Logs,
+		CreatedAt:   backfill.CreatedAt,
+		ProcessedAt: backfill.ProcessedAt,
+	}
+}
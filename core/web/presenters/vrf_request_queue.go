@@ -0,0 +1,28 @@
+package presenters
+
+import (
+	vrfv2 "github.com/smartcontractkit/chainlink/v2/core/services/vrf/v2"
+)
+
+// VRFRequestQueueResource is the queue of pending requests of a subscription
+// of a VRF V2 or V2Plus job, with the reason each request is pending.
+type VRFRequestQueueResource struct {
+	JAID
+	SubscriptionID string                `json:"subscriptionID"`
+	Requests       []vrfv2.QueuedRequest `json:"requests"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestQueueResource) GetName() string {
+	return "vrf_request_queues"
+}
+
+// NewVRFRequestQueueResource returns a new VRFRequestQueueResource for the
+// queue of subID.
+func NewVRFRequestQueueResource(subID string, queue []vrfv2.QueuedRequest) VRFRequestQueueResource {
+	return VRFRequestQueueResource{
+		JAID:           NewJAID(subID),
+		SubscriptionID: subID,
+		Requests:       queue,
+	}
+}
@@ -39,6 +39,19 @@ func (r *JobResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.j.CreatedAt}
 }
 
+// PausedAt resolves the time at which the job was paused, if it is paused.
+func (r *JobResolver) PausedAt() *graphql.Time {
+	if !r.j.PausedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.j.PausedAt.Time}
+}
+
+// PauseReason resolves the reason the job was paused for.
+func (r *JobResolver) PauseReason() *string {
+	return r.j.PauseReason.Ptr()
+}
+
 // Errors resolves the job's top level errors.
 func (r *JobResolver) Errors(ctx context.Context) ([]*JobErrorResolver, error) {
 	specErrs, err := loader.GetJobSpecErrorsByJobID(ctx, r.j.ID)
@@ -258,3 +271,65 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// -- PauseJob Mutation --
+
+type PauseJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewPauseJobPayload(app chainlink.Application, j *job.Job, err error) *PauseJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &PauseJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobSuccess() (*PauseJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &PauseJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type PauseJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *PauseJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- ResumeJob Mutation --
+
+type ResumeJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewResumeJobPayload(app chainlink.Application, j *job.Job, err error) *ResumeJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &ResumeJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobSuccess() (*ResumeJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &ResumeJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type ResumeJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *ResumeJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/gqlscalar"
 )
 
 // SpecStatus defines the enum values for GQL
@@ -289,3 +291,314 @@ func NewUpdateJobProposalSpecDefinitionSuccess(spec *feeds.JobProposalSpec) *Upd
 func (r *UpdateJobProposalSpecDefinitionSuccessResolver) Spec() *JobProposalSpecResolver {
 	return NewJobProposalSpec(r.spec)
 }
+
+// -- JobProposalSpecDiff Query --
+
+// SpecDiffChange defines the enum values for GQL
+type SpecDiffChange string
+
+const (
+	// revive:disable
+	SpecDiffChangeAdded   SpecDiffChange = "ADDED"
+	SpecDiffChangeRemoved SpecDiffChange = "REMOVED"
+	SpecDiffChangeChanged SpecDiffChange = "CHANGED"
+	// revive:enable
+)
+
+// ToSpecDiffChange converts the feeds diff change into the enum value.
+func ToSpecDiffChange(c feeds.DiffChange) SpecDiffChange {
+	switch c {
+	case feeds.DiffChangeAdded:
+		return SpecDiffChangeAdded
+	case feeds.DiffChangeRemoved:
+		return SpecDiffChangeRemoved
+	default:
+		return SpecDiffChangeChanged
+	}
+}
+
+// JobProposalSpecDiffResolver resolves the diff of a spec against the
+// currently approved spec of its job proposal.
+type JobProposalSpecDiffResolver struct {
+	diff *feeds.SpecDiff
+}
+
+// NewJobProposalSpecDiff creates a new JobProposalSpecDiffResolver.
+func NewJobProposalSpecDiff(diff *feeds.SpecDiff) *JobProposalSpecDiffResolver {
+	return &JobProposalSpecDiffResolver{diff: diff}
+}
+
+// Fields resolves to the TOML field differences.
+func (r *JobProposalSpecDiffResolver) Fields() []*JobProposalSpecFieldDiffResolver {
+	resolvers := []*JobProposalSpecFieldDiffResolver{}
+	for i := range r.diff.Fields {
+		resolvers = append(resolvers, &JobProposalSpecFieldDiffResolver{diff: r.diff.Fields[i]})
+	}
+
+	return resolvers
+}
+
+// Tasks resolves to the pipeline task differences.
+func (r *JobProposalSpecDiffResolver) Tasks() []*JobProposalSpecTaskDiffResolver {
+	resolvers := []*JobProposalSpecTaskDiffResolver{}
+	for i := range r.diff.Tasks {
+		resolvers = append(resolvers, &JobProposalSpecTaskDiffResolver{diff: r.diff.Tasks[i]})
+	}
+
+	return resolvers
+}
+
+// Edges resolves to the pipeline edge differences.
+func (r *JobProposalSpecDiffResolver) Edges() []*JobProposalSpecEdgeDiffResolver {
+	resolvers := []*JobProposalSpecEdgeDiffResolver{}
+	for i := range r.diff.Edges {
+		resolvers = append(resolvers, &JobProposalSpecEdgeDiffResolver{diff: r.diff.Edges[i]})
+	}
+
+	return resolvers
+}
+
+// JobProposalSpecFieldDiffResolver resolves a TOML field difference.
+type JobProposalSpecFieldDiffResolver struct {
+	diff feeds.FieldDiff
+}
+
+// Path resolves to the dotted path of the field.
+func (r *JobProposalSpecFieldDiffResolver) Path() string {
+	return r.diff.Path
+}
+
+// Change resolves to the type of change.
+func (r *JobProposalSpecFieldDiffResolver) Change() SpecDiffChange {
+	return ToSpecDiffChange(r.diff.Change)
+}
+
+// Old resolves to the JSON encoded value in the approved spec.
+func (r *JobProposalSpecFieldDiffResolver) Old() *string {
+	if r.diff.Change == feeds.DiffChangeAdded {
+		return nil
+	}
+
+	return &r.diff.Old
+}
+
+// New resolves to the JSON encoded value in the proposed spec.
+func (r *JobProposalSpecFieldDiffResolver) New() *string {
+	if r.diff.Change == feeds.DiffChangeRemoved {
+		return nil
+	}
+
+	return &r.diff.New
+}
+
+// JobProposalSpecTaskDiffResolver resolves a pipeline task difference.
+type JobProposalSpecTaskDiffResolver struct {
+	diff feeds.TaskDiff
+}
+
+// DotID resolves to the DOT ID of the task.
+func (r *JobProposalSpecTaskDiffResolver) DotID() string {
+	return r.diff.DotID
+}
+
+// Change resolves to the type of change.
+func (r *JobProposalSpecTaskDiffResolver) Change() SpecDiffChange {
+	return ToSpecDiffChange(r.diff.Change)
+}
+
+// OldAttributes resolves to the task attributes in the approved spec.
+func (r *JobProposalSpecTaskDiffResolver) OldAttributes() *gqlscalar.Map {
+	return attributesToMap(r.diff.OldAttributes)
+}
+
+// NewAttributes resolves to the task attributes in the proposed spec.
+func (r *JobProposalSpecTaskDiffResolver) NewAttributes() *gqlscalar.Map {
+	return attributesToMap(r.diff.NewAttributes)
+}
+
+func attributesToMap(attrs map[string]string) *gqlscalar.Map {
+	if attrs == nil {
+		return nil
+	}
+
+	m := make(gqlscalar.Map, len(attrs))
+	for k, v := range attrs {
+		m[k] = v
+	}
+
+	return &m
+}
+
+// JobProposalSpecEdgeDiffResolver resolves a pipeline edge difference.
+type JobProposalSpecEdgeDiffResolver struct {
+	diff feeds.EdgeDiff
+}
+
+// From resolves to the DOT ID of the upstream task.
+func (r *JobProposalSpecEdgeDiffResolver) From() string {
+	return r.diff.From
+}
+
+// To resolves to the DOT ID of the downstream task.
+func (r *JobProposalSpecEdgeDiffResolver) To() string {
+	return r.diff.To
+}
+
+// Change resolves to the type of change.
+func (r *JobProposalSpecEdgeDiffResolver) Change() SpecDiffChange {
+	return ToSpecDiffChange(r.diff.Change)
+}
+
+// JobProposalSpecDiffPayloadResolver resolves the diff payload.
+type JobProposalSpecDiffPayloadResolver struct {
+	diff *feeds.SpecDiff
+	NotFoundErrorUnionType
+}
+
+// NewJobProposalSpecDiffPayload generates the diff payload resolver.
+func NewJobProposalSpecDiffPayload(diff *feeds.SpecDiff, err error) *JobProposalSpecDiffPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: notFoundErrorMessage}
+
+	return &JobProposalSpecDiffPayloadResolver{diff: diff, NotFoundErrorUnionType: e}
+}
+
+// ToJobProposalSpecDiff resolves to the diff resolver.
+func (r *JobProposalSpecDiffPayloadResolver) ToJobProposalSpecDiff() (*JobProposalSpecDiffResolver, bool) {
+	if r.diff != nil {
+		return NewJobProposalSpecDiff(r.diff), true
+	}
+
+	return nil, false
+}
+
+// -- ScheduleJobProposalSpecApproval Mutation --
+
+// JobProposalSpecApprovalScheduleResolver resolves the approval schedule of
+// a spec.
+type JobProposalSpecApprovalScheduleResolver struct {
+	schedule *feeds.SpecApprovalSchedule
+}
+
+// NewJobProposalSpecApprovalSchedule creates a new JobProposalSpecApprovalScheduleResolver.
+func NewJobProposalSpecApprovalSchedule(schedule *feeds.SpecApprovalSchedule) *JobProposalSpecApprovalScheduleResolver {
+	return &JobProposalSpecApprovalScheduleResolver{schedule: schedule}
+}
+
+// Force resolves to whether an existing job is replaced on approval.
+func (r *JobProposalSpecApprovalScheduleResolver) Force() bool {
+	return r.schedule.Force
+}
+
+// ApproveAt resolves to the scheduled approval time.
+func (r *JobProposalSpecApprovalScheduleResolver) ApproveAt() *graphql.Time {
+	if !r.schedule.ApproveAt.Valid {
+		return nil
+	}
+
+	return &graphql.Time{Time: r.schedule.ApproveAt.Time}
+}
+
+// ApproveAtBlock resolves to the scheduled approval block height.
+func (r *JobProposalSpecApprovalScheduleResolver) ApproveAtBlock() *string {
+	if !r.schedule.ApproveAtBlock.Valid {
+		return nil
+	}
+
+	block := stringutils.FromInt64(r.schedule.ApproveAtBlock.Int64)
+
+	return &block
+}
+
+// EVMChainID resolves to the chain of the scheduled approval block height.
+func (r *JobProposalSpecApprovalScheduleResolver) EVMChainID() *graphql.ID {
+	if r.schedule.EVMChainID == nil {
+		return nil
+	}
+
+	id := graphql.ID(r.schedule.EVMChainID.String())
+
+	return &id
+}
+
+// CreatedAt resolves to the time the approval was scheduled.
+func (r *JobProposalSpecApprovalScheduleResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.schedule.CreatedAt}
+}
+
+// ScheduleJobProposalSpecApprovalPayloadResolver resolves the schedule payload.
+type ScheduleJobProposalSpecApprovalPayloadResolver struct {
+	spec     *feeds.JobProposalSpec
+	schedule *feeds.SpecApprovalSchedule
+	NotFoundErrorUnionType
+}
+
+// NewScheduleJobProposalSpecApprovalPayload generates the schedule payload resolver.
+func NewScheduleJobProposalSpecApprovalPayload(spec *feeds.JobProposalSpec, schedule *feeds.SpecApprovalSchedule, err error) *ScheduleJobProposalSpecApprovalPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: notFoundErrorMessage}
+
+	return &ScheduleJobProposalSpecApprovalPayloadResolver{spec: spec, schedule: schedule, NotFoundErrorUnionType: e}
+}
+
+// ToScheduleJobProposalSpecApprovalSuccess resolves to the schedule success
+// resolver.
+func (r *ScheduleJobProposalSpecApprovalPayloadResolver) ToScheduleJobProposalSpecApprovalSuccess() (*ScheduleJobProposalSpecApprovalSuccessResolver, bool) {
+	if r.spec != nil && r.schedule != nil {
+		return &ScheduleJobProposalSpecApprovalSuccessResolver{spec: r.spec, schedule: r.schedule}, true
+	}
+
+	return nil, false
+}
+
+// ScheduleJobProposalSpecApprovalSuccessResolver resolves the schedule
+// success response.
+type ScheduleJobProposalSpecApprovalSuccessResolver struct {
+	spec     *feeds.JobProposalSpec
+	schedule *feeds.SpecApprovalSchedule
+}
+
+// Spec returns the job proposal spec.
+func (r *ScheduleJobProposalSpecApprovalSuccessResolver) Spec() *JobProposalSpecResolver {
+	return NewJobProposalSpec(r.spec)
+}
+
+// Schedule returns the approval schedule of the spec.
+func (r *ScheduleJobProposalSpecApprovalSuccessResolver) Schedule() *JobProposalSpecApprovalScheduleResolver {
+	return NewJobProposalSpecApprovalSchedule(r.schedule)
+}
+
+// -- UnscheduleJobProposalSpecApproval Mutation --
+
+// UnscheduleJobProposalSpecApprovalPayloadResolver resolves the unschedule
+// payload.
+type UnscheduleJobProposalSpecApprovalPayloadResolver struct {
+	spec *feeds.JobProposalSpec
+	NotFoundErrorUnionType
+}
+
+// NewUnscheduleJobProposalSpecApprovalPayload generates the unschedule payload resolver.
+func NewUnscheduleJobProposalSpecApprovalPayload(spec *feeds.JobProposalSpec, err error) *UnscheduleJobProposalSpecApprovalPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: notFoundErrorMessage}
+
+	return &UnscheduleJobProposalSpecApprovalPayloadResolver{spec: spec, NotFoundErrorUnionType: e}
+}
+
+// ToUnscheduleJobProposalSpecApprovalSuccess resolves to the unschedule
+// success resolver.
+func (r *UnscheduleJobProposalSpecApprovalPayloadResolver) ToUnscheduleJobProposalSpecApprovalSuccess() (*UnscheduleJobProposalSpecApprovalSuccessResolver, bool) {
+	if r.spec != nil {
+		return &UnscheduleJobProposalSpecApprovalSuccessResolver{spec: r.spec}, true
+	}
+
+	return nil, false
+}
+
+// UnscheduleJobProposalSpecApprovalSuccessResolver resolves the unschedule
+// success response.
+type UnscheduleJobProposalSpecApprovalSuccessResolver struct {
+	spec *feeds.JobProposalSpec
+}
+
+// Spec returns the job proposal spec.
+func (r *UnscheduleJobProposalSpecApprovalSuccessResolver) Spec() *JobProposalSpecResolver {
+	return NewJobProposalSpec(r.spec)
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v4"
 
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 )
 
@@ -465,3 +467,263 @@ func TestResolver_GetJobProposal_Spec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_JobProposalSpecDiff(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobProposalSpecDiff($id: ID!) {
+			jobProposalSpecDiff(id: $id) {
+				... on JobProposalSpecDiff {
+					fields {
+						path
+						change
+						old
+						new
+					}
+					tasks {
+						dotID
+						change
+						oldAttributes
+						newAttributes
+					}
+					edges {
+						from
+						to
+						change
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	specID := int64(1)
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "jobProposalSpecDiff"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetSpecDiff", mock.Anything, specID).Return(&feeds.SpecDiff{
+					Fields: []feeds.FieldDiff{
+						{Path: "name", Change: feeds.DiffChangeChanged, Old: `"old"`, New: `"new"`},
+						{Path: "maxTaskDuration", Change: feeds.DiffChangeAdded, New: `"10s"`},
+					},
+					Tasks: []feeds.TaskDiff{
+						{DotID: "ds1", Change: feeds.DiffChangeAdded, NewAttributes: map[string]string{"type": "http"}},
+					},
+					Edges: []feeds.EdgeDiff{
+						{From: "ds1", To: "jp1", Change: feeds.DiffChangeRemoved},
+					},
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDiff": {
+					"fields": [
+						{"path": "name", "change": "CHANGED", "old": "\"old\"", "new": "\"new\""},
+						{"path": "maxTaskDuration", "change": "ADDED", "old": null, "new": "\"10s\""}
+					],
+					"tasks": [
+						{"dotID": "ds1", "change": "ADDED", "oldAttributes": null, "newAttributes": {"type": "http"}}
+					],
+					"edges": [
+						{"from": "ds1", "to": "jp1", "change": "REMOVED"}
+					]
+				}
+			}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetSpecDiff", mock.Anything, specID).Return(nil, sql.ErrNoRows)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"jobProposalSpecDiff": {
+					"message": "spec not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ScheduleJobProposalSpecApproval(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ScheduleJobProposalSpecApproval($id: ID!, $input: ScheduleJobProposalSpecApprovalInput!) {
+			scheduleJobProposalSpecApproval(id: $id, input: $input) {
+				... on ScheduleJobProposalSpecApprovalSuccess {
+					spec {
+						id
+					}
+					schedule {
+						force
+						approveAt
+						approveAtBlock
+						evmChainID
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	specID := int64(1)
+	variables := map[string]interface{}{
+		"id": "1",
+		"input": map[string]interface{}{
+			"force":          true,
+			"approveAtBlock": "100",
+			"evmChainID":     "1337",
+		},
+	}
+	schedule := feeds.ApprovalSchedule{
+		ApproveAtBlock: null.IntFrom(100),
+		EVMChainID:     ubig.NewI(1337),
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "scheduleJobProposalSpecApproval"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("ScheduleSpecApproval", mock.Anything, specID, true, schedule).Return(nil)
+				f.Mocks.feedsSvc.On("GetSpec", specID).Return(&feeds.JobProposalSpec{
+					ID: specID,
+				}, nil)
+				f.Mocks.feedsSvc.On("GetSpecApprovalSchedule", mock.Anything, specID).Return(&feeds.SpecApprovalSchedule{
+					JobProposalSpecID: specID,
+					Force:             true,
+					ApproveAtBlock:    null.IntFrom(100),
+					EVMChainID:        ubig.NewI(1337),
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"scheduleJobProposalSpecApproval": {
+					"spec": {
+						"id": "1"
+					},
+					"schedule": {
+						"force": true,
+						"approveAt": null,
+						"approveAtBlock": "100",
+						"evmChainID": "1337"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("ScheduleSpecApproval", mock.Anything, specID, true, schedule).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"scheduleJobProposalSpecApproval": {
+					"message": "spec not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UnscheduleJobProposalSpecApproval(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UnscheduleJobProposalSpecApproval($id: ID!) {
+			unscheduleJobProposalSpecApproval(id: $id) {
+				... on UnscheduleJobProposalSpecApprovalSuccess {
+					spec {
+						id
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	specID := int64(1)
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "unscheduleJobProposalSpecApproval"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UnscheduleSpecApproval", mock.Anything, specID).Return(nil)
+				f.Mocks.feedsSvc.On("GetSpec", specID).Return(&feeds.JobProposalSpec{
+					ID: specID,
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"unscheduleJobProposalSpecApproval": {
+					"spec": {
+						"id": "1"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UnscheduleSpecApproval", mock.Anything, specID).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"unscheduleJobProposalSpecApproval": {
+					"message": "spec not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
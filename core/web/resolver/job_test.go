@@ -328,6 +328,7 @@ func TestResolver_CreateJob(t *testing.T) {
 	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(spec)
 	assert.NoError(t, err)
+	jb.SourceTOML = spec
 
 	d, err := json.Marshal(map[string]interface{}{
 		"createJob": map[string]interface{}{
@@ -562,3 +563,165 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation PauseJob($id: ID!, $input: PauseJobInput) {
+			pauseJob(id: $id, input: $input) {
+				... on PauseJobSuccess {
+					job {
+						id
+						pausedAt
+						pauseReason
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+		"input": map[string]interface{}{
+			"reason": "maintenance",
+		},
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id, "maintenance").Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:          id,
+					PausedAt:    null.TimeFrom(f.Timestamp()),
+					PauseReason: null.StringFrom("maintenance"),
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": "2021-01-01T00:00:00Z",
+							"pauseReason": "maintenance"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id, "maintenance").Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on PauseJob()",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id, "maintenance").Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						pausedAt
+						pauseReason
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": null,
+							"pauseReason": null
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -483,6 +484,16 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// TLS, signing, health check and response cache settings are only
+	// managed through the REST API, so keep the bridge's current ones.
+	btr.TLSClientCert = bridge.TLSClientCert
+	btr.TLSClientKey = bridge.TLSClientKey
+	btr.TLSRootCA = bridge.TLSRootCA
+	btr.SigningSecret = bridge.SigningSecret
+	btr.HealthCheckPath = bridge.HealthCheckPath
+	btr.ResponseCacheTTL = bridge.ResponseCacheTTL
+	btr.ResponseCacheMaxStale = bridge.ResponseCacheMaxStale
+
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
@@ -764,6 +775,120 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	return NewApproveJobProposalSpecPayload(spec, err), nil
 }
 
+// ScheduleJobProposalSpecApproval schedules the approval of a job proposal
+// spec at a timestamp or a block height.
+func (r *Resolver) ScheduleJobProposalSpecApproval(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		Force          *bool
+		ApproveAt      *graphql.Time
+		ApproveAtBlock *string
+		EvmChainID     *graphql.ID
+	}
+}) (*ScheduleJobProposalSpecApprovalPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	force := false
+	if args.Input.Force != nil {
+		force = *args.Input.Force
+	}
+
+	var schedule feeds.ApprovalSchedule
+	if args.Input.ApproveAt != nil {
+		schedule.ApproveAt = null.TimeFrom(args.Input.ApproveAt.Time)
+	}
+	if args.Input.ApproveAtBlock != nil {
+		block, perr := stringutils.ToInt64(*args.Input.ApproveAtBlock)
+		if perr != nil {
+			return nil, errors.Wrap(perr, "invalid approval block height")
+		}
+		schedule.ApproveAtBlock = null.IntFrom(block)
+	}
+	if args.Input.EvmChainID != nil {
+		chainID, perr := stringutils.ToInt64(string(*args.Input.EvmChainID))
+		if perr != nil {
+			return nil, errors.Wrap(perr, "invalid evm chain id")
+		}
+		schedule.EVMChainID = ubig.NewI(chainID)
+	}
+
+	feedsSvc := r.App.GetFeedsService()
+	if err = feedsSvc.ScheduleSpecApproval(ctx, id, force, schedule); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewScheduleJobProposalSpecApprovalPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	spec, err := feedsSvc.GetSpec(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewScheduleJobProposalSpecApprovalPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	approvalSchedule, err := feedsSvc.GetSpecApprovalSchedule(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewScheduleJobProposalSpecApprovalPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	schedulej, _ := json.Marshal(approvalSchedule)
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecApprovalScheduled, map[string]interface{}{"schedule": schedulej})
+
+	return NewScheduleJobProposalSpecApprovalPayload(spec, approvalSchedule, nil), nil
+}
+
+// UnscheduleJobProposalSpecApproval removes the approval schedule of a job
+// proposal spec.
+func (r *Resolver) UnscheduleJobProposalSpecApproval(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*UnscheduleJobProposalSpecApprovalPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	feedsSvc := r.App.GetFeedsService()
+	if err = feedsSvc.UnscheduleSpecApproval(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUnscheduleJobProposalSpecApprovalPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	spec, err := feedsSvc.GetSpec(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUnscheduleJobProposalSpecApprovalPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecApprovalUnscheduled, map[string]interface{}{"specID": id})
+
+	return NewUnscheduleJobProposalSpecApprovalPayload(spec, nil), nil
+}
+
 // CancelJobProposalSpec cancels the job proposal spec.
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
@@ -1058,6 +1183,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	jb.SourceTOML = args.Input.TOML
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1107,6 +1233,74 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *struct {
+		Reason *string
+	}
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var reason string
+	if args.Input != nil && args.Input.Reason != nil {
+		reason = *args.Input.Reason
+	}
+
+	err = r.App.PauseJob(ctx, id, reason)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": args.ID, "reason": reason})
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.ResumeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": args.ID})
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
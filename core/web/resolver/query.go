@@ -341,6 +341,32 @@ func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	return NewJobProposalPayload(jp, err), nil
 }
 
+// JobProposalSpecDiff compares a job proposal spec with the spec currently
+// approved for the job proposal.
+func (r *Resolver) JobProposalSpecDiff(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*JobProposalSpecDiffPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := r.App.GetFeedsService().GetSpecDiff(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewJobProposalSpecDiffPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewJobProposalSpecDiffPayload(diff, nil), nil
+}
+
 // Nodes retrieves a paginated list of nodes.
 func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
@@ -125,6 +125,33 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the spec's timezone.
+func (r *CronSpecResolver) Timezone() *string {
+	if r.spec.Timezone == "" {
+		return nil
+	}
+	return &r.spec.Timezone
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() *string {
+	if r.spec.Jitter.IsZero() {
+		return nil
+	}
+	jitter := r.spec.Jitter.Duration().String()
+	return &jitter
+}
+
+// MaxConcurrentRuns resolves the spec's max concurrent runs.
+func (r *CronSpecResolver) MaxConcurrentRuns() int32 {
+	return int32(r.spec.MaxConcurrentRuns)
+}
+
+// CatchUpLimit resolves the spec's catch up limit.
+func (r *CronSpecResolver) CatchUpLimit() int32 {
+	return int32(r.spec.CatchUpLimit)
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -224,6 +251,23 @@ func (r *FluxMonitorSpecResolver) DrumbeatSchedule() *string {
 	return nil
 }
 
+// HeartbeatSchedule resolves the spec's heartbeat schedule.
+func (r *FluxMonitorSpecResolver) HeartbeatSchedule() *string {
+	if r.spec.HeartbeatSchedule == "" {
+		return nil
+	}
+	return &r.spec.HeartbeatSchedule
+}
+
+// MinSubmissionInterval resolves the spec's min submission interval.
+func (r *FluxMonitorSpecResolver) MinSubmissionInterval() *string {
+	if r.spec.MinSubmissionInterval <= 0 {
+		return nil
+	}
+	interval := r.spec.MinSubmissionInterval.String()
+	return &interval
+}
+
 // EVMChainID resolves the spec's evm chain id.
 func (r *FluxMonitorSpecResolver) EVMChainID() *string {
 	if r.spec.EVMChainID == nil {
@@ -679,10 +723,59 @@ func (r *VRFSpecResolver) VRFOwnerAddress() *string {
 	return &vrfOwnerAddress
 }
 
+// FulfillmentPriority resolves the spec's fulfillment priority.
+func (r *VRFSpecResolver) FulfillmentPriority() string {
+	return r.spec.FulfillmentPriority
+}
+
+// PrioritySubscriptionIDs resolves the spec's priority subscription IDs.
+func (r *VRFSpecResolver) PrioritySubscriptionIDs() []string {
+	return append([]string{}, r.spec.PrioritySubscriptionIDs...)
+}
+
+// MulticallAddress resolves the spec's multicall address.
+func (r *VRFSpecResolver) MulticallAddress() *string {
+	if r.spec.MulticallAddress == nil {
+		return nil
+	}
+	multicallAddress := r.spec.MulticallAddress.String()
+	return &multicallAddress
+}
+
 type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
 
+// SigningSecretSet resolves whether the spec accepts requests signed with a
+// signing secret. The secret itself is never exposed.
+func (r *WebhookSpecResolver) SigningSecretSet() bool {
+	return r.spec.HasSigningSecret()
+}
+
+// SignatureMaxAge resolves the spec's signature max age.
+func (r *WebhookSpecResolver) SignatureMaxAge() *string {
+	if !r.spec.HasSigningSecret() || r.spec.SignatureMaxAge.IsZero() {
+		return nil
+	}
+	age := r.spec.SignatureMaxAge.Duration().String()
+	return &age
+}
+
+// JWKSURL resolves the spec's JWKS URL.
+func (r *WebhookSpecResolver) JWKSURL() *string {
+	return r.spec.JWKSURL.Ptr()
+}
+
+// JWTIssuer resolves the spec's JWT issuer.
+func (r *WebhookSpecResolver) JWTIssuer() *string {
+	return r.spec.JWTIssuer.Ptr()
+}
+
+// JWTAudience resolves the spec's JWT audience.
+func (r *WebhookSpecResolver) JWTAudience() *string {
+	return r.spec.JWTAudience.Ptr()
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
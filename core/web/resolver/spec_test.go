@@ -39,8 +39,11 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "0 0 1 1 *",
+						Timezone:          "UTC",
+						Jitter:            models.Interval(30 * time.Second),
+						MaxConcurrentRuns: 1,
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -52,6 +55,10 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									timezone
+									jitter
+									maxConcurrentRuns
+									catchUpLimit
 									createdAt
 								}
 							}
@@ -64,7 +71,11 @@ func TestResolver_CronSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "CronSpec",
-							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"schedule": "0 0 1 1 *",
+							"timezone": "UTC",
+							"jitter": "30s",
+							"maxConcurrentRuns": 1,
+							"catchUpLimit": 0,
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -286,6 +297,51 @@ func TestResolver_FluxMonitorSpec(t *testing.T) {
 				}
 			`,
 		},
+		{
+			name:          "flux monitor spec with heartbeat",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					Type: job.FluxMonitor,
+					FluxMonitorSpec: &job.FluxMonitorSpec{
+						ContractAddress:       contractAddress,
+						CreatedAt:             f.Timestamp(),
+						EVMChainID:            ubig.NewI(42),
+						HeartbeatSchedule:     "CRON_TZ=UTC 0 0 * * *",
+						MinSubmissionInterval: 30 * time.Second,
+						IdleTimerDisabled:     true,
+						PollTimerPeriod:       1 * time.Minute,
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on FluxMonitorSpec {
+									heartbeatSchedule
+									minSubmissionInterval
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "FluxMonitorSpec",
+							"heartbeatSchedule": "CRON_TZ=UTC 0 0 * * *",
+							"minSubmissionInterval": "30s"
+						}
+					}
+				}
+			`,
+		},
 	}
 
 	RunGQLTests(t, testCases)
@@ -596,6 +652,9 @@ func TestResolver_VRFSpec(t *testing.T) {
 						BackoffInitialDelay:           time.Minute,
 						BackoffMaxDelay:               time.Hour,
 						GasLanePrice:                  assets.GWei(200),
+						FulfillmentPriority:           "age",
+						PrioritySubscriptionIDs:       []string{"2", "1"},
+						MulticallAddress:              &batchCoordinatorAddress,
 					},
 				}, nil)
 			},
@@ -623,6 +682,9 @@ func TestResolver_VRFSpec(t *testing.T) {
 									backoffInitialDelay
 									backoffMaxDelay
 									gasLanePrice
+									fulfillmentPriority
+									prioritySubscriptionIDs
+									multicallAddress
 								}
 							}
 						}
@@ -650,7 +712,10 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"chunkSize": 25,
 							"backoffInitialDelay": "1m0s",
 							"backoffMaxDelay": "1h0m0s",
-							"gasLanePrice": "200 gwei"
+							"gasLanePrice": "200 gwei",
+							"fulfillmentPriority": "age",
+							"prioritySubscriptionIDs": ["2", "1"],
+							"multicallAddress": "0x0ad9FE7a58216242a8475ca92F222b0640E26B63"
 						}
 					}
 				}
@@ -675,7 +740,11 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						SigningSecret:   null.StringFrom("secret"),
+						SignatureMaxAge: models.Interval(time.Minute),
+						JWKSURL:         null.StringFrom("https://issuer.example/.well-known/jwks.json"),
+						JWTAudience:     null.StringFrom("chainlink"),
+						CreatedAt:       f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -686,6 +755,11 @@ func TestResolver_WebhookSpec(t *testing.T) {
 							spec {
 								__typename
 								... on WebhookSpec {
+									signingSecretSet
+									signatureMaxAge
+									jwksURL
+									jwtIssuer
+									jwtAudience
 									createdAt
 								}
 							}
@@ -698,6 +772,11 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
+							"signingSecretSet": true,
+							"signatureMaxAge": "1m0s",
+							"jwksURL": "https://issuer.example/.well-known/jwks.json",
+							"jwtIssuer": null,
+							"jwtAudience": "chainlink",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
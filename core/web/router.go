@@ -238,6 +238,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
+	// Webhook jobs authenticate these requests themselves, with a signature
+	// or bearer token.
+	wtc := NewWebhookTriggersController(app)
+	unauthedv2.POST("/webhooks/:ID", wtc.Create)
+
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
@@ -368,8 +373,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
+		authv2.GET("/jobs/export", auth.RequiresEditRole(jc.Export))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/apply", auth.RequiresEditRole(jc.Apply))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -378,6 +387,30 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		// DecryptionRequestsController
+		drc := DecryptionRequestsController{app}
+		authv2.GET("/jobs/:ID/decryption_requests", drc.Index)
+
+		// BlockhashGapsController
+		bgc := BlockhashGapsController{app}
+		authv2.GET("/jobs/:ID/blockhash_gaps", bgc.Index)
+
+		// VRFRequestQueuesController
+		vqc := VRFRequestQueuesController{app}
+		authv2.GET("/jobs/:ID/vrf_request_queues", vqc.Index)
+
+		// KeeperMigrationsController
+		kmc := KeeperMigrationsController{app}
+		authv2.GET("/jobs/:ID/keeper_migration", kmc.Show)
+		authv2.POST("/jobs/:ID/keeper_migration", auth.RequiresEditRole(kmc.Create))
+		authv2.POST("/jobs/:ID/keeper_migration/confirm", auth.RequiresEditRole(kmc.Confirm))
+
+		// UpkeepStatesController
+		usc := UpkeepStatesController{app}
+		authv2.GET("/jobs/:ID/upkeeps/:upkeepID", usc.Show)
+		authv2.POST("/jobs/:ID/upkeeps/:upkeepID/replay", auth.RequiresRunRole(usc.Replay))
+		authv2.POST("/jobs/:ID/upkeeps/:upkeepID/backfill", auth.RequiresEditRole(usc.Backfill))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
@@ -0,0 +1,274 @@
+package web
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper"
+	evmregistry21 "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/logprovider"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/upkeepstate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// defaultUpkeepChecksLimit is the number of recent checks shown per upkeep.
+const defaultUpkeepChecksLimit = 10
+
+// UpkeepStatesController shows the state of the upkeeps of automation v2.1
+// jobs, and replays their checks.
+type UpkeepStatesController struct {
+	App chainlink.Application
+}
+
+// ReplayUpkeepCheckRequest is a request to replay the check of an upkeep at a
+// block. Log trigger upkeeps are checked with the log at LogIndex of the
+// transaction LogTxHash.
+type ReplayUpkeepCheckRequest struct {
+	Block     int64  `json:"block"`
+	LogTxHash string `json:"logTxHash"`
+	LogIndex  int64  `json:"logIndex"`
+}
+
+// BackfillUpkeepLogsRequest is a request to re-deliver the logs of a block
+// range to a log trigger upkeep.
+type BackfillUpkeepLogsRequest struct {
+	FromBlock int64 `json:"fromBlock"`
+	ToBlock   int64 `json:"toBlock"`
+}
+
+// Show returns the state of an upkeep: the most recent checks which found it
+// ineligible, and its last perform with the check which led to it. Eligible
+// checks are only known once performed, as the node does not record them.
+// Example:
+// "GET <application>/jobs/:ID/upkeeps/:upkeepID?limit=10"
+func (uc *UpkeepStatesController) Show(c *gin.Context) {
+	jb, chain, upkeepID, ok := uc.parseRequest(c)
+	if !ok {
+		return
+	}
+	limit := defaultUpkeepChecksLimit
+	if l := c.Query("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid limit %q", l))
+			return
+		}
+	}
+
+	orm := upkeepstate.NewORM(chain.ID(), uc.App.GetSqlxDB(), uc.App.GetLogger(), uc.App.GetConfig().Database())
+	states, err := orm.SelectStatesByUpkeepID(upkeepID, limit, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	registry, err := uc.newRegistry(jb, chain)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	performed, isPerformed, err := registry.LastPerformed(c.Request.Context(), upkeepID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resource := presenters.UpkeepStateResource{
+		JAID:     presenters.NewJAID(upkeepID.String()),
+		UpkeepID: upkeepID.String(),
+		Checks:   []presenters.UpkeepCheckRecord{},
+	}
+	for _, s := range states {
+		resource.Checks = append(resource.Checks, presenters.NewUpkeepCheckRecord(s.WorkID, s.BlockNumber, s.IneligibilityReason, s.InsertedAt))
+	}
+	if isPerformed {
+		resource.LastPerformedTxHash = performed.TxHash.Hex()
+		resource.LastPerformedBlock = performed.BlockNumber
+		resource.LastPerformSuccess = performed.Success
+		record := presenters.NewPerformedUpkeepCheckRecord(performed.WorkID, int64(performed.CheckBlock), resource.LastPerformedTxHash)
+		i := sort.Search(len(resource.Checks), func(i int) bool { return resource.Checks[i].BlockNumber <= record.BlockNumber })
+		if i < limit {
+			resource.Checks = append(resource.Checks[:i], append([]presenters.UpkeepCheckRecord{record}, resource.Checks[i:]...)...)
+		}
+		if len(resource.Checks) > limit {
+			resource.Checks = resource.Checks[:limit]
+		}
+	}
+	if len(resource.Checks) > 0 {
+		resource.LastCheckedBlock = resource.Checks[0].BlockNumber
+		resource.Eligible = resource.Checks[0].Eligible
+		resource.IneligibilityReason = resource.Checks[0].IneligibilityReason
+	}
+	jsonAPIResponse(c, resource, "upkeep_states")
+}
+
+// Replay runs the check pipeline for an upkeep at a block, reproducing the
+// decision taken for it. The block must still be available to the RPC.
+// Example:
+// "POST <application>/jobs/:ID/upkeeps/:upkeepID/replay"
+func (uc *UpkeepStatesController) Replay(c *gin.Context) {
+	jb, chain, upkeepID, ok := uc.parseRequest(c)
+	if !ok {
+		return
+	}
+	request := ReplayUpkeepCheckRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Block <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("block is required"))
+		return
+	}
+	var l *evmregistry21.ReplayLog
+	if request.LogTxHash != "" {
+		if !isHexHash(request.LogTxHash) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid logTxHash %q", request.LogTxHash))
+			return
+		}
+		l = &evmregistry21.ReplayLog{TxHash: common.HexToHash(request.LogTxHash), LogIndex: request.LogIndex}
+	}
+
+	registry, err := uc.newRegistry(jb, chain)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	result, err := registry.ReplayCheck(c.Request.Context(), upkeepID, request.Block, l)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewUpkeepCheckResultResource(result), "upkeep_check_results")
+}
+
+// Backfill requests the logs of a block range to be re-delivered to a log
+// trigger upkeep. The request is processed by the log recoverer of the job once
+// the block range can be recovered, and only re-delivers the logs which were
+// not performed. It only affects this node, so it should be requested on all
+// the nodes of the DON. Block ranges which can't be recovered are rejected.
+// Example:
+// "POST <application>/jobs/:ID/upkeeps/:upkeepID/backfill"
+func (uc *UpkeepStatesController) Backfill(c *gin.Context) {
+	jb, chain, upkeepID, ok := uc.parseRequest(c)
+	if !ok {
+		return
+	}
+	uid := &ocr2keepers.UpkeepIdentifier{}
+	if !uid.FromBigInt(upkeepID) || core.GetUpkeepType(*uid) != types.LogTrigger {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("upkeep %s is not a log trigger upkeep", upkeepID))
+		return
+	}
+	request := BackfillUpkeepLogsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.FromBlock <= 0 || request.ToBlock < request.FromBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid block range [%d, %d]", request.FromBlock, request.ToBlock))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := logprovider.ValidateBackfill(ctx, chain.LogPoller(), chain.Config().EVM().FinalityDepth(), upkeepID, request.FromBlock, request.ToBlock); err != nil {
+		if errors.Is(err, logprovider.ErrBackfillNotRecoverable) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	addr := common.HexToAddress(jb.OCR2OracleSpec.ContractID)
+	orm := logprovider.NewBackfillORM(chain.ID(), addr, uc.App.GetSqlxDB(), uc.App.GetLogger(), uc.App.GetConfig().Database())
+	backfill, err := orm.InsertBackfill(upkeepID, request.FromBlock, request.ToBlock, pg.WithParentCtx(ctx))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewLogUpkeepBackfillResource(backfill), "log_upkeep_backfills")
+}
+
+// parseRequest finds the automation v2.1 job, its chain and the upkeep id of
+// the request, writing an error response if any of them is invalid.
+func (uc *UpkeepStatesController) parseRequest(c *gin.Context) (job.Job, legacyevm.Chain, *big.Int, bool) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return job.Job{}, nil, nil, false
+	}
+	jb, err := uc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return job.Job{}, nil, nil, false
+	}
+	if !isAutomation21Job(jb) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job is not an automation v2.1 job"))
+		return job.Job{}, nil, nil, false
+	}
+	upkeepID, ok := new(big.Int).SetString(c.Param("upkeepID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid upkeep id %q", c.Param("upkeepID")))
+		return job.Job{}, nil, nil, false
+	}
+	rid, err := jb.OCR2OracleSpec.RelayID()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return job.Job{}, nil, nil, false
+	}
+	chain, err := uc.App.GetRelayers().LegacyEVMChains().Get(rid.ChainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return job.Job{}, nil, nil, false
+	}
+	return jb, chain, upkeepID, true
+}
+
+// newRegistry creates a registry for inspecting the upkeeps of jb, with the
+// same mercury credentials as the job.
+func (uc *UpkeepStatesController) newRegistry(jb job.Job, chain legacyevm.Chain) (*evmregistry21.EvmRegistry, error) {
+	credName, err := jb.OCR2OracleSpec.PluginConfig.MercuryCredentialName()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get mercury credential name")
+	}
+	addr := common.HexToAddress(jb.OCR2OracleSpec.ContractID)
+	return evmregistry21.NewReplayRegistry(uc.App.GetLogger(), addr, chain, uc.App.GetConfig().Mercury().Credentials(credName))
+}
+
+func isAutomation21Job(jb job.Job) bool {
+	if jb.Type != job.OffchainReporting2 || jb.OCR2OracleSpec == nil || jb.OCR2OracleSpec.PluginType != commontypes.OCR2Keeper {
+		return false
+	}
+	var cfg ocr2keeper.PluginConfig
+	if err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &cfg); err != nil {
+		return false
+	}
+	return cfg.ContractVersion == "v2.1" || cfg.ContractVersion == "v2.1+"
+}
+
+func isHexHash(s string) bool {
+	b, err := hexutil.Decode(s)
+	return err == nil && len(b) == common.HashLength
+}
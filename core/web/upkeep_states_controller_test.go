@@ -0,0 +1,34 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestUpkeepStatesController(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/upkeeps/1", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%d/upkeeps/1/replay", jobID), bytes.NewBufferString(`{"block":100}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%d/upkeeps/1/backfill", jobID), bytes.NewBufferString(`{"fromBlock":100,"toBlock":200}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/upkeeps/1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/invalid/upkeeps/1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,74 @@
+package web
+
+import (
+	"cmp"
+	"database/sql"
+	"math/big"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFRequestQueuesController shows the pending requests of VRF jobs.
+type VRFRequestQueuesController struct {
+	App chainlink.Application
+}
+
+// Index lists the request queue of each subscription of a running VRF V2 or
+// V2Plus job as of its last processing round, by subscription ID. Each request
+// has the reason it is pending: unconfirmed, backoff, inflight, or ready with
+// its position in the processing order of the subscription.
+// Example:
+// "GET <application>/jobs/:ID/vrf_request_queues"
+func (vc *VRFRequestQueuesController) Index(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := vc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	if jb.Type != job.VRF {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job is not a vrf job"))
+		return
+	}
+
+	queues, ok := vc.App.VRFRequestQueues(jb.ID)
+	if !ok {
+		jsonAPIError(c, http.StatusConflict, errors.New("job is not running"))
+		return
+	}
+
+	subIDs := make([]string, 0, len(queues))
+	for subID := range queues {
+		subIDs = append(subIDs, subID)
+	}
+	// subscription IDs are decimal numbers
+	slices.SortFunc(subIDs, func(a, b string) int {
+		x, _ := new(big.Int).SetString(a, 10)
+		y, _ := new(big.Int).SetString(b, 10)
+		if x == nil || y == nil {
+			return cmp.Compare(a, b)
+		}
+		return x.Cmp(y)
+	})
+
+	resources := []presenters.VRFRequestQueueResource{}
+	for _, subID := range subIDs {
+		resources = append(resources, presenters.NewVRFRequestQueueResource(subID, queues[subID]))
+	}
+	jsonAPIResponse(c, resources, "vrf_request_queues")
+}
@@ -0,0 +1,26 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestVRFRequestQueuesController_Index(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	// Not a VRF job.
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/vrf_request_queues", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/vrf_request_queues")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/invalid/vrf_request_queues")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
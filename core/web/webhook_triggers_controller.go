@@ -0,0 +1,96 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const jwksFetchTimeout = 10 * time.Second
+
+// WebhookTriggersController triggers runs of webhook jobs from requests which
+// are signed with the job's signing secret, or carry a JWT issued by the
+// job's JWKS, rather than being made by a node user or external initiator.
+type WebhookTriggersController struct {
+	App     chainlink.Application
+	jwks    *webhook.JWKSCache
+	secrets *webhook.SigningSecrets
+	replays *webhook.SignatureReplays
+}
+
+func NewWebhookTriggersController(app chainlink.Application) *WebhookTriggersController {
+	return &WebhookTriggersController{
+		App:     app,
+		jwks:    webhook.NewJWKSCache(&http.Client{Timeout: jwksFetchTimeout}),
+		secrets: webhook.NewSigningSecrets(app.GetKeyStore()),
+		replays: webhook.NewSignatureReplays(),
+	}
+}
+
+// Create triggers a run of a webhook job by its external job ID.
+// Example:
+// "POST <application>/v2/webhooks/:ID"
+func (wtc *WebhookTriggersController) Create(c *gin.Context) {
+	jobUUID, err := uuid.Parse(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	req := webhook.SignedRequest{
+		Timestamp: c.GetHeader(webhook.TimestampHeader),
+		Signature: c.GetHeader(webhook.SignatureHeader),
+		Body:      bodyBytes,
+	}
+	if bearer, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer "); found {
+		req.BearerToken = strings.TrimSpace(bearer)
+	}
+	if req.Signature == "" && req.BearerToken == "" {
+		jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("missing %s header or bearer token", webhook.SignatureHeader))
+		return
+	}
+
+	authorizer := webhook.NewSignedRequestAuthorizer(wtc.App.GetSqlxDB().DB, req, wtc.jwks, wtc.secrets, wtc.replays)
+	canRun, err := authorizer.CanRun(c.Request.Context(), wtc.App.GetConfig().JobPipeline(), jobUUID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if !canRun {
+		jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("request is not authorized to run job %s", jobUUID))
+		return
+	}
+
+	jobRunID, err := wtc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{})
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRun, err := wtc.App.PipelineORM().FindRun(jobRunID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	res := presenters.NewPipelineRunResource(pipelineRun, wtc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
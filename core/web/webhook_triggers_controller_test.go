@@ -0,0 +1,102 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWebhookTriggersController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	externalJobID := uuid.New()
+	jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+signingSecret   = "%s"
+observationSource   = """
+    parse_request  [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+"""
+`, externalJobID, secret), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+
+	// Give the job.Spawner ample time to discover the job and start its service
+	time.Sleep(3 * time.Second)
+
+	post := func(t *testing.T, id string, body string, header http.Header) *http.Response {
+		req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodPost, app.Server.URL+"/v2/webhooks/"+id, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+	signed := func(body string, timestamp time.Time, secret string) http.Header {
+		ts := strconv.FormatInt(timestamp.Unix(), 10)
+		header := http.Header{}
+		header.Set(webhook.TimestampHeader, ts)
+		header.Set(webhook.SignatureHeader, webhook.Signature(secret, ts, []byte(body)))
+		return header
+	}
+
+	body := `{"data":{"result":"123.45"}}`
+
+	t.Run("valid signature", func(t *testing.T) {
+		header := signed(body, time.Now(), secret)
+		resp := post(t, externalJobID.String(), body, header)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var run presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &run))
+		assert.NotNil(t, run.FinishedAt)
+		require.Len(t, run.TaskRuns, 1)
+
+		resp = post(t, externalJobID.String(), body, header)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "replayed within the signature max age")
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		resp := post(t, externalJobID.String(), body, http.Header{})
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		resp := post(t, externalJobID.String(), body, signed(body, time.Now(), strings.Repeat("x", 32)))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("tampered body", func(t *testing.T) {
+		resp := post(t, externalJobID.String(), `{"data":{"result":"1"}}`, signed(body, time.Now(), secret))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("replayed after the signature max age", func(t *testing.T) {
+		resp := post(t, externalJobID.String(), body, signed(body, time.Now().Add(-time.Hour), secret))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		resp := post(t, uuid.New().String(), body, signed(body, time.Now(), secret))
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}